@@ -64,8 +64,10 @@ type CallServiceConf struct {
 }
 
 type DHCPConf struct {
-	MaxSubnetsCount uint32 `yaml:"max-subnets-count"`
-	ScanInterval    uint32 `yaml:"scan-interval"`
+	MaxSubnetsCount           uint32 `yaml:"max-subnets-count"`
+	ScanInterval              uint32 `yaml:"scan-interval"`
+	LeaseHistoryRetentionDays uint32 `yaml:"lease-history-retention-days"`
+	AssetInactiveDays         uint32 `yaml:"asset-inactive-days"`
 }
 type PrometheusConf struct {
 	Addr       string `yaml:"addr"`
@@ -139,6 +141,14 @@ func (c *DHCPConfig) Reload() error {
 		newConf.DHCP.ScanInterval = 750
 	}
 
+	if newConf.DHCP.LeaseHistoryRetentionDays == 0 {
+		newConf.DHCP.LeaseHistoryRetentionDays = 180
+	}
+
+	if newConf.DHCP.AssetInactiveDays == 0 {
+		newConf.DHCP.AssetInactiveDays = 30
+	}
+
 	newConf.Path = c.Path
 	*c = newConf
 	gConf = &newConf
@@ -185,6 +195,14 @@ func GetMaxSubnetsCount() int {
 	return int(gConf.DHCP.MaxSubnetsCount)
 }
 
+func GetLeaseHistoryRetentionDays() int {
+	return int(gConf.DHCP.LeaseHistoryRetentionDays)
+}
+
+func GetAssetInactiveDays() int {
+	return int(gConf.DHCP.AssetInactiveDays)
+}
+
 func (c *DHCPConfig) parsePrometheusTlsConfig() error {
 	if keyPem, err := ioutil.ReadFile(c.Prometheus.KeyFile); err != nil {
 		return fmt.Errorf("read prometheus key file failed:%s", err.Error())
@@ -0,0 +1,105 @@
+package ddns
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cuityhj/g53"
+	"github.com/cuityhj/g53/util"
+)
+
+const (
+	DefaultPort              = "53"
+	DefaultTimeout           = 5 * time.Second
+	MaxUDPReceivedPacketSize = 4096
+)
+
+// RFC 2136 reuses the answer section for prerequisites and the
+// authority section for updates
+const (
+	prerequisiteSection = g53.AnswerSection
+	updateSection       = g53.AuthSection
+)
+
+type TsigKey struct {
+	Name      string
+	Algorithm string
+	Secret    string
+}
+
+type Client struct {
+	server  string
+	tsig    *g53.TSIG
+	timeout time.Duration
+}
+
+func NewClient(server string, key *TsigKey, timeout time.Duration) (*Client, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		if net.ParseIP(server) == nil {
+			return nil, fmt.Errorf("invalid dns server %s", server)
+		}
+
+		server = net.JoinHostPort(server, DefaultPort)
+	}
+
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+
+	cli := &Client{server: server, timeout: timeout}
+	if key != nil && key.Name != "" {
+		tsig, err := g53.NewTSIG(key.Name, key.Secret, key.Algorithm)
+		if err != nil {
+			return nil, fmt.Errorf("new tsig with key %s failed: %s", key.Name, err.Error())
+		}
+
+		cli.tsig = tsig
+	}
+
+	return cli, nil
+}
+
+func (cli *Client) Server() string {
+	return cli.server
+}
+
+func (cli *Client) exchange(msg *g53.Message) (g53.Rcode, error) {
+	if cli.tsig != nil {
+		msg.SetTSIG(cli.tsig)
+	}
+
+	render := g53.NewMsgRender()
+	msg.Rend(render)
+
+	conn, err := net.DialTimeout("udp", cli.server, cli.timeout)
+	if err != nil {
+		return 0, fmt.Errorf("dial dns server %s failed: %s", cli.server, err.Error())
+	}
+
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(cli.timeout)); err != nil {
+		return 0, fmt.Errorf("set deadline failed: %s", err.Error())
+	}
+
+	if _, err := conn.Write(render.Data()); err != nil {
+		return 0, fmt.Errorf("send update to %s failed: %s", cli.server, err.Error())
+	}
+
+	buf := make([]byte, MaxUDPReceivedPacketSize)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return 0, fmt.Errorf("read response from %s failed: %s", cli.server, err.Error())
+		}
+
+		resp, err := g53.MessageFromWire(util.NewInputBuffer(buf[:n]))
+		if err != nil {
+			return 0, fmt.Errorf("parse response from %s failed: %s", cli.server, err.Error())
+		}
+
+		if resp.Header.Id == msg.Header.Id {
+			return resp.Header.Rcode, nil
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package ddns
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+
+	"github.com/cuityhj/g53"
+	"github.com/cuityhj/g53/util"
+)
+
+const RR_DHCID = g53.RRType(49)
+
+type DhcidIdentifierType uint16
+
+// identifier types and digest type defined in RFC 4701
+const (
+	DhcidIdentifierTypeHwAddress DhcidIdentifierType = 0
+	DhcidIdentifierTypeClientId  DhcidIdentifierType = 1
+	DhcidIdentifierTypeDuid      DhcidIdentifierType = 2
+
+	dhcidDigestTypeSHA256 = 1
+)
+
+type DHCID struct {
+	Data []byte
+}
+
+func (d *DHCID) Rend(r *g53.MsgRender) {
+	r.WriteData(d.Data)
+}
+
+func (d *DHCID) ToWire(buf *util.OutputBuffer) {
+	buf.WriteData(d.Data)
+}
+
+func (d *DHCID) Compare(other g53.Rdata) int {
+	if another, ok := other.(*DHCID); ok {
+		return bytes.Compare(d.Data, another.Data)
+	} else {
+		return strings.Compare(d.String(), other.String())
+	}
+}
+
+func (d *DHCID) String() string {
+	return base64.StdEncoding.EncodeToString(d.Data)
+}
+
+// GenDhcid computes the DHCID rdata of RFC 4701, identifier is the client
+// identifier, the hardware type prepended chaddr or the duid
+func GenDhcid(identifierType DhcidIdentifierType, identifier []byte, fqdn string) []byte {
+	hash := sha256.New()
+	hash.Write(identifier)
+	hash.Write(fqdnToCanonicalWire(fqdn))
+	data := make([]byte, 3, 3+sha256.Size)
+	binary.BigEndian.PutUint16(data, uint16(identifierType))
+	data[2] = dhcidDigestTypeSHA256
+	return append(data, hash.Sum(nil)...)
+}
+
+func fqdnToCanonicalWire(fqdn string) []byte {
+	var buf bytes.Buffer
+	for _, label := range strings.Split(strings.TrimSuffix(strings.ToLower(fqdn), "."), ".") {
+		if label == "" {
+			continue
+		}
+
+		buf.WriteByte(byte(len(label)))
+		buf.WriteString(label)
+	}
+
+	buf.WriteByte(0)
+	return buf.Bytes()
+}
@@ -0,0 +1,48 @@
+package ddns
+
+import (
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	ReverseZoneSuffix4 = "in-addr.arpa."
+	ReverseZoneSuffix6 = "ip6.arpa."
+)
+
+func ReverseName(ip net.IP) string {
+	var buf strings.Builder
+	if ipv4 := ip.To4(); ipv4 != nil {
+		for i := net.IPv4len - 1; i >= 0; i-- {
+			buf.WriteString(strconv.Itoa(int(ipv4[i])))
+			buf.WriteByte('.')
+		}
+
+		buf.WriteString(ReverseZoneSuffix4)
+	} else {
+		nibbles := hex.EncodeToString(ip.To16())
+		for i := len(nibbles) - 1; i >= 0; i-- {
+			buf.WriteByte(nibbles[i])
+			buf.WriteByte('.')
+		}
+
+		buf.WriteString(ReverseZoneSuffix6)
+	}
+
+	return buf.String()
+}
+
+func Fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(name)
+	} else {
+		return strings.ToLower(name) + "."
+	}
+}
+
+func IsSubDomain(name, zone string) bool {
+	name, zone = Fqdn(name), Fqdn(zone)
+	return zone == "." || name == zone || strings.HasSuffix(name, "."+zone)
+}
@@ -0,0 +1,217 @@
+package ddns
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/cuityhj/g53"
+)
+
+type ForwardUpdate struct {
+	Zone       string
+	Fqdn       string
+	Ip         net.IP
+	Ttl        uint32
+	Dhcid      []byte
+	CheckDhcid bool
+}
+
+func (u *ForwardUpdate) names() (*g53.Name, *g53.Name, error) {
+	zone, err := g53.NameFromString(u.Zone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid zone %s: %s", u.Zone, err.Error())
+	}
+
+	name, err := g53.NameFromString(u.Fqdn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid fqdn %s: %s", u.Fqdn, err.Error())
+	}
+
+	return zone, name, nil
+}
+
+func (u *ForwardUpdate) addressType() g53.RRType {
+	if u.Ip.To4() != nil {
+		return g53.RR_A
+	} else {
+		return g53.RR_AAAA
+	}
+}
+
+func (u *ForwardUpdate) addressRdata() g53.Rdata {
+	if ip := u.Ip.To4(); ip != nil {
+		return &g53.A{Host: ip}
+	} else {
+		return &g53.AAAA{Host: u.Ip}
+	}
+}
+
+func (u *ForwardUpdate) dhcidRdata() g53.Rdata {
+	return &DHCID{Data: u.Dhcid}
+}
+
+// AddForward adds the address record of fqdn, if CheckDhcid is set, the
+// update follows RFC 4703 and the existing records are replaced only when
+// their dhcid belongs to the same client
+func (cli *Client) AddForward(u *ForwardUpdate) error {
+	zone, name, err := u.names()
+	if err != nil {
+		return err
+	}
+
+	if !u.CheckDhcid {
+		msg := g53.MakeUpdate(zone)
+		msg.AddRRset(updateSection, newRRset(name, u.addressType(), g53.CLASS_ANY, 0))
+		msg.AddRRset(updateSection, newRRset(name, u.addressType(), g53.CLASS_IN, u.Ttl,
+			u.addressRdata()))
+		if len(u.Dhcid) != 0 {
+			msg.AddRRset(updateSection, newRRset(name, RR_DHCID, g53.CLASS_ANY, 0))
+			msg.AddRRset(updateSection, newRRset(name, RR_DHCID, g53.CLASS_IN, u.Ttl,
+				u.dhcidRdata()))
+		}
+
+		return cli.exchangeExpectNoError(msg, u.Fqdn, u.Zone)
+	}
+
+	msg := g53.MakeUpdate(zone)
+	msg.AddRRset(prerequisiteSection, newRRset(name, g53.RR_ANY, g53.CLASS_NONE, 0))
+	msg.AddRRset(updateSection, newRRset(name, u.addressType(), g53.CLASS_IN, u.Ttl,
+		u.addressRdata()))
+	msg.AddRRset(updateSection, newRRset(name, RR_DHCID, g53.CLASS_IN, u.Ttl,
+		u.dhcidRdata()))
+	rcode, err := cli.exchange(msg)
+	if err != nil {
+		return err
+	} else if rcode == g53.R_NOERROR {
+		return nil
+	} else if rcode != g53.R_YXDOMAIN {
+		return rcodeToError(rcode, u.Fqdn, u.Zone)
+	}
+
+	msg = g53.MakeUpdate(zone)
+	msg.AddRRset(prerequisiteSection, newRRset(name, RR_DHCID, g53.CLASS_IN, 0,
+		u.dhcidRdata()))
+	msg.AddRRset(updateSection, newRRset(name, u.addressType(), g53.CLASS_ANY, 0))
+	msg.AddRRset(updateSection, newRRset(name, u.addressType(), g53.CLASS_IN, u.Ttl,
+		u.addressRdata()))
+	if rcode, err = cli.exchange(msg); err != nil {
+		return err
+	} else if rcode == g53.R_NXRRSET {
+		return fmt.Errorf("fqdn %s is in use by another client", u.Fqdn)
+	} else if rcode != g53.R_NOERROR {
+		return rcodeToError(rcode, u.Fqdn, u.Zone)
+	} else {
+		return nil
+	}
+}
+
+// RemoveForward removes the address record of fqdn, and the dhcid record
+// when no address record is left
+func (cli *Client) RemoveForward(u *ForwardUpdate) error {
+	zone, name, err := u.names()
+	if err != nil {
+		return err
+	}
+
+	msg := g53.MakeUpdate(zone)
+	if u.CheckDhcid {
+		msg.AddRRset(prerequisiteSection, newRRset(name, RR_DHCID, g53.CLASS_IN, 0,
+			u.dhcidRdata()))
+	}
+	msg.AddRRset(updateSection, newRRset(name, u.addressType(), g53.CLASS_NONE, 0,
+		u.addressRdata()))
+	if rcode, err := cli.exchange(msg); err != nil {
+		return err
+	} else if rcode == g53.R_NXRRSET {
+		return nil
+	} else if rcode != g53.R_NOERROR {
+		return rcodeToError(rcode, u.Fqdn, u.Zone)
+	}
+
+	if len(u.Dhcid) == 0 {
+		return nil
+	}
+
+	msg = g53.MakeUpdate(zone)
+	if u.CheckDhcid {
+		msg.AddRRset(prerequisiteSection, newRRset(name, RR_DHCID, g53.CLASS_IN, 0,
+			u.dhcidRdata()))
+	}
+	msg.AddRRset(prerequisiteSection, newRRset(name, g53.RR_A, g53.CLASS_NONE, 0))
+	msg.AddRRset(prerequisiteSection, newRRset(name, g53.RR_AAAA, g53.CLASS_NONE, 0))
+	msg.AddRRset(updateSection, newRRset(name, RR_DHCID, g53.CLASS_ANY, 0))
+	if rcode, err := cli.exchange(msg); err != nil {
+		return err
+	} else if rcode != g53.R_NOERROR && rcode != g53.R_NXRRSET && rcode != g53.R_YXRRSET {
+		return rcodeToError(rcode, u.Fqdn, u.Zone)
+	} else {
+		return nil
+	}
+}
+
+func (cli *Client) AddReverse(zone string, ip net.IP, fqdn string, ttl uint32) error {
+	zoneName, name, err := reverseNames(zone, ip)
+	if err != nil {
+		return err
+	}
+
+	target, err := g53.NameFromString(fqdn)
+	if err != nil {
+		return fmt.Errorf("invalid fqdn %s: %s", fqdn, err.Error())
+	}
+
+	msg := g53.MakeUpdate(zoneName)
+	msg.AddRRset(updateSection, newRRset(name, g53.RR_PTR, g53.CLASS_ANY, 0))
+	msg.AddRRset(updateSection, newRRset(name, g53.RR_PTR, g53.CLASS_IN, ttl,
+		&g53.PTR{Name: target}))
+	return cli.exchangeExpectNoError(msg, ReverseName(ip), zone)
+}
+
+func (cli *Client) RemoveReverse(zone string, ip net.IP) error {
+	zoneName, name, err := reverseNames(zone, ip)
+	if err != nil {
+		return err
+	}
+
+	msg := g53.MakeUpdate(zoneName)
+	msg.AddRRset(updateSection, newRRset(name, g53.RR_PTR, g53.CLASS_ANY, 0))
+	return cli.exchangeExpectNoError(msg, ReverseName(ip), zone)
+}
+
+func reverseNames(zone string, ip net.IP) (*g53.Name, *g53.Name, error) {
+	zoneName, err := g53.NameFromString(zone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid zone %s: %s", zone, err.Error())
+	}
+
+	name, err := g53.NameFromString(ReverseName(ip))
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid reverse name of %s: %s", ip.String(), err.Error())
+	}
+
+	return zoneName, name, nil
+}
+
+func newRRset(name *g53.Name, typ g53.RRType, class g53.RRClass, ttl uint32, rdatas ...g53.Rdata) *g53.RRset {
+	return &g53.RRset{
+		Name:   name,
+		Type:   typ,
+		Class:  class,
+		Ttl:    g53.RRTTL(ttl),
+		Rdatas: rdatas,
+	}
+}
+
+func (cli *Client) exchangeExpectNoError(msg *g53.Message, name, zone string) error {
+	if rcode, err := cli.exchange(msg); err != nil {
+		return err
+	} else if rcode != g53.R_NOERROR {
+		return rcodeToError(rcode, name, zone)
+	} else {
+		return nil
+	}
+}
+
+func rcodeToError(rcode g53.Rcode, name, zone string) error {
+	return fmt.Errorf("update %s in zone %s failed with rcode %s", name, zone, rcode.String())
+}
@@ -0,0 +1,393 @@
+package ddns
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testTypeA     = 1
+	testTypePTR   = 12
+	testTypeAAAA  = 28
+	testTypeDHCID = 49
+	testTypeANY   = 255
+
+	testClassIN   = 1
+	testClassNONE = 254
+	testClassANY  = 255
+
+	testRcodeNoError  = 0
+	testRcodeFormErr  = 1
+	testRcodeYXDomain = 6
+	testRcodeYXRRset  = 7
+	testRcodeNXRRset  = 8
+)
+
+type testRR struct {
+	name  string
+	typ   uint16
+	class uint16
+	rdata []byte
+}
+
+// testDnsServer is an in-process dns server which applies rfc 2136 updates
+// on a single in-memory zone, it understands only what the client sends
+type testDnsServer struct {
+	conn    net.PacketConn
+	lock    sync.Mutex
+	records map[string]map[uint16][][]byte
+}
+
+func newTestDnsServer(t *testing.T) *testDnsServer {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen udp failed: %s", err.Error())
+	}
+
+	s := &testDnsServer{conn: conn, records: make(map[string]map[uint16][][]byte)}
+	go s.serve()
+	t.Cleanup(func() { conn.Close() })
+	return s
+}
+
+func (s *testDnsServer) addr() string {
+	return s.conn.LocalAddr().String()
+}
+
+func (s *testDnsServer) serve() {
+	buf := make([]byte, MaxUDPReceivedPacketSize)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+
+		if n < 12 {
+			continue
+		}
+
+		resp := make([]byte, 12)
+		copy(resp, buf[:4])
+		resp[2] |= 0x80
+		resp[3] = s.handleUpdate(buf[:n])
+		s.conn.WriteTo(resp, addr)
+	}
+}
+
+func (s *testDnsServer) handleUpdate(msg []byte) byte {
+	zoneCount := binary.BigEndian.Uint16(msg[4:])
+	prerequisiteCount := binary.BigEndian.Uint16(msg[6:])
+	updateCount := binary.BigEndian.Uint16(msg[8:])
+	offset := 12
+	for i := uint16(0); i < zoneCount; i++ {
+		_, next, err := testReadName(msg, offset)
+		if err != nil {
+			return testRcodeFormErr
+		}
+
+		offset = next + 4
+	}
+
+	prerequisites, offset, err := testReadRRs(msg, offset, prerequisiteCount)
+	if err != nil {
+		return testRcodeFormErr
+	}
+
+	updates, _, err := testReadRRs(msg, offset, updateCount)
+	if err != nil {
+		return testRcodeFormErr
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, rr := range prerequisites {
+		if rcode := s.checkPrerequisite(rr); rcode != testRcodeNoError {
+			return rcode
+		}
+	}
+
+	for _, rr := range updates {
+		s.applyUpdate(rr)
+	}
+
+	return testRcodeNoError
+}
+
+func (s *testDnsServer) checkPrerequisite(rr *testRR) byte {
+	rrsets := s.records[rr.name]
+	switch {
+	case rr.class == testClassNONE && rr.typ == testTypeANY:
+		if len(rrsets) != 0 {
+			return testRcodeYXDomain
+		}
+	case rr.class == testClassNONE:
+		if len(rrsets[rr.typ]) != 0 {
+			return testRcodeYXRRset
+		}
+	case rr.class == testClassIN:
+		if rdatas := rrsets[rr.typ]; len(rdatas) != 1 || !bytes.Equal(rdatas[0], rr.rdata) {
+			return testRcodeNXRRset
+		}
+	}
+
+	return testRcodeNoError
+}
+
+func (s *testDnsServer) applyUpdate(rr *testRR) {
+	rrsets := s.records[rr.name]
+	switch rr.class {
+	case testClassANY:
+		if rr.typ == testTypeANY {
+			delete(s.records, rr.name)
+		} else {
+			delete(rrsets, rr.typ)
+		}
+	case testClassNONE:
+		var rdatas [][]byte
+		for _, rdata := range rrsets[rr.typ] {
+			if !bytes.Equal(rdata, rr.rdata) {
+				rdatas = append(rdatas, rdata)
+			}
+		}
+
+		if len(rdatas) == 0 {
+			delete(rrsets, rr.typ)
+		} else {
+			rrsets[rr.typ] = rdatas
+		}
+	case testClassIN:
+		if rrsets == nil {
+			rrsets = make(map[uint16][][]byte)
+			s.records[rr.name] = rrsets
+		}
+
+		for _, rdata := range rrsets[rr.typ] {
+			if bytes.Equal(rdata, rr.rdata) {
+				return
+			}
+		}
+
+		rrsets[rr.typ] = append(rrsets[rr.typ], rr.rdata)
+	}
+
+	if rrsets != nil && len(rrsets) == 0 {
+		delete(s.records, rr.name)
+	}
+}
+
+func (s *testDnsServer) get(name string, typ uint16) [][]byte {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.records[Fqdn(name)][typ]
+}
+
+func testReadRRs(msg []byte, offset int, count uint16) ([]*testRR, int, error) {
+	var rrs []*testRR
+	for i := uint16(0); i < count; i++ {
+		name, next, err := testReadName(msg, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if next+10 > len(msg) {
+			return nil, 0, errors.New("short rr")
+		}
+
+		rr := &testRR{
+			name:  name,
+			typ:   binary.BigEndian.Uint16(msg[next:]),
+			class: binary.BigEndian.Uint16(msg[next+2:]),
+		}
+		rdataLen := int(binary.BigEndian.Uint16(msg[next+8:]))
+		offset = next + 10 + rdataLen
+		if offset > len(msg) {
+			return nil, 0, errors.New("short rdata")
+		}
+
+		rr.rdata = msg[next+10 : offset]
+		if rr.typ == testTypePTR && rdataLen != 0 {
+			target, _, err := testReadName(msg, next+10)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			rr.rdata = []byte(target)
+		}
+
+		rrs = append(rrs, rr)
+	}
+
+	return rrs, offset, nil
+}
+
+func testReadName(msg []byte, offset int) (string, int, error) {
+	var labels []string
+	next := -1
+	for jumps := 0; jumps < 64; jumps++ {
+		if offset >= len(msg) {
+			return "", 0, errors.New("short name")
+		}
+
+		length := int(msg[offset])
+		switch {
+		case length == 0:
+			if next == -1 {
+				next = offset + 1
+			}
+
+			return strings.ToLower(strings.Join(labels, ".") + "."), next, nil
+		case length&0xc0 == 0xc0:
+			if offset+1 >= len(msg) {
+				return "", 0, errors.New("short pointer")
+			}
+
+			if next == -1 {
+				next = offset + 2
+			}
+
+			offset = int(binary.BigEndian.Uint16(msg[offset:]) & 0x3fff)
+		default:
+			if offset+1+length > len(msg) {
+				return "", 0, errors.New("short label")
+			}
+
+			labels = append(labels, string(msg[offset+1:offset+1+length]))
+			offset += 1 + length
+		}
+	}
+
+	return "", 0, errors.New("too many compression pointers")
+}
+
+func TestForwardUpdate(t *testing.T) {
+	server := newTestDnsServer(t)
+	cli, err := NewClient(server.addr(), nil, time.Second)
+	if err != nil {
+		t.Fatalf("new client failed: %s", err.Error())
+	}
+
+	fqdn := "host.example.com."
+	ip := net.ParseIP("10.0.0.10")
+	dhcid := GenDhcid(DhcidIdentifierTypeClientId, []byte{1, 2, 3}, fqdn)
+	update := &ForwardUpdate{Zone: "example.com.", Fqdn: fqdn, Ip: ip, Ttl: 300,
+		Dhcid: dhcid, CheckDhcid: true}
+	if err := cli.AddForward(update); err != nil {
+		t.Fatalf("add forward failed: %s", err.Error())
+	}
+
+	if rdatas := server.get(fqdn, testTypeA); len(rdatas) != 1 || !bytes.Equal(rdatas[0], ip.To4()) {
+		t.Errorf("a records got %v, want %v", rdatas, ip.To4())
+	}
+
+	if rdatas := server.get(fqdn, testTypeDHCID); len(rdatas) != 1 || !bytes.Equal(rdatas[0], dhcid) {
+		t.Errorf("dhcid records got %v, want %v", rdatas, dhcid)
+	}
+
+	newIp := net.ParseIP("10.0.0.11")
+	update.Ip = newIp
+	if err := cli.AddForward(update); err != nil {
+		t.Fatalf("replace forward of same client failed: %s", err.Error())
+	}
+
+	if rdatas := server.get(fqdn, testTypeA); len(rdatas) != 1 || !bytes.Equal(rdatas[0], newIp.To4()) {
+		t.Errorf("a records got %v, want %v", rdatas, newIp.To4())
+	}
+
+	other := &ForwardUpdate{Zone: "example.com.", Fqdn: fqdn, Ip: net.ParseIP("10.0.0.12"),
+		Ttl: 300, Dhcid: GenDhcid(DhcidIdentifierTypeClientId, []byte{4, 5, 6}, fqdn),
+		CheckDhcid: true}
+	if err := cli.AddForward(other); err == nil {
+		t.Errorf("add forward of another client should fail")
+	}
+
+	if err := cli.RemoveForward(other); err != nil {
+		t.Errorf("remove forward of another client failed: %s", err.Error())
+	} else if rdatas := server.get(fqdn, testTypeA); len(rdatas) != 1 {
+		t.Errorf("remove forward of another client should keep a records, got %v", rdatas)
+	}
+
+	if err := cli.RemoveForward(update); err != nil {
+		t.Fatalf("remove forward failed: %s", err.Error())
+	}
+
+	if rdatas := server.get(fqdn, testTypeA); len(rdatas) != 0 {
+		t.Errorf("a records got %v, want none", rdatas)
+	}
+
+	if rdatas := server.get(fqdn, testTypeDHCID); len(rdatas) != 0 {
+		t.Errorf("dhcid records got %v, want none", rdatas)
+	}
+}
+
+func TestForwardUpdateWithoutCheck(t *testing.T) {
+	server := newTestDnsServer(t)
+	cli, err := NewClient(server.addr(), nil, time.Second)
+	if err != nil {
+		t.Fatalf("new client failed: %s", err.Error())
+	}
+
+	fqdn := "host.example.com."
+	for _, ip := range []string{"2001:db8::1", "2001:db8::2"} {
+		if err := cli.AddForward(&ForwardUpdate{Zone: "example.com.", Fqdn: fqdn,
+			Ip: net.ParseIP(ip), Ttl: 300}); err != nil {
+			t.Fatalf("add forward %s failed: %s", ip, err.Error())
+		}
+	}
+
+	if rdatas := server.get(fqdn, testTypeAAAA); len(rdatas) != 1 ||
+		!bytes.Equal(rdatas[0], net.ParseIP("2001:db8::2")) {
+		t.Errorf("aaaa records got %v, want 2001:db8::2", rdatas)
+	}
+
+	if rdatas := server.get(fqdn, testTypeDHCID); len(rdatas) != 0 {
+		t.Errorf("dhcid records got %v, want none", rdatas)
+	}
+}
+
+func TestReverseUpdate(t *testing.T) {
+	server := newTestDnsServer(t)
+	cli, err := NewClient(server.addr(), nil, time.Second)
+	if err != nil {
+		t.Fatalf("new client failed: %s", err.Error())
+	}
+
+	ip := net.ParseIP("10.0.0.10")
+	if err := cli.AddReverse("10.in-addr.arpa.", ip, "host.example.com.", 300); err != nil {
+		t.Fatalf("add reverse failed: %s", err.Error())
+	}
+
+	if rdatas := server.get(ReverseName(ip), testTypePTR); len(rdatas) != 1 ||
+		string(rdatas[0]) != "host.example.com." {
+		t.Errorf("ptr records got %q, want host.example.com.", rdatas)
+	}
+
+	if err := cli.RemoveReverse("10.in-addr.arpa.", ip); err != nil {
+		t.Fatalf("remove reverse failed: %s", err.Error())
+	}
+
+	if rdatas := server.get(ReverseName(ip), testTypePTR); len(rdatas) != 0 {
+		t.Errorf("ptr records got %q, want none", rdatas)
+	}
+}
+
+func TestReverseName(t *testing.T) {
+	cases := []struct {
+		ip      string
+		reverse string
+	}{
+		{"10.0.1.2", "2.1.0.10.in-addr.arpa."},
+		{"2001:db8::1", "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+	}
+
+	for _, c := range cases {
+		if reverse := ReverseName(net.ParseIP(c.ip)); reverse != c.reverse {
+			t.Errorf("reverse name of %s got %s, want %s", c.ip, reverse, c.reverse)
+		}
+	}
+}
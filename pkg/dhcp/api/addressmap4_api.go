@@ -0,0 +1,29 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type AddressMap4Api struct {
+	Service *service.AddressMap4Service
+}
+
+func NewAddressMap4Api() *AddressMap4Api {
+	return &AddressMap4Api{Service: service.NewAddressMap4Service()}
+}
+
+func (a *AddressMap4Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	addressMaps, nextPageToken, totalCount, err := a.Service.List(
+		ctx.Resource.GetParent().(*resource.Subnet4), ctx.GetFilters())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	service.SetLeasePageHeaders(ctx, nextPageToken, totalCount)
+	return addressMaps, nil
+}
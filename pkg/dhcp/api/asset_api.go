@@ -29,10 +29,11 @@ func (a *AssetApi) Create(ctx *restresource.Context) (restresource.Resource, *re
 }
 
 func (a *AssetApi) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	state, _ := util.GetFilterValueWithEqModifierFromFilters(service.FilterNameState, ctx.GetFilters())
 	duids, err := a.Service.List(util.GenStrConditionsFromFilters(ctx.GetFilters(),
 		service.OrderByCreateTime, resource.SqlColumnName, resource.SqlColumnHwAddress, resource.SqlColumnAssetType,
 		resource.SqlColumnManufacturer, resource.SqlColumnModel, resource.SqlColumnOperatingSystem,
-		resource.SqlColumnAccessNetworkTime))
+		resource.SqlColumnAccessNetworkTime, resource.SqlColumnLastSeenIp, resource.SqlColumnLastSeenSubnet), state)
 	if err != nil {
 		return nil, errorno.HandleAPIError(resterror.ServerError, err)
 	}
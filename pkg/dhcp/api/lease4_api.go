@@ -0,0 +1,26 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type Lease4Api struct {
+	Service *service.Lease4Service
+}
+
+func NewLease4Api() *Lease4Api {
+	return &Lease4Api{Service: service.NewLease4Service()}
+}
+
+func (l *Lease4Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	leases, err := l.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return leases, nil
+}
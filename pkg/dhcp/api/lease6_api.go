@@ -0,0 +1,26 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type Lease6Api struct {
+	Service *service.Lease6Service
+}
+
+func NewLease6Api() *Lease6Api {
+	return &Lease6Api{Service: service.NewLease6Service()}
+}
+
+func (l *Lease6Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	leases, err := l.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return leases, nil
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type LeaseHistoryApi struct {
+	Service *service.LeaseHistoryService
+}
+
+func NewLeaseHistoryApi() *LeaseHistoryApi {
+	return &LeaseHistoryApi{Service: service.NewLeaseHistoryService()}
+}
+
+func (l *LeaseHistoryApi) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	histories, err := l.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return histories, nil
+}
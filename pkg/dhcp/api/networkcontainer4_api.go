@@ -0,0 +1,62 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type NetworkContainer4Api struct {
+	Service *service.NetworkContainer4Service
+}
+
+func NewNetworkContainer4Api() *NetworkContainer4Api {
+	return &NetworkContainer4Api{Service: service.NewNetworkContainer4Service()}
+}
+
+func (n *NetworkContainer4Api) Create(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	container := ctx.Resource.(*resource.NetworkContainer4)
+	if err := n.Service.Create(container); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return container, nil
+}
+
+func (n *NetworkContainer4Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	containers, err := n.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return containers, nil
+}
+
+func (n *NetworkContainer4Api) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	container, err := n.Service.Get(ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return container, nil
+}
+
+func (n *NetworkContainer4Api) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	container := ctx.Resource.(*resource.NetworkContainer4)
+	if err := n.Service.Update(container); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return container, nil
+}
+
+func (n *NetworkContainer4Api) Delete(ctx *restresource.Context) *resterror.APIError {
+	if err := n.Service.Delete(ctx.Resource.GetID()); err != nil {
+		return errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type NetworkContainer6Api struct {
+	Service *service.NetworkContainer6Service
+}
+
+func NewNetworkContainer6Api() *NetworkContainer6Api {
+	return &NetworkContainer6Api{Service: service.NewNetworkContainer6Service()}
+}
+
+func (n *NetworkContainer6Api) Create(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	container := ctx.Resource.(*resource.NetworkContainer6)
+	if err := n.Service.Create(container); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return container, nil
+}
+
+func (n *NetworkContainer6Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	containers, err := n.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return containers, nil
+}
+
+func (n *NetworkContainer6Api) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	container, err := n.Service.Get(ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return container, nil
+}
+
+func (n *NetworkContainer6Api) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	container := ctx.Resource.(*resource.NetworkContainer6)
+	if err := n.Service.Update(container); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return container, nil
+}
+
+func (n *NetworkContainer6Api) Delete(ctx *restresource.Context) *resterror.APIError {
+	if err := n.Service.Delete(ctx.Resource.GetID()); err != nil {
+		return errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type OptionDef6Api struct {
+	Service *service.OptionDef6Service
+}
+
+func NewOptionDef6Api() *OptionDef6Api {
+	return &OptionDef6Api{Service: service.NewOptionDef6Service()}
+}
+
+func (o *OptionDef6Api) Create(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	optionDef := ctx.Resource.(*resource.OptionDef6)
+	if err := o.Service.Create(optionDef); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return optionDef, nil
+}
+
+func (o *OptionDef6Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	optionDefs, err := o.Service.List(util.GenStrConditionsFromFilters(ctx.GetFilters(),
+		"", resource.SqlColumnName, resource.SqlColumnCode))
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return optionDefs, nil
+}
+
+func (o *OptionDef6Api) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	optionDef, err := o.Service.Get(ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return optionDef, nil
+}
+
+func (o *OptionDef6Api) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	optionDef := ctx.Resource.(*resource.OptionDef6)
+	if err := o.Service.Update(optionDef); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return optionDef, nil
+}
+
+func (o *OptionDef6Api) Delete(ctx *restresource.Context) *resterror.APIError {
+	if err := o.Service.Delete(ctx.Resource.GetID()); err != nil {
+		return errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return nil
+}
@@ -68,6 +68,8 @@ func (p *Pool6Api) Action(ctx *restresource.Context) (interface{}, *resterror.AP
 	switch ctx.Resource.GetAction().Name {
 	case resource.ActionNameValidTemplate:
 		return p.actionValidTemplate(ctx)
+	case resource.ActionNameEffectiveConfig:
+		return p.actionEffectiveConfig(ctx)
 	default:
 		return nil, errorno.HandleAPIError(resterror.InvalidAction,
 			errorno.ErrUnknownOpt(errorno.ErrNameDhcpPool, ctx.Resource.GetAction().Name))
@@ -88,3 +90,11 @@ func (p *Pool6Api) actionValidTemplate(ctx *restresource.Context) (interface{},
 		return templatePool, nil
 	}
 }
+
+func (p *Pool6Api) actionEffectiveConfig(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	if config, err := p.Service.EffectiveConfig(ctx.Resource.GetParent().GetID(), ctx.Resource.GetID()); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return config, nil
+	}
+}
@@ -74,6 +74,8 @@ func (s *Reservation6Api) Action(ctx *restresource.Context) (interface{}, *reste
 		return s.actionExportExcel(ctx)
 	case excel.ActionNameExportTemplate:
 		return s.actionExportExcelTemplate()
+	case resource.ActionNameEffectiveConfig:
+		return s.actionEffectiveConfig(ctx)
 	default:
 		return nil, errorno.HandleAPIError(resterror.InvalidAction,
 			errorno.ErrUnknownOpt(errorno.ErrNameDhcpReservation, errorno.ErrName(ctx.Resource.GetAction().Name)))
@@ -123,3 +125,12 @@ func (s *Reservation6Api) actionExportExcelTemplate() (interface{}, *resterror.A
 		return file, nil
 	}
 }
+
+func (s *Reservation6Api) actionEffectiveConfig(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	if config, err := s.Service.EffectiveConfig(ctx.Resource.GetParent().GetID(),
+		ctx.Resource.GetID()); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return config, nil
+	}
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type SearchApi struct {
+	Service *service.SearchService
+}
+
+func NewSearchApi() *SearchApi {
+	return &SearchApi{Service: service.NewSearchService()}
+}
+
+func (s *SearchApi) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	results, err := s.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"github.com/linkingthing/clxone-utils/excel"
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type SharedNetwork6Api struct {
+	Service *service.SharedNetwork6Service
+}
+
+func NewSharedNetwork6Api() *SharedNetwork6Api {
+	return &SharedNetwork6Api{Service: service.NewSharedNetwork6Service()}
+}
+
+func (s *SharedNetwork6Api) Create(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	sharedNetwork6 := ctx.Resource.(*resource.SharedNetwork6)
+	if err := s.Service.Create(sharedNetwork6); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return sharedNetwork6, nil
+}
+
+func (s *SharedNetwork6Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	sharedNetwork6s, err := s.Service.List(
+		util.GenStrConditionsFromFilters(ctx.GetFilters(),
+			util.FilterNameName, util.FilterNameName))
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return sharedNetwork6s, nil
+}
+
+func (s *SharedNetwork6Api) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	sharedNetwork6, err := s.Service.Get(ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return sharedNetwork6, nil
+}
+
+func (s *SharedNetwork6Api) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	sharedNetwork6 := ctx.Resource.(*resource.SharedNetwork6)
+	if err := s.Service.Update(sharedNetwork6); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return sharedNetwork6, nil
+}
+
+func (s *SharedNetwork6Api) Delete(ctx *restresource.Context) *resterror.APIError {
+	if err := s.Service.Delete(ctx.Resource.GetID()); err != nil {
+		return errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return nil
+}
+
+func (s *SharedNetwork6Api) Action(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	switch ctx.Resource.GetAction().Name {
+	case excel.ActionNameImport:
+		return s.actionImportExcel(ctx)
+	case excel.ActionNameExport:
+		return s.actionExportExcel()
+	case excel.ActionNameExportTemplate:
+		return s.actionExportExcelTemplate()
+	default:
+		return nil, errorno.HandleAPIError(resterror.InvalidAction,
+			errorno.ErrUnknownOpt(errorno.ErrNameSharedNetwork, ctx.Resource.GetAction().Name))
+	}
+}
+
+func (s *SharedNetwork6Api) actionImportExcel(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	file, ok := ctx.Resource.GetAction().Input.(*excel.ImportFile)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameSharedNetwork, errorno.ErrNameImport))
+	}
+
+	if resp, err := s.Service.ImportExcel(file); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return resp, nil
+	}
+}
+
+func (s *SharedNetwork6Api) actionExportExcel() (interface{}, *resterror.APIError) {
+	if exportFile, err := s.Service.ExportExcel(); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return exportFile, nil
+	}
+}
+
+func (s *SharedNetwork6Api) actionExportExcelTemplate() (interface{}, *resterror.APIError) {
+	if file, err := s.Service.ExportExcelTemplate(); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return file, nil
+	}
+}
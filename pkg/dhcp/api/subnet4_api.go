@@ -8,6 +8,7 @@ import (
 	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
 	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
 	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
 )
 
 type Subnet4Api struct {
@@ -76,6 +77,24 @@ func (s *Subnet4Api) Action(ctx *restresource.Context) (interface{}, *resterror.
 		return s.actionCouldBeCreated(ctx)
 	case resource.ActionNameListWithSubnets:
 		return s.actionListWithSubnets(ctx)
+	case resource.ActionNameExportLeases:
+		return s.actionExportLeases(ctx)
+	case resource.ActionNameEffectiveConfig:
+		return s.actionEffectiveConfig(ctx)
+	case resource.ActionNameSimulate:
+		return s.actionSimulate(ctx)
+	case resource.ActionNameCreateFromTemplate:
+		return s.actionCreateFromTemplate(ctx)
+	case resource.ActionNameRenumber:
+		return s.actionRenumber(ctx)
+	case resource.ActionNameSplit:
+		return s.actionSplit(ctx)
+	case resource.ActionNameMerge:
+		return s.actionMerge(ctx)
+	case resource.ActionNameAllocate:
+		return s.actionAllocate(ctx)
+	case resource.ActionNameNextFreeAddress:
+		return s.actionNextFreeAddress(ctx)
 	default:
 		return nil, errorno.HandleAPIError(resterror.InvalidAction,
 			errorno.ErrUnknownOpt(errorno.ErrNameNetworkV4, ctx.Resource.GetAction().Name))
@@ -155,3 +174,135 @@ func (s *Subnet4Api) actionListWithSubnets(ctx *restresource.Context) (interface
 
 	return ret, nil
 }
+
+func (s *Subnet4Api) actionExportLeases(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.ExportSubnetLeasesInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameExportLeases))
+	}
+
+	if file, err := s.Service.ExportLeases(input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return file, nil
+	}
+}
+
+func (s *Subnet4Api) actionEffectiveConfig(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	if config, err := s.Service.EffectiveConfig(ctx.Resource.GetID()); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return config, nil
+	}
+}
+
+func (s *Subnet4Api) actionSimulate(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.Simulate4Input)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameSimulate))
+	}
+
+	if result, err := s.Service.Simulate(input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return result, nil
+	}
+}
+
+func (s *Subnet4Api) actionCreateFromTemplate(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.SubnetsFromTemplateInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameCreateFromTemplate))
+	}
+
+	if output, err := s.Service.CreateFromTemplate(input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
+
+func (s *Subnet4Api) actionRenumber(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.RenumberSubnetInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameRenumber))
+	}
+
+	if input.Preview {
+		util.SetIgnoreAuditLog(ctx)
+	}
+
+	if output, err := s.Service.Renumber(ctx.Resource.GetID(), input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
+
+func (s *Subnet4Api) actionSplit(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.SplitSubnetInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameSplit))
+	}
+
+	if output, err := s.Service.Split(ctx.Resource.GetID(), input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
+
+func (s *Subnet4Api) actionMerge(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.MergeSubnetsInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameMerge))
+	}
+
+	if output, err := s.Service.Merge(input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
+
+func (s *Subnet4Api) actionAllocate(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.AllocateSubnetsInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameAllocate))
+	}
+
+	if !input.Create {
+		util.SetIgnoreAuditLog(ctx)
+	}
+
+	if output, err := s.Service.Allocate(input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
+
+func (s *Subnet4Api) actionNextFreeAddress(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.NextFreeAddressInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameNetworkV4, resource.ActionNameNextFreeAddress))
+	}
+
+	if input.CreateMode == "" {
+		util.SetIgnoreAuditLog(ctx)
+	}
+
+	if output, err := s.Service.NextFreeAddress(ctx.Resource.GetID(), input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type SubnetDdns4Api struct {
+	Service *service.SubnetDdns4Service
+}
+
+func NewSubnetDdns4Api() *SubnetDdns4Api {
+	return &SubnetDdns4Api{Service: service.NewSubnetDdns4Service()}
+}
+
+func (d *SubnetDdns4Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	settings, err := d.Service.List(ctx.Resource.GetParent().GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return settings, nil
+}
+
+func (d *SubnetDdns4Api) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	setting, err := d.Service.Get(ctx.Resource.GetParent().GetID(), ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return setting, nil
+}
+
+func (d *SubnetDdns4Api) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	setting := ctx.Resource.(*resource.SubnetDdns4)
+	if err := d.Service.Update(ctx.Resource.GetParent().GetID(), setting); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return setting, nil
+}
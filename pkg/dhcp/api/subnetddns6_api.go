@@ -0,0 +1,45 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type SubnetDdns6Api struct {
+	Service *service.SubnetDdns6Service
+}
+
+func NewSubnetDdns6Api() *SubnetDdns6Api {
+	return &SubnetDdns6Api{Service: service.NewSubnetDdns6Service()}
+}
+
+func (d *SubnetDdns6Api) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	settings, err := d.Service.List(ctx.Resource.GetParent().GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return settings, nil
+}
+
+func (d *SubnetDdns6Api) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	setting, err := d.Service.Get(ctx.Resource.GetParent().GetID(), ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return setting, nil
+}
+
+func (d *SubnetDdns6Api) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	setting := ctx.Resource.(*resource.SubnetDdns6)
+	if err := d.Service.Update(ctx.Resource.GetParent().GetID(), setting); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return setting, nil
+}
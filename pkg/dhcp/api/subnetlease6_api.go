@@ -1,6 +1,7 @@
 package api
 
 import (
+	"github.com/linkingthing/clxone-utils/excel"
 	resterror "github.com/linkingthing/gorest/error"
 	restresource "github.com/linkingthing/gorest/resource"
 
@@ -22,6 +23,22 @@ func (l *SubnetLease6Api) List(ctx *restresource.Context) (interface{}, *resterr
 	ip, _ := util.GetFilterValueWithEqModifierFromFilters(
 		util.FilterNameIp, ctx.GetFilters())
 
+	filter, err := service.LeaseFilterFromFilters(ctx.GetFilters())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat, err)
+	}
+
+	if filter != nil {
+		subnetLease6s, nextPageToken, totalCount, err := l.Service.ListWithFilter(
+			ctx.Resource.GetParent().(*resource.Subnet6), filter)
+		if err != nil {
+			return nil, errorno.HandleAPIError(resterror.ServerError, err)
+		}
+
+		service.SetLeasePageHeaders(ctx, nextPageToken, totalCount)
+		return subnetLease6s, nil
+	}
+
 	subnetLease6s, err := l.Service.List(ctx.Resource.GetParent().(*resource.Subnet6), ip)
 	if err != nil {
 		return nil, errorno.HandleAPIError(resterror.ServerError, err)
@@ -52,6 +69,10 @@ func (l *SubnetLease6Api) Action(ctx *restresource.Context) (interface{}, *reste
 		return l.actionDynamicToReservation(ctx)
 	case resource.ActionFingerprintStatistics:
 		return l.actionFingerprintStatistics(ctx)
+	case excel.ActionNameExport:
+		return l.actionExport(ctx)
+	case resource.ActionForceRenew:
+		return l.actionForceRenew(ctx)
 	default:
 		return nil, errorno.HandleAPIError(resterror.InvalidAction,
 			errorno.ErrUnknownOpt(errorno.ErrNameLease, errorno.ErrName(ctx.Resource.GetAction().Name)))
@@ -108,3 +129,31 @@ func (l *SubnetLease6Api) actionFingerprintStatistics(ctx *restresource.Context)
 		return output, nil
 	}
 }
+
+func (l *SubnetLease6Api) actionExport(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.ExportLeasesInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameLease, errorno.ErrNameExport))
+	}
+
+	if file, err := l.Service.ActionExport(ctx.Resource.GetParent().(*resource.Subnet6), input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return file, nil
+	}
+}
+
+func (l *SubnetLease6Api) actionForceRenew(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	input, ok := ctx.Resource.GetAction().Input.(*resource.ForceRenewLeasesInput)
+	if !ok {
+		return nil, errorno.HandleAPIError(resterror.InvalidFormat,
+			errorno.ErrInvalidFormat(errorno.ErrNameLease, resource.ActionForceRenew))
+	}
+
+	if output, err := l.Service.ActionForceRenew(ctx.Resource.GetParent().(*resource.Subnet6), input); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	} else {
+		return output, nil
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type VendorOption43ProfileApi struct {
+	Service *service.VendorOption43ProfileService
+}
+
+func NewVendorOption43ProfileApi() *VendorOption43ProfileApi {
+	return &VendorOption43ProfileApi{Service: service.NewVendorOption43ProfileService()}
+}
+
+func (v *VendorOption43ProfileApi) Create(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	profile := ctx.Resource.(*resource.VendorOption43Profile)
+	if err := v.Service.Create(profile); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return profile, nil
+}
+
+func (v *VendorOption43ProfileApi) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	profiles, err := v.Service.List(util.GenStrConditionsFromFilters(ctx.GetFilters(),
+		resource.SqlColumnName, resource.SqlColumnName))
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return profiles, nil
+}
+
+func (v *VendorOption43ProfileApi) Get(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	profile, err := v.Service.Get(ctx.Resource.GetID())
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return profile, nil
+}
+
+func (v *VendorOption43ProfileApi) Update(ctx *restresource.Context) (restresource.Resource, *resterror.APIError) {
+	profile := ctx.Resource.(*resource.VendorOption43Profile)
+	if err := v.Service.Update(profile); err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return profile, nil
+}
+
+func (v *VendorOption43ProfileApi) Delete(ctx *restresource.Context) *resterror.APIError {
+	if err := v.Service.Delete(ctx.Resource.GetID()); err != nil {
+		return errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return nil
+}
@@ -25,8 +25,16 @@ func RegisterApi(apiServer *gorest.Server, router gin.IRoutes) error {
 	apiServer.Schemas.MustImport(&Version, resource.ReservedPool4{}, api.NewReservedPool4Api())
 	apiServer.Schemas.MustImport(&Version, resource.Reservation4{}, api.NewReservation4Api())
 	apiServer.Schemas.MustImport(&Version, resource.ClientClass4{}, api.NewClientClass4Api())
+	apiServer.Schemas.MustImport(&Version, resource.OptionDef4{}, api.NewOptionDef4Api())
+	apiServer.Schemas.MustImport(&Version, resource.VendorOption43Profile{}, api.NewVendorOption43ProfileApi())
 	apiServer.Schemas.MustImport(&Version, resource.Pool4Template{}, api.NewPool4TemplateApi())
+	apiServer.Schemas.MustImport(&Version, resource.Subnet4Template{}, api.NewSubnet4TemplateApi())
 	apiServer.Schemas.MustImport(&Version, resource.SubnetLease4{}, api.NewSubnetLease4Api())
+	apiServer.Schemas.MustImport(&Version, resource.AddressMap4{}, api.NewAddressMap4Api())
+	apiServer.Schemas.MustImport(&Version, resource.SubnetDdns4{}, api.NewSubnetDdns4Api())
+	apiServer.Schemas.MustImport(&Version, resource.Lease4{}, api.NewLease4Api())
+	apiServer.Schemas.MustImport(&Version, resource.NetworkContainer4{}, api.NewNetworkContainer4Api())
+	apiServer.Schemas.MustImport(&Version, resource.SharedNetwork6{}, api.NewSharedNetwork6Api())
 	apiServer.Schemas.MustImport(&Version, resource.Subnet6{}, api.NewSubnet6Api())
 	apiServer.Schemas.MustImport(&Version, resource.PdPool{}, api.NewPdPoolApi())
 	apiServer.Schemas.MustImport(&Version, resource.ReservedPdPool{}, api.NewReservedPdPoolApi())
@@ -34,8 +42,13 @@ func RegisterApi(apiServer *gorest.Server, router gin.IRoutes) error {
 	apiServer.Schemas.MustImport(&Version, resource.ReservedPool6{}, api.NewReservedPool6Api())
 	apiServer.Schemas.MustImport(&Version, resource.Reservation6{}, api.NewReservation6Api())
 	apiServer.Schemas.MustImport(&Version, resource.ClientClass6{}, api.NewClientClass6Api())
+	apiServer.Schemas.MustImport(&Version, resource.OptionDef6{}, api.NewOptionDef6Api())
 	apiServer.Schemas.MustImport(&Version, resource.Pool6Template{}, api.NewPool6TemplateApi())
+	apiServer.Schemas.MustImport(&Version, resource.Subnet6Template{}, api.NewSubnet6TemplateApi())
 	apiServer.Schemas.MustImport(&Version, resource.SubnetLease6{}, api.NewSubnetLease6Api())
+	apiServer.Schemas.MustImport(&Version, resource.SubnetDdns6{}, api.NewSubnetDdns6Api())
+	apiServer.Schemas.MustImport(&Version, resource.Lease6{}, api.NewLease6Api())
+	apiServer.Schemas.MustImport(&Version, resource.NetworkContainer6{}, api.NewNetworkContainer6Api())
 
 	apiServer.Schemas.MustImport(&Version, resource.Agent4{}, api.NewAgent4Api())
 	apiServer.Schemas.MustImport(&Version, resource.Agent6{}, api.NewAgent6Api())
@@ -78,6 +91,12 @@ func RegisterApi(apiServer *gorest.Server, router gin.IRoutes) error {
 	apiServer.Schemas.MustImport(&Version, resource.AddressCodeLayoutSegment{}, api.NewAddressCodeLayoutSegmentApi())
 	apiServer.Schemas.MustImport(&Version, resource.Asset{}, api.NewAssetApi())
 	apiServer.Schemas.MustImport(&Version, resource.DhcpOui{}, api.NewDhcpOuiApi())
+	apiServer.Schemas.MustImport(&Version, resource.LeaseHistory{}, api.NewLeaseHistoryApi())
+	apiServer.Schemas.MustImport(&Version, resource.Search{}, api.NewSearchApi())
+
+	if err := service.InitLeaseHistory(); err != nil {
+		return err
+	}
 
 	service.ConsumeLease()
 	return nil
@@ -91,7 +110,15 @@ func PersistentResources() []restresource.Resource {
 		&resource.ReservedPool4{},
 		&resource.Reservation4{},
 		&resource.ClientClass4{},
+		&resource.OptionDef4{},
+		&resource.OptionValue4{},
+		&resource.StaticRoute4{},
+		&resource.VendorOption43Profile{},
+		&resource.VendorSubOption43{},
 		&resource.Pool4Template{},
+		&resource.Subnet4Template{},
+		&resource.NetworkContainer4{},
+		&resource.SharedNetwork6{},
 		&resource.Subnet6{},
 		&resource.Pool6{},
 		&resource.ReservedPool6{},
@@ -99,11 +126,18 @@ func PersistentResources() []restresource.Resource {
 		&resource.ReservedPdPool{},
 		&resource.Reservation6{},
 		&resource.ClientClass6{},
+		&resource.OptionDef6{},
+		&resource.OptionValue6{},
 		&resource.Pool6Template{},
+		&resource.Subnet6Template{},
+		&resource.NetworkContainer6{},
 		&resource.DhcpConfig{},
 		&resource.DhcpFingerprint{},
 		&resource.SubnetLease4{},
 		&resource.SubnetLease6{},
+		&resource.SubnetDdns4{},
+		&resource.SubnetDdns6{},
+		&resource.DdnsRecord{},
 		&resource.Pinger{},
 		&resource.DhcpOui{},
 		&resource.Admit{},
@@ -0,0 +1,20 @@
+package resource
+
+import (
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+type AddressMap4 struct {
+	restresource.ResourceBase `json:",inline"`
+	Address                   string      `json:"address"`
+	AddressType               AddressType `json:"addressType"`
+	LeaseState                string      `json:"leaseState"`
+	HwAddress                 string      `json:"hwAddress"`
+	Hostname                  string      `json:"hostname"`
+	ExpirationTime            string      `json:"expirationTime"`
+	ClientType                string      `json:"clientType"`
+}
+
+func (a AddressMap4) GetParents() []restresource.ResourceKind {
+	return []restresource.ResourceKind{Subnet4{}}
+}
@@ -8,6 +8,7 @@ const (
 	AddressTypeReserve     AddressType = "reserve"
 	AddressTypeExclusion   AddressType = "exclusion"
 	AddressTypeDelegation  AddressType = "delegation"
+	AddressTypeUnmanaged   AddressType = "unmanaged"
 )
 
 func (a AddressType) String() string {
@@ -3,6 +3,7 @@ package resource
 import (
 	"net"
 	"strings"
+	"time"
 	"unicode/utf8"
 
 	"github.com/linkingthing/clxone-utils/excel"
@@ -21,15 +22,28 @@ const (
 	InputMaxLength = 30
 )
 
+type AssetState string
+
+const (
+	AssetStateOnline   AssetState = "online"
+	AssetStateOffline  AssetState = "offline"
+	AssetStateInactive AssetState = "inactive"
+)
+
 type Asset struct {
 	restresource.ResourceBase `json:",inline"`
-	Name                      string `json:"name" rest:"required=true"`
-	HwAddress                 string `json:"hwAddress" db:"uk" rest:"required=true"`
-	AssetType                 string `json:"assetType"`
-	Manufacturer              string `json:"manufacturer"`
-	Model                     string `json:"model"`
-	OperatingSystem           string `json:"operatingSystem"`
-	AccessNetworkTime         string `json:"accessNetworkTime"`
+	Name                      string     `json:"name" rest:"required=true"`
+	HwAddress                 string     `json:"hwAddress" db:"uk" rest:"required=true"`
+	AssetType                 string     `json:"assetType"`
+	Manufacturer              string     `json:"manufacturer"`
+	Model                     string     `json:"model"`
+	OperatingSystem           string     `json:"operatingSystem"`
+	AccessNetworkTime         string     `json:"accessNetworkTime"`
+	LastSeenIp                string     `json:"lastSeenIp" rest:"description=readonly"`
+	LastSeenSubnet            string     `json:"lastSeenSubnet" rest:"description=readonly"`
+	LastSeenTime              string     `json:"lastSeenTime" rest:"description=readonly"`
+	LeaseExpirationTime       string     `json:"leaseExpirationTime" rest:"description=readonly"`
+	State                     AssetState `json:"state" rest:"description=readonly" db:"-"`
 }
 
 type Assets struct {
@@ -105,3 +119,19 @@ func (a *Asset) Diff(another *Asset) bool {
 		a.OperatingSystem != another.OperatingSystem ||
 		a.AccessNetworkTime != another.AccessNetworkTime
 }
+
+func (a *Asset) CalculateState(now time.Time, inactiveDays int) AssetState {
+	lastSeenTime, err := time.Parse(time.RFC3339, a.LastSeenTime)
+	if err != nil {
+		a.State = AssetStateInactive
+	} else if expirationTime, err := time.Parse(time.RFC3339,
+		a.LeaseExpirationTime); err == nil && expirationTime.After(now) {
+		a.State = AssetStateOnline
+	} else if lastSeenTime.Before(now.AddDate(0, 0, -inactiveDays)) {
+		a.State = AssetStateInactive
+	} else {
+		a.State = AssetStateOffline
+	}
+
+	return a.State
+}
@@ -1,6 +1,7 @@
 package resource
 
 import (
+	"github.com/linkingthing/cement/slice"
 	restdb "github.com/linkingthing/gorest/db"
 	restresource "github.com/linkingthing/gorest/resource"
 
@@ -28,14 +29,21 @@ var TableClientClass6 = restdb.ResourceDBType(&ClientClass6{})
 type ClientClass6 struct {
 	restresource.ResourceBase `json:",inline"`
 	Name                      string          `json:"name" rest:"required=true,description=immutable" db:"uk"`
-	Code                      Option6Code     `json:"code" rest:"required=true,description=immutable"`
-	Condition                 OptionCondition `json:"condition" rest:"required=true,options=exists|equal|substring"`
+	Code                      Option6Code     `json:"code" rest:"description=immutable"`
+	Condition                 OptionCondition `json:"condition" rest:"required=true,options=exists|equal|substring|expression"`
 	Regexp                    string          `json:"regexp"`
 	BeginIndex                uint32          `json:"beginIndex"`
+	Expression                string          `json:"expression"`
 	Description               string          `json:"description"`
+	Options                   []*OptionValue6 `json:"options" db:"-"`
 }
 
 func (c *ClientClass6) Validate() error {
+	if c.Condition == OptionConditionExpression {
+		return c.validateExpression()
+	}
+
+	c.Expression = ""
 	if len(c.Name) == 0 || (c.Condition != OptionConditionExists && len(c.Regexp) == 0) {
 		return errorno.ErrEmpty(string(errorno.ErrNameName), string(errorno.ErrNameRegexp))
 	} else if _, ok := code6Localization[c.Code]; !ok {
@@ -46,6 +54,8 @@ func (c *ClientClass6) Validate() error {
 		return errorno.ErrInvalidParams(errorno.ErrNameDescription, c.Description)
 	} else if err := util.ValidateStrings(util.RegexpTypeSlash, c.Regexp); err != nil {
 		return errorno.ErrInvalidParams(errorno.ErrNameRegexp, c.Regexp)
+	} else if err := CheckOptionValue6s(c.Options, nil); err != nil {
+		return err
 	} else {
 		if c.Description == "" {
 			c.Description = code6ToDescription(uint16(c.Code))
@@ -214,3 +224,35 @@ func code6ToDescription(code uint16) string {
 		return "unassigned"
 	}
 }
+
+func (c *ClientClass6) validateExpression() error {
+	if len(c.Name) == 0 {
+		return errorno.ErrEmpty(string(errorno.ErrNameName))
+	} else if err := util.ValidateStrings(util.RegexpTypeCommon, c.Name); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, c.Name)
+	} else if err := util.ValidateStrings(util.RegexpTypeCommon, c.Description); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameDescription, c.Description)
+	} else if err := CheckOptionValue6s(c.Options, nil); err != nil {
+		return err
+	}
+
+	expression, err := ParseClientClassExpression(false, c.Expression)
+	if err != nil {
+		return errorno.ErrInvalidExpression(c.Expression, err.Error())
+	} else if slice.SliceIndex(expression.Members(), c.Name) != -1 {
+		return errorno.ErrInvalidExpression(c.Expression, "class can not be member of itself")
+	}
+
+	c.Code = 0
+	c.BeginIndex = 0
+	c.Regexp = expression.Compile()
+	return nil
+}
+
+func (c *ClientClass6) ParseExpression() (*ClientClassExpression, error) {
+	if c.Condition != OptionConditionExpression {
+		return nil, nil
+	}
+
+	return ParseClientClassExpression(false, c.Expression)
+}
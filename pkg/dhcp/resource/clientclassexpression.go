@@ -0,0 +1,690 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	MaxClientClassExpressionLength = 1024
+	MaxRelay6NestLevel             = 31
+	MaxOption4Code                 = 254
+)
+
+const (
+	exprFieldText   = "text"
+	exprFieldHex    = "hex"
+	exprFieldExists = "exists"
+	exprLengthAll   = "all"
+)
+
+// ClientClassExpressionContext supplies the packet fields referenced by expression,
+// relay options of dhcpv4 are sub options of option 82 and nest is ignored
+type ClientClassExpressionContext interface {
+	Option(code uint32) ([]byte, bool)
+	RelayOption(nest, code uint32) ([]byte, bool)
+	HwAddress() []byte
+	Member(name string) bool
+}
+
+// ClientClassExpression is the boolean expression of client class, such as
+// option[60].text startswith 'PXE' and (relay4[1].hex == 0x0a0b or not member('printers')),
+// it is compiled to the expression syntax which dhcp agent accepts
+type ClientClassExpression struct {
+	root    exprBoolNode
+	members []string
+}
+
+func ParseClientClassExpression(isv4 bool, expression string) (*ClientClassExpression, error) {
+	if len(strings.TrimSpace(expression)) == 0 {
+		return nil, fmt.Errorf("expression is empty")
+	} else if len(expression) > MaxClientClassExpressionLength {
+		return nil, fmt.Errorf("expression length exceeds %d", MaxClientClassExpressionLength)
+	}
+
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &exprParser{isv4: isv4, tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	} else if tok := p.peek(); tok.kind != exprTokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", tok.text, tok.pos)
+	}
+
+	return &ClientClassExpression{root: root, members: p.members}, nil
+}
+
+func (e *ClientClassExpression) Compile() string {
+	return e.root.compile()
+}
+
+func (e *ClientClassExpression) Evaluate(ctx ClientClassExpressionContext) bool {
+	return e.root.eval(ctx)
+}
+
+func (e *ClientClassExpression) Members() []string {
+	return e.members
+}
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenIdent
+	exprTokenNumber
+	exprTokenString
+	exprTokenHex
+	exprTokenPunct
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+func tokenizeExpression(expression string) ([]exprToken, error) {
+	var tokens []exprToken
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '\'':
+			end := strings.IndexByte(expression[i+1:], '\'')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated string at position %d", i)
+			}
+
+			tokens = append(tokens, exprToken{kind: exprTokenString, text: expression[i+1 : i+1+end], pos: i})
+			i += end + 2
+		case c == '=' || c == '!':
+			if i+1 >= len(expression) || expression[i+1] != '=' {
+				return nil, fmt.Errorf("unexpected %c at position %d", c, i)
+			}
+
+			tokens = append(tokens, exprToken{kind: exprTokenPunct, text: expression[i : i+2], pos: i})
+			i += 2
+		case strings.IndexByte("()[].,", c) != -1:
+			tokens = append(tokens, exprToken{kind: exprTokenPunct, text: string(c), pos: i})
+			i++
+		case c == '0' && i+1 < len(expression) && (expression[i+1] == 'x' || expression[i+1] == 'X'):
+			j := i + 2
+			for j < len(expression) && isHexDigit(expression[j]) {
+				j++
+			}
+
+			if j == i+2 {
+				return nil, fmt.Errorf("invalid hex string at position %d", i)
+			}
+
+			tokens = append(tokens, exprToken{kind: exprTokenHex, text: expression[i+2 : j], pos: i})
+			i = j
+		case c == '-' || (c >= '0' && c <= '9'):
+			j := i + 1
+			for j < len(expression) && expression[j] >= '0' && expression[j] <= '9' {
+				j++
+			}
+
+			if c == '-' && j == i+1 {
+				return nil, fmt.Errorf("unexpected - at position %d", i)
+			}
+
+			tokens = append(tokens, exprToken{kind: exprTokenNumber, text: expression[i:j], pos: i})
+			i = j
+		case isIdentByte(c):
+			j := i + 1
+			for j < len(expression) && (isIdentByte(expression[j]) || (expression[j] >= '0' && expression[j] <= '9')) {
+				j++
+			}
+
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, text: strings.ToLower(expression[i:j]), pos: i})
+			i = j
+		default:
+			return nil, fmt.Errorf("unexpected %c at position %d", c, i)
+		}
+	}
+
+	return append(tokens, exprToken{kind: exprTokenEOF, text: "end of expression", pos: len(expression)}), nil
+}
+
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+func isIdentByte(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_'
+}
+
+type exprParser struct {
+	isv4    bool
+	tokens  []exprToken
+	index   int
+	members []string
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.index]
+}
+
+func (p *exprParser) next() exprToken {
+	tok := p.tokens[p.index]
+	if tok.kind != exprTokenEOF {
+		p.index++
+	}
+
+	return tok
+}
+
+func (p *exprParser) accept(kind exprTokenKind, text string) bool {
+	if tok := p.peek(); tok.kind == kind && tok.text == text {
+		p.index++
+		return true
+	}
+
+	return false
+}
+
+func (p *exprParser) expect(kind exprTokenKind, text string) error {
+	if tok := p.next(); tok.kind != kind || (text != "" && tok.text != text) {
+		if text == "" {
+			text = exprTokenKindName(kind)
+		}
+
+		return fmt.Errorf("expect %s but got %s at position %d", text, tok.text, tok.pos)
+	}
+
+	return nil
+}
+
+func exprTokenKindName(kind exprTokenKind) string {
+	switch kind {
+	case exprTokenNumber:
+		return "number"
+	case exprTokenString:
+		return "string"
+	case exprTokenHex:
+		return "hex string"
+	default:
+		return "identifier"
+	}
+}
+
+func (p *exprParser) parseOr() (exprBoolNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(exprTokenIdent, "or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprLogicNode{op: "or", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (exprBoolNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.accept(exprTokenIdent, "and") {
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprLogicNode{op: "and", left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprBoolNode, error) {
+	if p.accept(exprTokenIdent, "not") {
+		node, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &exprNotNode{node: node}, nil
+	}
+
+	if p.accept(exprTokenPunct, "(") {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		} else if err := p.expect(exprTokenPunct, ")"); err != nil {
+			return nil, err
+		}
+
+		return node, nil
+	}
+
+	if p.accept(exprTokenIdent, "member") {
+		if err := p.expect(exprTokenPunct, "("); err != nil {
+			return nil, err
+		}
+
+		tok := p.next()
+		if tok.kind != exprTokenString || len(tok.text) == 0 {
+			return nil, fmt.Errorf("expect class name but got %s at position %d", tok.text, tok.pos)
+		} else if err := p.expect(exprTokenPunct, ")"); err != nil {
+			return nil, err
+		}
+
+		p.members = append(p.members, tok.text)
+		return &exprMemberNode{name: tok.text}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprBoolNode, error) {
+	pos := p.peek().pos
+	left, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	var op string
+	switch {
+	case tok.kind == exprTokenPunct && (tok.text == "==" || tok.text == "!="):
+		op = tok.text
+	case tok.kind == exprTokenIdent && (tok.text == "startswith" || tok.text == "endswith"):
+		op = tok.text
+	default:
+		if option, ok := left.(*exprOptionNode); ok && option.field == exprFieldExists {
+			return &exprExistsNode{option: option}, nil
+		}
+
+		return nil, fmt.Errorf("expect comparison after value at position %d", pos)
+	}
+
+	p.next()
+	right, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	} else if isExistsValue(left) || isExistsValue(right) {
+		return nil, fmt.Errorf("exists can not be compared at position %d", pos)
+	}
+
+	if op == "startswith" || op == "endswith" {
+		literal, ok := right.(*exprLiteralNode)
+		if !ok {
+			return nil, fmt.Errorf("%s requires string or hex string at position %d", op, tok.pos)
+		}
+
+		return &exprAffixNode{prefix: op == "startswith", value: left, literal: literal}, nil
+	}
+
+	return &exprCompareNode{negative: op == "!=", left: left, right: right}, nil
+}
+
+func isExistsValue(value exprValueNode) bool {
+	option, ok := value.(*exprOptionNode)
+	return ok && option.field == exprFieldExists
+}
+
+func (p *exprParser) parseValue() (exprValueNode, error) {
+	tok := p.next()
+	switch tok.kind {
+	case exprTokenString:
+		return &exprLiteralNode{data: []byte(tok.text), text: "'" + tok.text + "'"}, nil
+	case exprTokenHex:
+		text := tok.text
+		if len(text)%2 == 1 {
+			text = "0" + text
+		}
+
+		data, err := hex.DecodeString(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex string at position %d", tok.pos)
+		}
+
+		return &exprLiteralNode{data: data, text: "0x" + tok.text}, nil
+	case exprTokenIdent:
+		switch tok.text {
+		case "option":
+			return p.parseOption(false, 0, tok)
+		case "relay4":
+			if !p.isv4 {
+				return nil, fmt.Errorf("relay4 is only supported by dhcpv4 at position %d", tok.pos)
+			}
+
+			return p.parseOption(true, 0, tok)
+		case "relay6":
+			if p.isv4 {
+				return nil, fmt.Errorf("relay6 is only supported by dhcpv6 at position %d", tok.pos)
+			}
+
+			nest, err := p.parseIndex(0, MaxRelay6NestLevel)
+			if err != nil {
+				return nil, err
+			} else if err := p.expect(exprTokenPunct, "."); err != nil {
+				return nil, err
+			} else if err := p.expect(exprTokenIdent, "option"); err != nil {
+				return nil, err
+			}
+
+			return p.parseOption(true, nest, tok)
+		case "pkt4":
+			if !p.isv4 {
+				return nil, fmt.Errorf("pkt4 is only supported by dhcpv4 at position %d", tok.pos)
+			} else if err := p.expect(exprTokenPunct, "."); err != nil {
+				return nil, err
+			} else if err := p.expect(exprTokenIdent, "mac"); err != nil {
+				return nil, err
+			}
+
+			return &exprMacNode{}, nil
+		case "substring":
+			return p.parseSubstring()
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected %s at position %d", tok.text, tok.pos)
+}
+
+func (p *exprParser) parseOption(relay bool, nest uint32, tok exprToken) (exprValueNode, error) {
+	maxCode := uint32(MaxOption4Code)
+	if !p.isv4 {
+		maxCode = MaxOption6Code
+	}
+
+	code, err := p.parseIndex(1, maxCode)
+	if err != nil {
+		return nil, err
+	} else if err := p.expect(exprTokenPunct, "."); err != nil {
+		return nil, err
+	}
+
+	field := p.next()
+	if field.kind != exprTokenIdent || (field.text != exprFieldText &&
+		field.text != exprFieldHex && field.text != exprFieldExists) {
+		return nil, fmt.Errorf("expect text, hex or exists but got %s at position %d", field.text, field.pos)
+	}
+
+	return &exprOptionNode{
+		isv4:  p.isv4,
+		relay: relay,
+		nest:  nest,
+		code:  code,
+		field: field.text,
+	}, nil
+}
+
+func (p *exprParser) parseIndex(min, max uint32) (uint32, error) {
+	if err := p.expect(exprTokenPunct, "["); err != nil {
+		return 0, err
+	}
+
+	tok := p.next()
+	index, err := strconv.ParseUint(tok.text, 10, 32)
+	if tok.kind != exprTokenNumber || err != nil || uint32(index) < min || uint32(index) > max {
+		return 0, fmt.Errorf("index %s at position %d should in [%d, %d]", tok.text, tok.pos, min, max)
+	} else if err := p.expect(exprTokenPunct, "]"); err != nil {
+		return 0, err
+	}
+
+	return uint32(index), nil
+}
+
+func (p *exprParser) parseSubstring() (exprValueNode, error) {
+	if err := p.expect(exprTokenPunct, "("); err != nil {
+		return nil, err
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	} else if isExistsValue(value) {
+		return nil, fmt.Errorf("substring can not be applied to exists")
+	} else if err := p.expect(exprTokenPunct, ","); err != nil {
+		return nil, err
+	}
+
+	tok := p.next()
+	start, err := strconv.Atoi(tok.text)
+	if tok.kind != exprTokenNumber || err != nil {
+		return nil, fmt.Errorf("invalid substring start %s at position %d", tok.text, tok.pos)
+	} else if err := p.expect(exprTokenPunct, ","); err != nil {
+		return nil, err
+	}
+
+	length := -1
+	if tok = p.next(); tok.kind == exprTokenNumber {
+		if length, err = strconv.Atoi(tok.text); err != nil || length < 0 {
+			return nil, fmt.Errorf("invalid substring length %s at position %d", tok.text, tok.pos)
+		}
+	} else if tok.kind != exprTokenIdent || tok.text != exprLengthAll {
+		return nil, fmt.Errorf("invalid substring length %s at position %d", tok.text, tok.pos)
+	}
+
+	if err := p.expect(exprTokenPunct, ")"); err != nil {
+		return nil, err
+	}
+
+	return &exprSubstringNode{value: value, start: start, length: length}, nil
+}
+
+type exprBoolNode interface {
+	compile() string
+	eval(ctx ClientClassExpressionContext) bool
+}
+
+type exprValueNode interface {
+	compile() string
+	eval(ctx ClientClassExpressionContext) []byte
+}
+
+type exprLogicNode struct {
+	op    string
+	left  exprBoolNode
+	right exprBoolNode
+}
+
+func (n *exprLogicNode) compile() string {
+	return "(" + n.left.compile() + " " + n.op + " " + n.right.compile() + ")"
+}
+
+func (n *exprLogicNode) eval(ctx ClientClassExpressionContext) bool {
+	if n.op == "and" {
+		return n.left.eval(ctx) && n.right.eval(ctx)
+	}
+
+	return n.left.eval(ctx) || n.right.eval(ctx)
+}
+
+type exprNotNode struct {
+	node exprBoolNode
+}
+
+func (n *exprNotNode) compile() string {
+	if _, ok := n.node.(*exprLogicNode); ok {
+		return "not " + n.node.compile()
+	}
+
+	return "not (" + n.node.compile() + ")"
+}
+
+func (n *exprNotNode) eval(ctx ClientClassExpressionContext) bool {
+	return !n.node.eval(ctx)
+}
+
+type exprMemberNode struct {
+	name string
+}
+
+func (n *exprMemberNode) compile() string {
+	return "member('" + n.name + "')"
+}
+
+func (n *exprMemberNode) eval(ctx ClientClassExpressionContext) bool {
+	return ctx.Member(n.name)
+}
+
+type exprExistsNode struct {
+	option *exprOptionNode
+}
+
+func (n *exprExistsNode) compile() string {
+	return n.option.compile()
+}
+
+func (n *exprExistsNode) eval(ctx ClientClassExpressionContext) bool {
+	_, ok := n.option.lookup(ctx)
+	return ok
+}
+
+type exprCompareNode struct {
+	negative bool
+	left     exprValueNode
+	right    exprValueNode
+}
+
+func (n *exprCompareNode) compile() string {
+	compiled := n.left.compile() + " == " + n.right.compile()
+	if n.negative {
+		return "not (" + compiled + ")"
+	}
+
+	return compiled
+}
+
+func (n *exprCompareNode) eval(ctx ClientClassExpressionContext) bool {
+	return bytes.Equal(n.left.eval(ctx), n.right.eval(ctx)) != n.negative
+}
+
+// exprAffixNode is compiled to substring as agent has no startswith and endswith
+type exprAffixNode struct {
+	prefix  bool
+	value   exprValueNode
+	literal *exprLiteralNode
+}
+
+func (n *exprAffixNode) compile() string {
+	if n.prefix {
+		return fmt.Sprintf("substring(%s,0,%d) == %s", n.value.compile(),
+			len(n.literal.data), n.literal.text)
+	}
+
+	return fmt.Sprintf("substring(%s,-%d,all) == %s", n.value.compile(),
+		len(n.literal.data), n.literal.text)
+}
+
+func (n *exprAffixNode) eval(ctx ClientClassExpressionContext) bool {
+	if n.prefix {
+		return bytes.HasPrefix(n.value.eval(ctx), n.literal.data)
+	}
+
+	return bytes.HasSuffix(n.value.eval(ctx), n.literal.data)
+}
+
+type exprOptionNode struct {
+	isv4  bool
+	relay bool
+	nest  uint32
+	code  uint32
+	field string
+}
+
+func (n *exprOptionNode) compile() string {
+	switch {
+	case !n.relay:
+		return fmt.Sprintf("option[%d].%s", n.code, n.field)
+	case n.isv4:
+		return fmt.Sprintf("relay4[%d].%s", n.code, n.field)
+	default:
+		return fmt.Sprintf("relay6[%d].option[%d].%s", n.nest, n.code, n.field)
+	}
+}
+
+func (n *exprOptionNode) lookup(ctx ClientClassExpressionContext) ([]byte, bool) {
+	if n.relay {
+		return ctx.RelayOption(n.nest, n.code)
+	}
+
+	return ctx.Option(n.code)
+}
+
+func (n *exprOptionNode) eval(ctx ClientClassExpressionContext) []byte {
+	data, _ := n.lookup(ctx)
+	return data
+}
+
+type exprMacNode struct{}
+
+func (n *exprMacNode) compile() string {
+	return "pkt4.mac"
+}
+
+func (n *exprMacNode) eval(ctx ClientClassExpressionContext) []byte {
+	return ctx.HwAddress()
+}
+
+type exprLiteralNode struct {
+	data []byte
+	text string
+}
+
+func (n *exprLiteralNode) compile() string {
+	return n.text
+}
+
+func (n *exprLiteralNode) eval(ctx ClientClassExpressionContext) []byte {
+	return n.data
+}
+
+// exprSubstringNode follows the semantics of agent, negative start counts from
+// the end and negative length means all
+type exprSubstringNode struct {
+	value  exprValueNode
+	start  int
+	length int
+}
+
+func (n *exprSubstringNode) compile() string {
+	length := exprLengthAll
+	if n.length >= 0 {
+		length = strconv.Itoa(n.length)
+	}
+
+	return fmt.Sprintf("substring(%s,%d,%s)", n.value.compile(), n.start, length)
+}
+
+func (n *exprSubstringNode) eval(ctx ClientClassExpressionContext) []byte {
+	data := n.value.eval(ctx)
+	start := n.start
+	if start < 0 {
+		start += len(data)
+		if start < 0 {
+			start = 0
+		}
+	}
+
+	if start >= len(data) {
+		return nil
+	}
+
+	end := len(data)
+	if n.length >= 0 && start+n.length < end {
+		end = start + n.length
+	}
+
+	return data[start:end]
+}
@@ -0,0 +1,193 @@
+package resource
+
+import (
+	"testing"
+)
+
+type testExpressionContext struct {
+	options      map[uint32][]byte
+	relayOptions map[uint32]map[uint32][]byte
+	hwAddress    []byte
+	members      map[string]bool
+}
+
+func (c *testExpressionContext) Option(code uint32) ([]byte, bool) {
+	data, ok := c.options[code]
+	return data, ok
+}
+
+func (c *testExpressionContext) RelayOption(nest, code uint32) ([]byte, bool) {
+	data, ok := c.relayOptions[nest][code]
+	return data, ok
+}
+
+func (c *testExpressionContext) HwAddress() []byte {
+	return c.hwAddress
+}
+
+func (c *testExpressionContext) Member(name string) bool {
+	return c.members[name]
+}
+
+func TestClientClassExpressionCompile(t *testing.T) {
+	cases := []struct {
+		name       string
+		isv4       bool
+		expression string
+		compiled   string
+	}{
+		{"and binds tighter than or", true,
+			"option[60].text == 'a' or option[60].text == 'b' and option[12].exists",
+			"(option[60].text == 'a' or (option[60].text == 'b' and option[12].exists))"},
+		{"parentheses", true,
+			"(option[60].text == 'a' or option[60].text == 'b') and option[12].exists",
+			"((option[60].text == 'a' or option[60].text == 'b') and option[12].exists)"},
+		{"not binds tighter than and", true,
+			"not member('a') and member('b')",
+			"(not (member('a')) and member('b'))"},
+		{"not with logic", true,
+			"not (member('a') or member('b'))",
+			"not (member('a') or member('b'))"},
+		{"not equal", true,
+			"option[60].text != 'PXE'",
+			"not (option[60].text == 'PXE')"},
+		{"startswith", true,
+			"option[60].text startswith 'PXE'",
+			"substring(option[60].text,0,3) == 'PXE'"},
+		{"endswith", true,
+			"option[60].hex endswith 0x0a0b",
+			"substring(option[60].hex,-2,all) == 0x0a0b"},
+		{"substring", true,
+			"substring(option[61].hex,1,all) == 0x01",
+			"substring(option[61].hex,1,all) == 0x01"},
+		{"relay4", true,
+			"relay4[1].hex == 0x0a0b",
+			"relay4[1].hex == 0x0a0b"},
+		{"relay6", false,
+			"relay6[0].option[18].text == 'eth0'",
+			"relay6[0].option[18].text == 'eth0'"},
+		{"mac", true,
+			"pkt4.mac == 0x001122334455",
+			"pkt4.mac == 0x001122334455"},
+		{"case insensitive keyword", true,
+			"OPTION[60].TEXT StartsWith 'MSFT' AND Member('a')",
+			"(substring(option[60].text,0,4) == 'MSFT' and member('a'))"},
+	}
+
+	for _, c := range cases {
+		expr, err := ParseClientClassExpression(c.isv4, c.expression)
+		if err != nil {
+			t.Fatalf("%s: parse %s failed: %s", c.name, c.expression, err.Error())
+		}
+
+		if compiled := expr.Compile(); compiled != c.compiled {
+			t.Errorf("%s: compile %s got %s, want %s", c.name, c.expression, compiled, c.compiled)
+		}
+	}
+}
+
+func TestClientClassExpressionEvaluate(t *testing.T) {
+	ctx := &testExpressionContext{
+		options: map[uint32][]byte{
+			60: []byte("PXEClient:Arch:00000"),
+			61: {0x01, 0x00, 0x11, 0x22},
+		},
+		relayOptions: map[uint32]map[uint32][]byte{
+			0: {1: {0x0a, 0x0b}},
+			1: {18: []byte("eth0")},
+		},
+		hwAddress: []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55},
+		members:   map[string]bool{"printers": true},
+	}
+
+	cases := []struct {
+		isv4       bool
+		expression string
+		matched    bool
+	}{
+		{true, "option[60].text startswith 'PXE'", true},
+		{true, "option[60].text startswith 'MSFT'", false},
+		{true, "option[60].text endswith '00000'", true},
+		{true, "option[60].text endswith 0x3030", true},
+		{true, "substring(option[60].text,0,9) == 'PXEClient'", true},
+		{true, "substring(option[60].text,-5,all) == '00000'", true},
+		{true, "substring(option[60].text,-100,3) == 'PXE'", true},
+		{true, "substring(option[60].text,100,all) == ''", true},
+		{true, "option[61].hex == 0x01001122", true},
+		{true, "option[61].hex == 0x1001122", true},
+		{true, "option[12].exists", false},
+		{true, "option[60].exists", true},
+		{true, "relay4[1].hex == 0x0a0b", true},
+		{true, "relay4[2].exists", false},
+		{false, "relay6[1].option[18].text == 'eth0'", true},
+		{false, "relay6[0].option[18].text == 'eth0'", false},
+		{true, "pkt4.mac == 0x001122334455", true},
+		{true, "member('printers')", true},
+		{true, "not member('printers')", false},
+		{true, "member('phones') or member('printers') and option[12].exists", false},
+		{true, "(member('phones') or member('printers')) and option[60].exists", true},
+		{true, "member('phones') or member('printers') and option[60].exists", true},
+		{true, "option[60].text != 'PXE'", true},
+	}
+
+	for _, c := range cases {
+		expr, err := ParseClientClassExpression(c.isv4, c.expression)
+		if err != nil {
+			t.Fatalf("parse %s failed: %s", c.expression, err.Error())
+		}
+
+		if matched := expr.Evaluate(ctx); matched != c.matched {
+			t.Errorf("evaluate %s got %v, want %v", c.expression, matched, c.matched)
+		}
+	}
+}
+
+func TestClientClassExpressionMembers(t *testing.T) {
+	expr, err := ParseClientClassExpression(true, "member('a') and (member('b') or not member('c'))")
+	if err != nil {
+		t.Fatalf("parse failed: %s", err.Error())
+	}
+
+	members := expr.Members()
+	if len(members) != 3 || members[0] != "a" || members[1] != "b" || members[2] != "c" {
+		t.Errorf("members got %v, want [a b c]", members)
+	}
+}
+
+func TestClientClassExpressionInvalid(t *testing.T) {
+	cases := []struct {
+		isv4       bool
+		expression string
+	}{
+		{true, ""},
+		{true, "   "},
+		{true, "option[60].text == 'PXE"},
+		{true, "option[60].text = 'PXE'"},
+		{true, "option[60].text == 'PXE' and"},
+		{true, "(option[60].text == 'PXE'"},
+		{true, "option[60].text == 'PXE')"},
+		{true, "option[60].text"},
+		{true, "option[60].value == 'PXE'"},
+		{true, "option[0].text == 'PXE'"},
+		{true, "option[255].text == 'PXE'"},
+		{true, "option[60].exists == 0x01"},
+		{true, "option[60].text startswith option[61].text"},
+		{true, "option[60].hex == 0x"},
+		{true, "option[60].hex == 0xzz"},
+		{true, "member()"},
+		{true, "member('')"},
+		{true, "relay6[0].option[18].text == 'eth0'"},
+		{false, "relay4[1].hex == 0x0a"},
+		{false, "pkt4.mac == 0x001122334455"},
+		{false, "relay6[32].option[18].text == 'eth0'"},
+		{true, "substring(option[60].text,0,-1) == 'PXE'"},
+		{true, "substring(option[60].exists,0,all) == 'PXE'"},
+		{true, "option[60].text == 'PXE' # comment"},
+	}
+
+	for _, c := range cases {
+		if _, err := ParseClientClassExpression(c.isv4, c.expression); err == nil {
+			t.Errorf("parse %s should fail", c.expression)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package resource
+
+import (
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+var TableDdnsRecord = restdb.ResourceDBType(&DdnsRecord{})
+
+type DdnsRecord struct {
+	restresource.ResourceBase `json:",inline"`
+	IsV6                      bool   `json:"isV6"`
+	SubnetId                  string `json:"subnetId"`
+	Fqdn                      string `json:"fqdn"`
+	Forward                   bool   `json:"forward"`
+	Reverse                   bool   `json:"reverse"`
+	IdentifierType            uint16 `json:"identifierType"`
+	Identifier                string `json:"identifier"`
+	ExpireAt                  int64  `json:"expireAt"`
+}
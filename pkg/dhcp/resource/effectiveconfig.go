@@ -0,0 +1,239 @@
+package resource
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/linkingthing/cement/slice"
+)
+
+const ActionNameEffectiveConfig = "effective_config"
+
+const (
+	ConfigFieldValidLifetime          = "validLifetime"
+	ConfigFieldMaxValidLifetime       = "maxValidLifetime"
+	ConfigFieldMinValidLifetime       = "minValidLifetime"
+	ConfigFieldPreferredLifetime      = "preferredLifetime"
+	ConfigFieldWhiteClientClasses     = "whiteClientClasses"
+	ConfigFieldBlackClientClasses     = "blackClientClasses"
+	ConfigFieldSubnetMask             = "subnetMask"
+	ConfigFieldRouters                = "routers"
+	ConfigFieldDomainServers          = "domainServers"
+	ConfigFieldNextServer             = "nextServer"
+	ConfigFieldTftpServer             = "tftpServer"
+	ConfigFieldBootfile               = "bootfile"
+	ConfigFieldIpv6OnlyPreferred      = "ipv6OnlyPreferred"
+	ConfigFieldCaptivePortalUrl       = "captivePortalUrl"
+	ConfigFieldDomainSearchList       = "domainSearchList"
+	ConfigFieldCapWapACAddresses      = "capWapACAddresses"
+	ConfigFieldStaticRoutes           = "staticRoutes"
+	ConfigFieldInformationRefreshTime = "informationRefreshTime"
+	ConfigFieldV6Prefix64             = "v6Prefix64"
+)
+
+type ConfigSource string
+
+const (
+	ConfigSourceDhcpConfig  ConfigSource = "dhcpconfig"
+	ConfigSourceSubnet      ConfigSource = "subnet"
+	ConfigSourcePool        ConfigSource = "pool"
+	ConfigSourceReservation ConfigSource = "reservation"
+	ConfigSourceClientClass ConfigSource = "clientclass"
+)
+
+type EffectiveConfig struct {
+	SharedNetwork string            `json:"sharedNetwork"`
+	Subnet        string            `json:"subnet"`
+	Pool          string            `json:"pool"`
+	Reservation   string            `json:"reservation"`
+	Values        []*EffectiveValue `json:"values"`
+}
+
+type EffectiveValue struct {
+	Name   string       `json:"name"`
+	Code   uint32       `json:"code"`
+	Value  string       `json:"value"`
+	Source ConfigSource `json:"source"`
+}
+
+func NewEffectiveConfig4(sharedNetwork string, subnet *Subnet4, pool *Pool4, reservation *Reservation4) *EffectiveConfig {
+	config := &EffectiveConfig{SharedNetwork: sharedNetwork, Subnet: subnet.Subnet}
+	config.setSubnet4(subnet)
+	if pool != nil {
+		config.Pool = pool.String()
+		config.setOverrides4(ConfigSourcePool, pool.ValidLifetime, pool.MaxValidLifetime,
+			pool.MinValidLifetime, pool.Routers, pool.DomainServers, pool.NextServer,
+			pool.Bootfile, pool.DomainSearchList, pool.Options)
+	}
+
+	if reservation != nil {
+		config.Reservation = reservation.String()
+		config.setOverrides4(ConfigSourceReservation, reservation.ValidLifetime,
+			reservation.MaxValidLifetime, reservation.MinValidLifetime, reservation.Routers,
+			reservation.DomainServers, reservation.NextServer, reservation.Bootfile,
+			reservation.DomainSearchList, reservation.Options)
+	}
+
+	return config
+}
+
+func (c *EffectiveConfig) setSubnet4(subnet *Subnet4) {
+	c.setInheritable(subnet.InheritedFields, ConfigFieldValidLifetime, 0,
+		uint32ToValue(subnet.ValidLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldMaxValidLifetime, 0,
+		uint32ToValue(subnet.MaxValidLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldMinValidLifetime, 0,
+		uint32ToValue(subnet.MinValidLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldWhiteClientClasses, 0,
+		stringsToValue(subnet.WhiteClientClasses))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldBlackClientClasses, 0,
+		stringsToValue(subnet.BlackClientClasses))
+	c.set(ConfigFieldSubnetMask, 1, subnet.SubnetMask, ConfigSourceSubnet)
+	c.setInheritable(subnet.InheritedFields, ConfigFieldRouters, 3,
+		stringsToValue(subnet.Routers))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldDomainServers, 6,
+		stringsToValue(subnet.DomainServers))
+	c.set(ConfigFieldNextServer, 0, subnet.NextServer, ConfigSourceSubnet)
+	c.set(ConfigFieldTftpServer, 66, subnet.TftpServer, ConfigSourceSubnet)
+	c.set(ConfigFieldBootfile, 67, subnet.Bootfile, ConfigSourceSubnet)
+	c.set(ConfigFieldIpv6OnlyPreferred, 108, uint32ToValue(subnet.Ipv6OnlyPreferred), ConfigSourceSubnet)
+	c.set(ConfigFieldCaptivePortalUrl, 114, subnet.CaptivePortalUrl, ConfigSourceSubnet)
+	c.setInheritable(subnet.InheritedFields, ConfigFieldDomainSearchList, 119,
+		stringsToValue(subnet.DomainSearchList))
+	c.set(ConfigFieldCapWapACAddresses, 138, stringsToValue(subnet.CapWapACAddresses), ConfigSourceSubnet)
+	if len(subnet.StaticRoutes) != 0 {
+		routes := make([]string, 0, len(subnet.StaticRoutes))
+		for _, route := range subnet.StaticRoutes {
+			routes = append(routes, route.String())
+		}
+
+		c.set(ConfigFieldStaticRoutes, Option4CodeClasslessStaticRoute,
+			stringsToValue(routes), ConfigSourceSubnet)
+	}
+
+	c.setOptionValue4s(subnet.Options, ConfigSourceSubnet)
+}
+
+func (c *EffectiveConfig) setOverrides4(source ConfigSource, validLifetime, maxValidLifetime, minValidLifetime uint32, routers, domainServers []string, nextServer, bootfile string, domainSearchList []string, options []*OptionValue4) {
+	c.set(ConfigFieldValidLifetime, 0, uint32ToValue(validLifetime), source)
+	c.set(ConfigFieldMaxValidLifetime, 0, uint32ToValue(maxValidLifetime), source)
+	c.set(ConfigFieldMinValidLifetime, 0, uint32ToValue(minValidLifetime), source)
+	c.set(ConfigFieldRouters, 3, stringsToValue(routers), source)
+	c.set(ConfigFieldDomainServers, 6, stringsToValue(domainServers), source)
+	c.set(ConfigFieldNextServer, 0, nextServer, source)
+	c.set(ConfigFieldBootfile, 67, bootfile, source)
+	c.set(ConfigFieldDomainSearchList, 119, stringsToValue(domainSearchList), source)
+	c.setOptionValue4s(options, source)
+}
+
+func (c *EffectiveConfig) setOptionValue4s(options []*OptionValue4, source ConfigSource) {
+	for _, option := range options {
+		c.set(option.Name, option.Code, option.Value, source)
+	}
+}
+
+func NewEffectiveConfig6(sharedNetwork string, subnet *Subnet6, pool *Pool6, reservation *Reservation6) *EffectiveConfig {
+	config := &EffectiveConfig{SharedNetwork: sharedNetwork, Subnet: subnet.Subnet}
+	config.setSubnet6(subnet)
+	if pool != nil {
+		config.Pool = pool.String()
+		config.setOverrides6(ConfigSourcePool, pool.ValidLifetime, pool.MaxValidLifetime,
+			pool.MinValidLifetime, pool.PreferredLifetime, pool.DomainServers,
+			pool.DomainSearchList, pool.Options)
+	}
+
+	if reservation != nil {
+		config.Reservation = reservation.String()
+		config.setOverrides6(ConfigSourceReservation, reservation.ValidLifetime,
+			reservation.MaxValidLifetime, reservation.MinValidLifetime,
+			reservation.PreferredLifetime, reservation.DomainServers,
+			reservation.DomainSearchList, reservation.Options)
+	}
+
+	return config
+}
+
+func (c *EffectiveConfig) setSubnet6(subnet *Subnet6) {
+	c.setInheritable(subnet.InheritedFields, ConfigFieldValidLifetime, 0,
+		uint32ToValue(subnet.ValidLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldMaxValidLifetime, 0,
+		uint32ToValue(subnet.MaxValidLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldMinValidLifetime, 0,
+		uint32ToValue(subnet.MinValidLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldPreferredLifetime, 0,
+		uint32ToValue(subnet.PreferredLifetime))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldWhiteClientClasses, 0,
+		stringsToValue(subnet.WhiteClientClasses))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldBlackClientClasses, 0,
+		stringsToValue(subnet.BlackClientClasses))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldDomainServers, 23,
+		stringsToValue(subnet.DomainServers))
+	c.setInheritable(subnet.InheritedFields, ConfigFieldDomainSearchList, 24,
+		stringsToValue(subnet.DomainSearchList))
+	c.set(ConfigFieldInformationRefreshTime, 32, uint32ToValue(subnet.InformationRefreshTime), ConfigSourceSubnet)
+	c.set(ConfigFieldCapWapACAddresses, 52, stringsToValue(subnet.CapWapACAddresses), ConfigSourceSubnet)
+	c.set(ConfigFieldCaptivePortalUrl, 103, subnet.CaptivePortalUrl, ConfigSourceSubnet)
+	c.set(ConfigFieldV6Prefix64, 113, subnet.V6Prefix64, ConfigSourceSubnet)
+	c.setOptionValue6s(subnet.Options, ConfigSourceSubnet)
+}
+
+func (c *EffectiveConfig) setOverrides6(source ConfigSource, validLifetime, maxValidLifetime, minValidLifetime, preferredLifetime uint32, domainServers, domainSearchList []string, options []*OptionValue6) {
+	c.set(ConfigFieldValidLifetime, 0, uint32ToValue(validLifetime), source)
+	c.set(ConfigFieldMaxValidLifetime, 0, uint32ToValue(maxValidLifetime), source)
+	c.set(ConfigFieldMinValidLifetime, 0, uint32ToValue(minValidLifetime), source)
+	c.set(ConfigFieldPreferredLifetime, 0, uint32ToValue(preferredLifetime), source)
+	c.set(ConfigFieldDomainServers, 23, stringsToValue(domainServers), source)
+	c.set(ConfigFieldDomainSearchList, 24, stringsToValue(domainSearchList), source)
+	c.setOptionValue6s(options, source)
+}
+
+func (c *EffectiveConfig) setOptionValue6s(options []*OptionValue6, source ConfigSource) {
+	for _, option := range options {
+		c.set(option.Name, option.Code, option.Value, source)
+	}
+}
+
+// setInheritable marks value as from dhcp config if it is in inherited fields of subnet
+func (c *EffectiveConfig) setInheritable(inheritedFields []string, name string, code uint32, value string) {
+	source := ConfigSourceSubnet
+	if slice.SliceIndex(inheritedFields, name) != -1 {
+		source = ConfigSourceDhcpConfig
+	}
+
+	c.set(name, code, value, source)
+}
+
+func (c *EffectiveConfig) get(name string, code uint32) *EffectiveValue {
+	for _, value := range c.Values {
+		if (code != 0 && value.Code == code) || (code == 0 && value.Code == 0 && value.Name == name) {
+			return value
+		}
+	}
+
+	return nil
+}
+
+func (c *EffectiveConfig) set(name string, code uint32, value string, source ConfigSource) {
+	if len(value) == 0 {
+		return
+	}
+
+	effectiveValue := EffectiveValue{Name: name, Code: code, Value: value, Source: source}
+	if old := c.get(name, code); old != nil {
+		*old = effectiveValue
+	} else {
+		c.Values = append(c.Values, &effectiveValue)
+	}
+}
+
+func uint32ToValue(i uint32) string {
+	if i == 0 {
+		return ""
+	}
+
+	return strconv.FormatUint(uint64(i), 10)
+}
+
+func stringsToValue(ss []string) string {
+	return strings.Join(ss, ",")
+}
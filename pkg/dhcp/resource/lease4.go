@@ -0,0 +1,13 @@
+package resource
+
+import (
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+type Lease4 struct {
+	restresource.ResourceBase `json:",inline"`
+	Subnet4                   string        `json:"subnet4"`
+	Subnet                    string        `json:"subnet"`
+	SubnetTags                string        `json:"subnetTags"`
+	Lease                     *SubnetLease4 `json:"lease"`
+}
@@ -0,0 +1,13 @@
+package resource
+
+import (
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+type Lease6 struct {
+	restresource.ResourceBase `json:",inline"`
+	Subnet6                   string        `json:"subnet6"`
+	Subnet                    string        `json:"subnet"`
+	SubnetTags                string        `json:"subnetTags"`
+	Lease                     *SubnetLease6 `json:"lease"`
+}
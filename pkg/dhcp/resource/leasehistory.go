@@ -0,0 +1,60 @@
+package resource
+
+import (
+	"github.com/linkingthing/cement/uuid"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+var TableLeaseHistory = restdb.ResourceDBType(&LeaseHistory{})
+
+const (
+	LeaseHistoryVersion4 = "4"
+	LeaseHistoryVersion6 = "6"
+)
+
+var LeaseHistoryColumns = []string{restdb.IDField, restdb.CreateTimeField, SqlColumnVersion,
+	SqlColumnAddress, SqlColumnHwAddress, SqlColumnDuid, SqlColumnClientId, SqlColumnHostname,
+	SqlColumnRequestType, SqlColumnLeaseState, SqlColumnSubnetId, SqlColumnSubnet, SqlColumnNode,
+	SqlColumnValidLifetime, SqlColumnExpirationTime}
+
+type LeaseHistory struct {
+	restresource.ResourceBase `json:",inline"`
+	Version                   string `json:"version"`
+	Address                   string `json:"address"`
+	HwAddress                 string `json:"hwAddress"`
+	Duid                      string `json:"duid"`
+	ClientId                  string `json:"clientId"`
+	Hostname                  string `json:"hostname"`
+	RequestType               string `json:"requestType"`
+	LeaseState                string `json:"leaseState"`
+	SubnetId                  uint64 `json:"subnetId"`
+	Subnet                    string `json:"subnet"`
+	Node                      string `json:"node"`
+	ValidLifetime             uint32 `json:"validLifetime"`
+	ExpirationTime            string `json:"expirationTime"`
+}
+
+func (h *LeaseHistory) GenCopyValues() []interface{} {
+	if h.GetID() == "" {
+		h.ID, _ = uuid.Gen()
+	}
+
+	return []interface{}{
+		h.GetID(),
+		h.GetCreationTimestamp(),
+		h.Version,
+		h.Address,
+		h.HwAddress,
+		h.Duid,
+		h.ClientId,
+		h.Hostname,
+		h.RequestType,
+		h.LeaseState,
+		h.SubnetId,
+		h.Subnet,
+		h.Node,
+		h.ValidLifetime,
+		h.ExpirationTime,
+	}
+}
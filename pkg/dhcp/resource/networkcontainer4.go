@@ -0,0 +1,65 @@
+package resource
+
+import (
+	"net"
+	"unicode/utf8"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+var TableNetworkContainer4 = restdb.ResourceDBType(&NetworkContainer4{})
+
+type NetworkContainer4 struct {
+	restresource.ResourceBase `json:",inline"`
+	Supernet                  string    `json:"supernet" rest:"required=true,description=immutable" db:"uk"`
+	Ipnet                     net.IPNet `json:"-"`
+	Name                      string    `json:"name" rest:"required=true" db:"uk"`
+	Tags                      string    `json:"tags"`
+	Comment                   string    `json:"comment"`
+	Parent                    string    `json:"parent" rest:"description=readonly" db:"-"`
+	SubnetCount               uint64    `json:"subnetCount" rest:"description=readonly" db:"-"`
+	SharedNetworks            []string  `json:"sharedNetworks" rest:"description=readonly" db:"-"`
+	Capacity                  uint64    `json:"capacity" rest:"description=readonly" db:"-"`
+	UsedRatio                 string    `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64    `json:"usedCount" rest:"description=readonly" db:"-"`
+}
+
+func (n *NetworkContainer4) Validate() error {
+	ipnet, err := gohelperip.ParseCIDRv4(n.Supernet)
+	if err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNamePrefix, n.Supernet)
+	}
+
+	n.Ipnet = *ipnet
+	n.Supernet = ipnet.String()
+	return n.ValidateParams()
+}
+
+func (n *NetworkContainer4) ValidateParams() error {
+	return validateNetworkContainerParams(n.Name, n.Tags, n.Comment)
+}
+
+func validateNetworkContainerParams(name, tags, comment string) error {
+	if len(name) == 0 || util.ValidateStrings(util.RegexpTypeCommon, name) != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, name)
+	}
+
+	if utf8.RuneCountInString(name) > MaxNameLength {
+		return errorno.ErrExceedResourceMaxCount(errorno.ErrNameName, errorno.ErrNameCharacter, MaxNameLength)
+	}
+
+	if err := util.ValidateStrings(util.RegexpTypeComma, tags); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameTags, tags)
+	}
+
+	if err := util.ValidateStrings(util.RegexpTypeComma, comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, comment)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package resource
+
+import (
+	"net"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+var TableNetworkContainer6 = restdb.ResourceDBType(&NetworkContainer6{})
+
+type NetworkContainer6 struct {
+	restresource.ResourceBase `json:",inline"`
+	Supernet                  string    `json:"supernet" rest:"required=true,description=immutable" db:"uk"`
+	Ipnet                     net.IPNet `json:"-"`
+	Name                      string    `json:"name" rest:"required=true" db:"uk"`
+	Tags                      string    `json:"tags"`
+	Comment                   string    `json:"comment"`
+	Parent                    string    `json:"parent" rest:"description=readonly" db:"-"`
+	SubnetCount               uint64    `json:"subnetCount" rest:"description=readonly" db:"-"`
+	SharedNetworks            []string  `json:"sharedNetworks" rest:"description=readonly" db:"-"`
+	Capacity                  string    `json:"capacity" rest:"description=readonly" db:"-"`
+	UsedRatio                 string    `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64    `json:"usedCount" rest:"description=readonly" db:"-"`
+}
+
+func (n *NetworkContainer6) Validate() error {
+	ipnet, err := gohelperip.ParseCIDRv6(n.Supernet)
+	if err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNamePrefix, n.Supernet)
+	}
+
+	n.Ipnet = *ipnet
+	n.Supernet = ipnet.String()
+	return n.ValidateParams()
+}
+
+func (n *NetworkContainer6) ValidateParams() error {
+	return validateNetworkContainerParams(n.Name, n.Tags, n.Comment)
+}
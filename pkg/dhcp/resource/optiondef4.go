@@ -0,0 +1,288 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cuityhj/g53"
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type OptionType string
+
+const (
+	OptionTypeIp      OptionType = "ip"
+	OptionTypeIpList  OptionType = "ip-list"
+	OptionTypeUint8   OptionType = "uint8"
+	OptionTypeUint16  OptionType = "uint16"
+	OptionTypeUint32  OptionType = "uint32"
+	OptionTypeString  OptionType = "string"
+	OptionTypeHex     OptionType = "hex"
+	OptionTypeBoolean OptionType = "boolean"
+	OptionTypeRecord  OptionType = "record"
+
+	OptionValueDelimiter = ","
+	MaxOptionDataLength  = 255
+)
+
+var option4Types = map[OptionType]struct{}{
+	OptionTypeIp:      {},
+	OptionTypeIpList:  {},
+	OptionTypeUint8:   {},
+	OptionTypeUint16:  {},
+	OptionTypeUint32:  {},
+	OptionTypeString:  {},
+	OptionTypeHex:     {},
+	OptionTypeBoolean: {},
+	OptionTypeRecord:  {},
+}
+
+var option4CodesReserved = map[uint32]struct{}{
+	0:   {},
+	50:  {},
+	51:  {},
+	52:  {},
+	53:  {},
+	54:  {},
+	55:  {},
+	57:  {},
+	58:  {},
+	59:  {},
+	61:  {},
+	82:  {},
+	255: {},
+}
+
+var TableOptionDef4 = restdb.ResourceDBType(&OptionDef4{})
+
+type OptionDef4 struct {
+	restresource.ResourceBase `json:",inline"`
+	Name                      string       `json:"name" rest:"required=true,description=immutable" db:"uk"`
+	Code                      uint32       `json:"code" rest:"required=true,description=immutable" db:"uk"`
+	Type                      OptionType   `json:"type" rest:"required=true,description=immutable,options=ip|ip-list|uint8|uint16|uint32|string|hex|boolean|record"`
+	RecordTypes               []OptionType `json:"recordTypes" rest:"description=immutable"`
+	Comment                   string       `json:"comment"`
+}
+
+func (o *OptionDef4) Validate() error {
+	if len(o.Name) == 0 {
+		return errorno.ErrEmpty(string(errorno.ErrNameName))
+	} else if err := util.ValidateStrings(util.RegexpTypeCommon, o.Name); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, o.Name)
+	} else if utf8.RuneCountInString(o.Name) > MaxNameLength {
+		return errorno.ErrExceedResourceMaxCount(errorno.ErrNameName,
+			errorno.ErrNameCharacter, MaxNameLength)
+	} else if o.Code == 0 || o.Code > 254 {
+		return errorno.ErrNotInRange(errorno.ErrNameCode, 1, 254)
+	} else if _, ok := option4CodesReserved[o.Code]; ok {
+		return errorno.ErrReservedOptionCode(o.Code)
+	} else if err := checkOptionTypes(option4Types, o.Type, o.RecordTypes); err != nil {
+		return err
+	}
+
+	return o.ValidateComment()
+}
+
+func (o *OptionDef4) ValidateComment() error {
+	if err := util.ValidateStrings(util.RegexpTypeComma, o.Comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, o.Comment)
+	} else if utf8.RuneCountInString(o.Comment) > MaxCommentLength {
+		return errorno.ErrExceedMaxCount(errorno.ErrNameComment, MaxCommentLength)
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionDef4) Encode(value string) ([]byte, error) {
+	data, err := encodeOptionValue(true, o.Type, o.RecordTypes, value)
+	if err != nil {
+		return nil, errorno.ErrInvalidOptionValue(o.Name, value, err.Error())
+	} else if len(data) > MaxOptionDataLength {
+		return nil, errorno.ErrInvalidOptionValue(o.Name, value,
+			"exceeds max length "+strconv.Itoa(MaxOptionDataLength))
+	} else {
+		return data, nil
+	}
+}
+
+func checkOptionTypes(supportTypes map[OptionType]struct{}, typ OptionType, recordTypes []OptionType) error {
+	if _, ok := supportTypes[typ]; !ok {
+		return errorno.ErrInvalidParams(errorno.ErrNameOptionType, typ)
+	}
+
+	if typ != OptionTypeRecord {
+		if len(recordTypes) != 0 {
+			return errorno.ErrInvalidParams(errorno.ErrNameOptionRecordTypes, recordTypes)
+		}
+
+		return nil
+	}
+
+	if len(recordTypes) < 2 {
+		return errorno.ErrInvalidParams(errorno.ErrNameOptionRecordTypes, recordTypes)
+	}
+
+	for i, recordType := range recordTypes {
+		if _, ok := supportTypes[recordType]; !ok || recordType == OptionTypeRecord ||
+			(isVariableLengthOptionType(recordType) && i != len(recordTypes)-1) {
+			return errorno.ErrInvalidParams(errorno.ErrNameOptionRecordTypes, recordTypes)
+		}
+	}
+
+	return nil
+}
+
+func isVariableLengthOptionType(typ OptionType) bool {
+	switch typ {
+	case OptionTypeUint8, OptionTypeUint16, OptionTypeUint32, OptionTypeBoolean, OptionTypeIp:
+		return false
+	default:
+		return true
+	}
+}
+
+func encodeOptionValue(isv4 bool, typ OptionType, recordTypes []OptionType, value string) ([]byte, error) {
+	if typ != OptionTypeRecord {
+		return encodeOptionField(isv4, typ, value)
+	}
+
+	fields := strings.SplitN(value, OptionValueDelimiter, len(recordTypes))
+	if len(fields) != len(recordTypes) {
+		return nil, errorno.ErrExpect(errorno.ErrNameOptionRecordTypes, len(recordTypes), len(fields))
+	}
+
+	var buf bytes.Buffer
+	for i, recordType := range recordTypes {
+		data, err := encodeOptionField(isv4, recordType, strings.TrimSpace(fields[i]))
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func encodeOptionField(isv4 bool, typ OptionType, value string) ([]byte, error) {
+	if len(value) == 0 {
+		return nil, errorno.ErrEmpty(string(errorno.ErrNameOptionValue))
+	}
+
+	switch typ {
+	case OptionTypeIp:
+		return encodeOptionIp(isv4, value)
+	case OptionTypeIpList:
+		var buf bytes.Buffer
+		for _, ip := range strings.Split(value, OptionValueDelimiter) {
+			data, err := encodeOptionIp(isv4, strings.TrimSpace(ip))
+			if err != nil {
+				return nil, err
+			}
+
+			buf.Write(data)
+		}
+		return buf.Bytes(), nil
+	case OptionTypeUint8:
+		i, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameNumber, value)
+		}
+		return []byte{uint8(i)}, nil
+	case OptionTypeUint16:
+		i, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameNumber, value)
+		}
+		return binary.BigEndian.AppendUint16(nil, uint16(i)), nil
+	case OptionTypeUint32:
+		i, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameNumber, value)
+		}
+		return binary.BigEndian.AppendUint32(nil, uint32(i)), nil
+	case OptionTypeBoolean:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameOptionValue, value)
+		} else if b {
+			return []byte{1}, nil
+		} else {
+			return []byte{0}, nil
+		}
+	case OptionTypeString:
+		if err := util.ValidateStrings(util.RegexpTypeSlash, value); err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameOptionValue, value)
+		}
+		return []byte(value), nil
+	case OptionTypeHex:
+		data, err := hex.DecodeString(strings.NewReplacer(":", "", " ", "").Replace(value))
+		if err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameOptionValue, value)
+		}
+		return data, nil
+	case OptionTypeDomainList:
+		var buf bytes.Buffer
+		for _, domain := range strings.Split(value, OptionValueDelimiter) {
+			data, err := encodeOptionDomain(strings.TrimSpace(domain))
+			if err != nil {
+				return nil, err
+			}
+
+			buf.Write(data)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, errorno.ErrInvalidParams(errorno.ErrNameOptionType, typ)
+	}
+}
+
+func encodeOptionIp(isv4 bool, value string) ([]byte, error) {
+	if ip, err := gohelperip.ParseIP(value, isv4); err != nil {
+		return nil, errorno.ErrInvalidAddress(value)
+	} else if isv4 {
+		return ip.To4(), nil
+	} else {
+		return ip.To16(), nil
+	}
+}
+
+func encodeOptionDomain(domain string) ([]byte, error) {
+	if _, err := g53.NameFromString(domain); err != nil {
+		return nil, errorno.ErrInvalidParams(errorno.ErrNameDomainSearchList, domain)
+	}
+
+	var buf bytes.Buffer
+	if domain = strings.TrimSuffix(domain, "."); len(domain) != 0 {
+		for _, label := range strings.Split(domain, ".") {
+			buf.WriteByte(byte(len(label)))
+			buf.WriteString(label)
+		}
+	}
+
+	buf.WriteByte(0)
+	return buf.Bytes(), nil
+}
+
+func GetOptionDef4s() ([]*OptionDef4, error) {
+	var optionDefs []*OptionDef4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(nil, &optionDefs)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameOptionDef), pg.Error(err).Error())
+	} else {
+		return optionDefs, nil
+	}
+}
@@ -0,0 +1,168 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	OptionTypeDomainList OptionType = "domain-list"
+	OptionTypeVendor     OptionType = "vendor"
+
+	Option6CodeVendorOpts    = 17
+	VendorSubOptionDelimiter = "="
+	MaxOption6Code           = 65535
+	MaxOption6DataLength     = 65535
+)
+
+var option6Types = map[OptionType]struct{}{
+	OptionTypeIp:         {},
+	OptionTypeIpList:     {},
+	OptionTypeUint8:      {},
+	OptionTypeUint16:     {},
+	OptionTypeUint32:     {},
+	OptionTypeString:     {},
+	OptionTypeHex:        {},
+	OptionTypeBoolean:    {},
+	OptionTypeRecord:     {},
+	OptionTypeDomainList: {},
+	OptionTypeVendor:     {},
+}
+
+var option6CodesReserved = map[uint32]struct{}{
+	0:  {},
+	1:  {},
+	2:  {},
+	3:  {},
+	4:  {},
+	5:  {},
+	6:  {},
+	8:  {},
+	9:  {},
+	11: {},
+	12: {},
+	13: {},
+	14: {},
+	18: {},
+	19: {},
+	20: {},
+	25: {},
+	26: {},
+}
+
+var TableOptionDef6 = restdb.ResourceDBType(&OptionDef6{})
+
+type OptionDef6 struct {
+	restresource.ResourceBase `json:",inline"`
+	Name                      string       `json:"name" rest:"required=true,description=immutable"`
+	Code                      uint32       `json:"code" rest:"required=true,description=immutable" db:"uk"`
+	Type                      OptionType   `json:"type" rest:"required=true,description=immutable,options=ip|ip-list|uint8|uint16|uint32|string|hex|boolean|record|domain-list|vendor"`
+	RecordTypes               []OptionType `json:"recordTypes" rest:"description=immutable"`
+	EnterpriseNumber          uint32       `json:"enterpriseNumber" rest:"description=immutable" db:"uk"`
+	Comment                   string       `json:"comment"`
+}
+
+func (o *OptionDef6) Validate() error {
+	if len(o.Name) == 0 {
+		return errorno.ErrEmpty(string(errorno.ErrNameName))
+	} else if err := util.ValidateStrings(util.RegexpTypeCommon, o.Name); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, o.Name)
+	} else if utf8.RuneCountInString(o.Name) > MaxNameLength {
+		return errorno.ErrExceedResourceMaxCount(errorno.ErrNameName,
+			errorno.ErrNameCharacter, MaxNameLength)
+	} else if o.Code == 0 || o.Code > MaxOption6Code {
+		return errorno.ErrNotInRange(errorno.ErrNameCode, 1, MaxOption6Code)
+	} else if _, ok := option6CodesReserved[o.Code]; ok {
+		return errorno.ErrReservedOptionCode(o.Code)
+	} else if (o.Code == Option6CodeVendorOpts) != (o.Type == OptionTypeVendor) {
+		return errorno.ErrInvalidParams(errorno.ErrNameOptionType, o.Type)
+	} else if (o.Type == OptionTypeVendor) != (o.EnterpriseNumber != 0) {
+		return errorno.ErrInvalidParams(errorno.ErrNameEnterpriseNumber, o.EnterpriseNumber)
+	} else if err := checkOptionTypes(option6Types, o.Type, o.RecordTypes); err != nil {
+		return err
+	} else if o.Type == OptionTypeRecord && slices.Contains(o.RecordTypes, OptionTypeVendor) {
+		return errorno.ErrInvalidParams(errorno.ErrNameOptionRecordTypes, o.RecordTypes)
+	}
+
+	return o.ValidateComment()
+}
+
+func (o *OptionDef6) ValidateComment() error {
+	if err := util.ValidateStrings(util.RegexpTypeComma, o.Comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, o.Comment)
+	} else if utf8.RuneCountInString(o.Comment) > MaxCommentLength {
+		return errorno.ErrExceedMaxCount(errorno.ErrNameComment, MaxCommentLength)
+	} else {
+		return nil
+	}
+}
+
+func (o *OptionDef6) Encode(value string) ([]byte, error) {
+	var data []byte
+	var err error
+	if o.Type == OptionTypeVendor {
+		data, err = encodeVendorOption(o.EnterpriseNumber, value)
+	} else {
+		data, err = encodeOptionValue(false, o.Type, o.RecordTypes, value)
+	}
+
+	if err != nil {
+		return nil, errorno.ErrInvalidOptionValue(o.Name, value, err.Error())
+	} else if len(data) > MaxOption6DataLength {
+		return nil, errorno.ErrInvalidOptionValue(o.Name, value,
+			"exceeds max length "+strconv.Itoa(MaxOption6DataLength))
+	} else {
+		return data, nil
+	}
+}
+
+func encodeVendorOption(enterpriseNumber uint32, value string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(binary.BigEndian.AppendUint32(nil, enterpriseNumber))
+	for _, subOption := range strings.Split(value, OptionValueDelimiter) {
+		codeAndData := strings.SplitN(strings.TrimSpace(subOption), VendorSubOptionDelimiter, 2)
+		if len(codeAndData) != 2 {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameOptionValue, subOption)
+		}
+
+		code, err := strconv.ParseUint(strings.TrimSpace(codeAndData[0]), 10, 16)
+		if err != nil || code == 0 {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameCode, codeAndData[0])
+		}
+
+		data, err := encodeOptionField(false, OptionTypeHex, strings.TrimSpace(codeAndData[1]))
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(code)))
+		buf.Write(binary.BigEndian.AppendUint16(nil, uint16(len(data))))
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func GetOptionDef6s() ([]*OptionDef6, error) {
+	var optionDefs []*OptionDef6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(nil, &optionDefs)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameOptionDef), pg.Error(err).Error())
+	} else {
+		return optionDefs, nil
+	}
+}
@@ -0,0 +1,132 @@
+package resource
+
+import (
+	gohelperip "github.com/cuityhj/gohelper/ip"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+func mergeLifetime(lifetime, inherited uint32) uint32 {
+	if lifetime == 0 {
+		return inherited
+	}
+
+	return lifetime
+}
+
+// mergeLifetimes fills lifetimes not overridden with the ones of subnet which has been
+// merged with dhcp config, only the overridden lifetimes are saved and the merged ones
+// are sent to dhcp agent, lifetimes keep zero if none of them is overridden
+func mergeLifetimes(validLifetime, minValidLifetime, maxValidLifetime, subnetValidLifetime, subnetMinValidLifetime, subnetMaxValidLifetime uint32) (uint32, uint32, uint32) {
+	if validLifetime == 0 && minValidLifetime == 0 && maxValidLifetime == 0 {
+		return 0, 0, 0
+	}
+
+	return mergeLifetime(validLifetime, subnetValidLifetime),
+		mergeLifetime(minValidLifetime, subnetMinValidLifetime),
+		mergeLifetime(maxValidLifetime, subnetMaxValidLifetime)
+}
+
+func checkLifetimes(validLifetime, minValidLifetime, maxValidLifetime, subnetValidLifetime, subnetMinValidLifetime, subnetMaxValidLifetime uint32) error {
+	if validLifetime == 0 && minValidLifetime == 0 && maxValidLifetime == 0 {
+		return nil
+	}
+
+	return checkLifetimeValid(mergeLifetimes(validLifetime, minValidLifetime,
+		maxValidLifetime, subnetValidLifetime, subnetMinValidLifetime, subnetMaxValidLifetime))
+}
+
+func mergeLifetimes6(validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime uint32, subnet *Subnet6) (uint32, uint32, uint32, uint32) {
+	if validLifetime == 0 && minValidLifetime == 0 && maxValidLifetime == 0 &&
+		preferredLifetime == 0 {
+		return 0, 0, 0, 0
+	}
+
+	return mergeLifetime(validLifetime, subnet.ValidLifetime),
+		mergeLifetime(minValidLifetime, subnet.MinValidLifetime),
+		mergeLifetime(maxValidLifetime, subnet.MaxValidLifetime),
+		mergeLifetime(preferredLifetime, subnet.PreferredLifetime)
+}
+
+func checkLifetimes6(validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime uint32, subnet *Subnet6) error {
+	if validLifetime == 0 && minValidLifetime == 0 && maxValidLifetime == 0 &&
+		preferredLifetime == 0 {
+		return nil
+	}
+
+	validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime = mergeLifetimes6(
+		validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime, subnet)
+	if err := checkLifetimeValid(validLifetime, minValidLifetime, maxValidLifetime); err != nil {
+		return err
+	}
+
+	return checkPreferredLifetime(preferredLifetime, validLifetime, minValidLifetime)
+}
+
+func checkOptionOverrides4(routers, domainServers, domainSearchList []string, nextServer, bootfile string) error {
+	if err := checkIpsValidWithVersion(true, routers); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameGateway, routers)
+	}
+
+	if err := checkIpsValidWithVersion(true, domainServers); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameDNS, domainServers)
+	}
+
+	if err := checkDomainSearchList(domainSearchList); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameDomainSearchList, domainSearchList)
+	}
+
+	if nextServer != "" {
+		if err := gohelperip.CheckIPv4sValid(nextServer); err != nil {
+			return errorno.ErrInvalidAddress(nextServer)
+		}
+	}
+
+	return checkTFTPValid("", bootfile)
+}
+
+func overrideOptionCodes4(routers, domainServers, domainSearchList []string, bootfile string) map[uint32]errorno.ErrName {
+	codes := make(map[uint32]errorno.ErrName)
+	if len(routers) != 0 {
+		codes[3] = errorno.ErrNameGateway
+	}
+
+	if len(domainServers) != 0 {
+		codes[6] = errorno.ErrNameDNS
+	}
+
+	if len(bootfile) != 0 {
+		codes[67] = errorno.ErrNameBootFile
+	}
+
+	if len(domainSearchList) != 0 {
+		codes[119] = errorno.ErrNameDomainSearchList
+	}
+
+	return codes
+}
+
+func checkOptionOverrides6(domainServers, domainSearchList []string) error {
+	if err := checkIpsValidWithVersion(false, domainServers); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameDNS, domainServers)
+	}
+
+	if err := checkDomainSearchList(domainSearchList); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameDomainSearchList, domainSearchList)
+	}
+
+	return nil
+}
+
+func overrideOptionCodes6(domainServers, domainSearchList []string) map[uint32]errorno.ErrName {
+	codes := make(map[uint32]errorno.ErrName)
+	if len(domainServers) != 0 {
+		codes[23] = errorno.ErrNameDNS
+	}
+
+	if len(domainSearchList) != 0 {
+		codes[24] = errorno.ErrNameDomainSearchList
+	}
+
+	return codes
+}
@@ -0,0 +1,87 @@
+package resource
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type OptionScope string
+
+const (
+	OptionScopeSubnet4         OptionScope = "subnet4"
+	OptionScopePool4           OptionScope = "pool4"
+	OptionScopeReservation4    OptionScope = "reservation4"
+	OptionScopeClientClass4    OptionScope = "clientclass4"
+	OptionScopeSubnet4Template OptionScope = "subnet4template"
+
+	OptionDataTypeHex = "hex"
+)
+
+var TableOptionValue4 = restdb.ResourceDBType(&OptionValue4{})
+
+type OptionValue4 struct {
+	restresource.ResourceBase `json:"-"`
+	Scope                     OptionScope `json:"-"`
+	ScopeId                   string      `json:"-"`
+	Subnet4                   string      `json:"-"`
+	Name                      string      `json:"name"`
+	Code                      uint32      `json:"code" rest:"description=readonly"`
+	Value                     string      `json:"value"`
+	Data                      string      `json:"-"`
+}
+
+func CheckOptionValue4s(values []*OptionValue4, optionDefs []*OptionDef4) (err error) {
+	if len(values) == 0 {
+		return
+	}
+
+	if len(optionDefs) == 0 {
+		if optionDefs, err = GetOptionDef4s(); err != nil {
+			return
+		}
+	}
+
+	optionDefMap := make(map[string]*OptionDef4, len(optionDefs))
+	for _, optionDef := range optionDefs {
+		optionDefMap[optionDef.Name] = optionDef
+	}
+
+	codes := make(map[uint32]struct{}, len(values))
+	for _, value := range values {
+		optionDef, ok := optionDefMap[value.Name]
+		if !ok {
+			return errorno.ErrNotFound(errorno.ErrNameOptionDef, value.Name)
+		}
+
+		if _, ok := codes[optionDef.Code]; ok {
+			return errorno.ErrDuplicate(errorno.ErrNameOptionDef, value.Name)
+		}
+
+		data, err := optionDef.Encode(value.Value)
+		if err != nil {
+			return err
+		}
+
+		codes[optionDef.Code] = struct{}{}
+		value.Code = optionDef.Code
+		value.Data = hex.EncodeToString(data)
+	}
+
+	return nil
+}
+
+func checkOptionValue4sConflictWithCodes(values []*OptionValue4, codes map[uint32]errorno.ErrName) error {
+	for _, value := range values {
+		if errName, ok := codes[value.Code]; ok {
+			return errorno.ErrConflict(errorno.ErrNameOptionDef, errName,
+				value.Name, strconv.FormatUint(uint64(value.Code), 10))
+		}
+	}
+
+	return nil
+}
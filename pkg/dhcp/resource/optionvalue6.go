@@ -0,0 +1,85 @@
+package resource
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+const (
+	OptionScopeSubnet6         OptionScope = "subnet6"
+	OptionScopePool6           OptionScope = "pool6"
+	OptionScopePdPool          OptionScope = "pdpool"
+	OptionScopeReservation6    OptionScope = "reservation6"
+	OptionScopeClientClass6    OptionScope = "clientclass6"
+	OptionScopeSubnet6Template OptionScope = "subnet6template"
+)
+
+var TableOptionValue6 = restdb.ResourceDBType(&OptionValue6{})
+
+type OptionValue6 struct {
+	restresource.ResourceBase `json:"-"`
+	Scope                     OptionScope `json:"-"`
+	ScopeId                   string      `json:"-"`
+	Subnet6                   string      `json:"-"`
+	Name                      string      `json:"name"`
+	Code                      uint32      `json:"code" rest:"description=readonly"`
+	Value                     string      `json:"value"`
+	Data                      string      `json:"-"`
+}
+
+func CheckOptionValue6s(values []*OptionValue6, optionDefs []*OptionDef6) (err error) {
+	if len(values) == 0 {
+		return
+	}
+
+	if len(optionDefs) == 0 {
+		if optionDefs, err = GetOptionDef6s(); err != nil {
+			return
+		}
+	}
+
+	optionDefMap := make(map[string]*OptionDef6, len(optionDefs))
+	for _, optionDef := range optionDefs {
+		optionDefMap[optionDef.Name] = optionDef
+	}
+
+	codes := make(map[[2]uint32]struct{}, len(values))
+	for _, value := range values {
+		optionDef, ok := optionDefMap[value.Name]
+		if !ok {
+			return errorno.ErrNotFound(errorno.ErrNameOptionDef, value.Name)
+		}
+
+		code := [2]uint32{optionDef.Code, optionDef.EnterpriseNumber}
+		if _, ok := codes[code]; ok {
+			return errorno.ErrDuplicate(errorno.ErrNameOptionDef, value.Name)
+		}
+
+		data, err := optionDef.Encode(value.Value)
+		if err != nil {
+			return err
+		}
+
+		codes[code] = struct{}{}
+		value.Code = optionDef.Code
+		value.Data = hex.EncodeToString(data)
+	}
+
+	return nil
+}
+
+func checkOptionValue6sConflictWithCodes(values []*OptionValue6, codes map[uint32]errorno.ErrName) error {
+	for _, value := range values {
+		if errName, ok := codes[value.Code]; ok {
+			return errorno.ErrConflict(errorno.ErrNameOptionDef, errName,
+				value.Name, strconv.FormatUint(uint64(value.Code), 10))
+		}
+	}
+
+	return nil
+}
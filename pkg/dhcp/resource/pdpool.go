@@ -17,15 +17,20 @@ var TablePdPool = restdb.ResourceDBType(&PdPool{})
 
 type PdPool struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet6                   string    `json:"-" db:"ownby"`
-	Prefix                    string    `json:"prefix" rest:"required=true"`
-	PrefixLen                 uint32    `json:"prefixLen" rest:"required=true"`
-	PrefixIpnet               net.IPNet `json:"-"`
-	DelegatedLen              uint32    `json:"delegatedLen" rest:"required=true"`
-	Capacity                  string    `json:"capacity" rest:"description=readonly"`
-	UsedRatio                 string    `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64    `json:"usedCount" rest:"description=readonly" db:"-"`
-	Comment                   string    `json:"comment"`
+	Subnet6                   string          `json:"-" db:"ownby"`
+	Prefix                    string          `json:"prefix" rest:"required=true"`
+	PrefixLen                 uint32          `json:"prefixLen" rest:"required=true"`
+	PrefixIpnet               net.IPNet       `json:"-"`
+	DelegatedLen              uint32          `json:"delegatedLen" rest:"required=true"`
+	Capacity                  string          `json:"capacity" rest:"description=readonly"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
+	Comment                   string          `json:"comment"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	Options                   []*OptionValue6 `json:"options" db:"-"`
 }
 
 func (pdpool PdPool) GetParents() []restresource.ResourceKind {
@@ -46,6 +51,14 @@ func (pdpool *PdPool) Validate() error {
 		return err
 	}
 
+	if err := CheckOptionValue6s(pdpool.Options, nil); err != nil {
+		return err
+	}
+
+	if err := pdpool.ValidateClientClasses(); err != nil {
+		return err
+	}
+
 	pdpool.Prefix = prefix.String()
 	pdpool.PrefixIpnet = ipToIPNet(prefix, pdpool.PrefixLen)
 	pdpool.Capacity = capacity
@@ -133,3 +146,15 @@ func (pdpool *PdPool) SubCapacityWithBigInt(capacityForSub *big.Int) string {
 	pdpool.Capacity = SubCapacityWithBigInt(pdpool.Capacity, capacityForSub)
 	return pdpool.Capacity
 }
+
+func (pdpool *PdPool) ValidateClientClasses() error {
+	if err := checkClientClassStrategy(pdpool.WhiteClientClassStrategy, len(pdpool.WhiteClientClasses) != 0); err != nil {
+		return err
+	}
+
+	if err := checkClientClassStrategy(pdpool.BlackClientClassStrategy, len(pdpool.BlackClientClasses) != 0); err != nil {
+		return err
+	}
+
+	return checkClientClass6s(pdpool.WhiteClientClasses, pdpool.BlackClientClasses, nil)
+}
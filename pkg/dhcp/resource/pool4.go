@@ -16,16 +16,29 @@ var TablePool4 = restdb.ResourceDBType(&Pool4{})
 
 type Pool4 struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet4                   string `json:"-" db:"ownby"`
-	BeginAddress              string `json:"beginAddress" rest:"description=immutable"`
-	BeginIp                   net.IP `json:"-"`
-	EndAddress                string `json:"endAddress" rest:"description=immutable"`
-	EndIp                     net.IP `json:"-"`
-	Capacity                  uint64 `json:"capacity" rest:"description=readonly"`
-	UsedRatio                 string `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64 `json:"usedCount" rest:"description=readonly" db:"-"`
-	Template                  string `json:"template" db:"-"`
-	Comment                   string `json:"comment"`
+	Subnet4                   string          `json:"-" db:"ownby"`
+	BeginAddress              string          `json:"beginAddress" rest:"description=immutable"`
+	BeginIp                   net.IP          `json:"-"`
+	EndAddress                string          `json:"endAddress" rest:"description=immutable"`
+	EndIp                     net.IP          `json:"-"`
+	Capacity                  uint64          `json:"capacity" rest:"description=readonly"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
+	Template                  string          `json:"template" db:"-"`
+	Comment                   string          `json:"comment"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	Routers                   []string        `json:"routers"`
+	DomainServers             []string        `json:"domainServers"`
+	NextServer                string          `json:"nextServer"`
+	Bootfile                  string          `json:"bootfile"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	Options                   []*OptionValue4 `json:"options" db:"-"`
 }
 
 func (p Pool4) GetParents() []restresource.ResourceKind {
@@ -50,6 +63,10 @@ func (p Pool4) GetActions() []restresource.Action {
 			Input:  &TemplateInfo{},
 			Output: &TemplatePool{},
 		},
+		restresource.Action{
+			Name:   ActionNameEffectiveConfig,
+			Output: &EffectiveConfig{},
+		},
 	}
 }
 
@@ -95,6 +112,14 @@ func (p *Pool4) Validate() error {
 		return errorno.ErrInvalidParams(errorno.ErrNameComment, p.Comment)
 	}
 
+	if err := p.ValidateOptions(); err != nil {
+		return err
+	}
+
+	if err := p.ValidateClientClasses(); err != nil {
+		return err
+	}
+
 	if p.Template != "" {
 		return nil
 	}
@@ -102,6 +127,30 @@ func (p *Pool4) Validate() error {
 	return p.ValidateAddress()
 }
 
+func (p *Pool4) ValidateOptions() error {
+	if err := CheckOptionValue4s(p.Options, nil); err != nil {
+		return err
+	}
+
+	if err := checkOptionOverrides4(p.Routers, p.DomainServers, p.DomainSearchList,
+		p.NextServer, p.Bootfile); err != nil {
+		return err
+	}
+
+	return checkOptionValue4sConflictWithCodes(p.Options, overrideOptionCodes4(p.Routers,
+		p.DomainServers, p.DomainSearchList, p.Bootfile))
+}
+
+func (p *Pool4) CheckLifetimes(subnet *Subnet4) error {
+	return checkLifetimes(p.ValidLifetime, p.MinValidLifetime, p.MaxValidLifetime,
+		subnet.ValidLifetime, subnet.MinValidLifetime, subnet.MaxValidLifetime)
+}
+
+func (p *Pool4) MergedLifetimes(subnet *Subnet4) (uint32, uint32, uint32) {
+	return mergeLifetimes(p.ValidLifetime, p.MinValidLifetime, p.MaxValidLifetime,
+		subnet.ValidLifetime, subnet.MinValidLifetime, subnet.MaxValidLifetime)
+}
+
 func (p *Pool4) ParseAddressWithTemplate(tx restdb.Transaction, subnet *Subnet4) error {
 	if p.Template == "" {
 		return nil
@@ -185,3 +234,15 @@ func calculateIpv4Pool4Capacity(beginIp, endIp net.IP) (uint64, error) {
 		return uint64(endUint32) - uint64(beginUint32) + 1, nil
 	}
 }
+
+func (p *Pool4) ValidateClientClasses() error {
+	if err := checkClientClassStrategy(p.WhiteClientClassStrategy, len(p.WhiteClientClasses) != 0); err != nil {
+		return err
+	}
+
+	if err := checkClientClassStrategy(p.BlackClientClassStrategy, len(p.BlackClientClasses) != 0); err != nil {
+		return err
+	}
+
+	return checkClientClass4s(p.WhiteClientClasses, p.BlackClientClasses, nil)
+}
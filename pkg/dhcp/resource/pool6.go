@@ -18,16 +18,27 @@ var TablePool6 = restdb.ResourceDBType(&Pool6{})
 
 type Pool6 struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet6                   string `json:"-" db:"ownby"`
-	BeginAddress              string `json:"beginAddress" rest:"description=immutable"`
-	BeginIp                   net.IP `json:"-"`
-	EndAddress                string `json:"endAddress" rest:"description=immutable"`
-	EndIp                     net.IP `json:"-"`
-	Capacity                  string `json:"capacity" rest:"description=readonly"`
-	UsedRatio                 string `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64 `json:"usedCount" rest:"description=readonly" db:"-"`
-	Template                  string `json:"template" db:"-"`
-	Comment                   string `json:"comment"`
+	Subnet6                   string          `json:"-" db:"ownby"`
+	BeginAddress              string          `json:"beginAddress" rest:"description=immutable"`
+	BeginIp                   net.IP          `json:"-"`
+	EndAddress                string          `json:"endAddress" rest:"description=immutable"`
+	EndIp                     net.IP          `json:"-"`
+	Capacity                  string          `json:"capacity" rest:"description=readonly"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
+	Template                  string          `json:"template" db:"-"`
+	Comment                   string          `json:"comment"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	PreferredLifetime         uint32          `json:"preferredLifetime"`
+	DomainServers             []string        `json:"domainServers"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	Options                   []*OptionValue6 `json:"options" db:"-"`
 }
 
 func (p Pool6) GetParents() []restresource.ResourceKind {
@@ -41,6 +52,10 @@ func (p Pool6) GetActions() []restresource.Action {
 			Input:  &TemplateInfo{},
 			Output: &TemplatePool{},
 		},
+		restresource.Action{
+			Name:   ActionNameEffectiveConfig,
+			Output: &EffectiveConfig{},
+		},
 	}
 }
 
@@ -86,6 +101,14 @@ func (p *Pool6) Validate() error {
 		return errorno.ErrInvalidParams(errorno.ErrNameComment, p.Comment)
 	}
 
+	if err := p.ValidateOptions(); err != nil {
+		return err
+	}
+
+	if err := p.ValidateClientClasses(); err != nil {
+		return err
+	}
+
 	if p.Template != "" {
 		return nil
 	}
@@ -93,6 +116,29 @@ func (p *Pool6) Validate() error {
 	return p.ValidateAddress()
 }
 
+func (p *Pool6) ValidateOptions() error {
+	if err := CheckOptionValue6s(p.Options, nil); err != nil {
+		return err
+	}
+
+	if err := checkOptionOverrides6(p.DomainServers, p.DomainSearchList); err != nil {
+		return err
+	}
+
+	return checkOptionValue6sConflictWithCodes(p.Options,
+		overrideOptionCodes6(p.DomainServers, p.DomainSearchList))
+}
+
+func (p *Pool6) CheckLifetimes(subnet *Subnet6) error {
+	return checkLifetimes6(p.ValidLifetime, p.MinValidLifetime, p.MaxValidLifetime,
+		p.PreferredLifetime, subnet)
+}
+
+func (p *Pool6) MergedLifetimes(subnet *Subnet6) (uint32, uint32, uint32, uint32) {
+	return mergeLifetimes6(p.ValidLifetime, p.MinValidLifetime, p.MaxValidLifetime,
+		p.PreferredLifetime, subnet)
+}
+
 func (p *Pool6) ParseAddressWithTemplate(tx restdb.Transaction, subnet *Subnet6) error {
 	if p.Template == "" {
 		return nil
@@ -194,3 +240,15 @@ func (p *Pool6) SubCapacityWithBigInt(capacityForSub *big.Int) string {
 	p.Capacity = SubCapacityWithBigInt(p.Capacity, capacityForSub)
 	return p.Capacity
 }
+
+func (p *Pool6) ValidateClientClasses() error {
+	if err := checkClientClassStrategy(p.WhiteClientClassStrategy, len(p.WhiteClientClasses) != 0); err != nil {
+		return err
+	}
+
+	if err := checkClientClassStrategy(p.BlackClientClassStrategy, len(p.BlackClientClasses) != 0); err != nil {
+		return err
+	}
+
+	return checkClientClass6s(p.WhiteClientClasses, p.BlackClientClasses, nil)
+}
@@ -26,20 +26,31 @@ const (
 var TableReservation4 = restdb.ResourceDBType(&Reservation4{})
 
 var Reservation4Columns = []string{restdb.IDField, restdb.CreateTimeField, SqlColumnSubnet4, SqlColumnHwAddress,
-	SqlColumnHostname, SqlColumnIpAddress, SqlColumnIp, SqlColumnCapacity, SqlColumnComment, SqlColumnAutoCreate}
+	SqlColumnHostname, SqlColumnIpAddress, SqlColumnIp, SqlColumnCapacity, SqlColumnComment, SqlColumnAutoCreate,
+	SqlColumnValidLifetime, SqlColumnMaxValidLifetime, SqlColumnMinValidLifetime, SqlColumnRouters,
+	SqlColumnDomainServers, SqlColumnNextServer, SqlColumnBootfile, SqlColumnDomainSearchList}
 
 type Reservation4 struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet4                   string `json:"-" db:"ownby"`
-	HwAddress                 string `json:"hwAddress"`
-	Hostname                  string `json:"hostname"`
-	IpAddress                 string `json:"ipAddress" rest:"required=true"`
-	Ip                        net.IP `json:"-"`
-	UsedRatio                 string `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64 `json:"usedCount" rest:"description=readonly" db:"-"`
-	Capacity                  uint64 `json:"capacity" rest:"description=readonly"`
-	Comment                   string `json:"comment"`
-	AutoCreate                bool   `json:"autoCreate" rest:"description=readonly"`
+	Subnet4                   string          `json:"-" db:"ownby"`
+	HwAddress                 string          `json:"hwAddress"`
+	Hostname                  string          `json:"hostname"`
+	IpAddress                 string          `json:"ipAddress" rest:"required=true"`
+	Ip                        net.IP          `json:"-"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
+	Capacity                  uint64          `json:"capacity" rest:"description=readonly"`
+	Comment                   string          `json:"comment"`
+	AutoCreate                bool            `json:"autoCreate" rest:"description=readonly"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	Routers                   []string        `json:"routers"`
+	DomainServers             []string        `json:"domainServers"`
+	NextServer                string          `json:"nextServer"`
+	Bootfile                  string          `json:"bootfile"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	Options                   []*OptionValue4 `json:"options" db:"-"`
 }
 
 func (r Reservation4) GetParents() []restresource.ResourceKind {
@@ -64,6 +75,10 @@ func (s Reservation4) GetActions() []restresource.Action {
 			Name:  ActionBatchDelete,
 			Input: &BatchDeleteInput{},
 		},
+		restresource.Action{
+			Name:   ActionNameEffectiveConfig,
+			Output: &EffectiveConfig{},
+		},
 	}
 }
 
@@ -108,10 +123,38 @@ func (r *Reservation4) Validate() error {
 		return errorno.ErrExceedMaxCount(errorno.ErrNameComment, MaxCommentLength)
 	}
 
+	if err := r.ValidateOptions(); err != nil {
+		return err
+	}
+
 	r.Capacity = 1
 	return nil
 }
 
+func (r *Reservation4) ValidateOptions() error {
+	if err := CheckOptionValue4s(r.Options, nil); err != nil {
+		return err
+	}
+
+	if err := checkOptionOverrides4(r.Routers, r.DomainServers, r.DomainSearchList,
+		r.NextServer, r.Bootfile); err != nil {
+		return err
+	}
+
+	return checkOptionValue4sConflictWithCodes(r.Options, overrideOptionCodes4(r.Routers,
+		r.DomainServers, r.DomainSearchList, r.Bootfile))
+}
+
+func (r *Reservation4) CheckLifetimes(subnet *Subnet4) error {
+	return checkLifetimes(r.ValidLifetime, r.MinValidLifetime, r.MaxValidLifetime,
+		subnet.ValidLifetime, subnet.MinValidLifetime, subnet.MaxValidLifetime)
+}
+
+func (r *Reservation4) MergedLifetimes(subnet *Subnet4) (uint32, uint32, uint32) {
+	return mergeLifetimes(r.ValidLifetime, r.MinValidLifetime, r.MaxValidLifetime,
+		subnet.ValidLifetime, subnet.MinValidLifetime, subnet.MaxValidLifetime)
+}
+
 func (r *Reservation4) GenCopyValues() []interface{} {
 	if r.GetID() == "" {
 		r.ID, _ = uuid.Gen()
@@ -127,5 +170,13 @@ func (r *Reservation4) GenCopyValues() []interface{} {
 		r.Capacity,
 		r.Comment,
 		r.AutoCreate,
+		r.ValidLifetime,
+		r.MaxValidLifetime,
+		r.MinValidLifetime,
+		r.Routers,
+		r.DomainServers,
+		r.NextServer,
+		r.Bootfile,
+		r.DomainSearchList,
 	}
 }
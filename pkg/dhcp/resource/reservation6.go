@@ -32,23 +32,31 @@ var TableReservation6 = restdb.ResourceDBType(&Reservation6{})
 
 var Reservation6Columns = []string{restdb.IDField, restdb.CreateTimeField, SqlColumnSubnet6, SqlColumnDuid, SqlColumnHwAddress,
 	SqlColumnHostname, SqlColumnIpAddresses, SqlColumnIps, SqlColumnPrefixes, SqlColumnIpNets, SqlColumnCapacity,
-	SqlColumnComment, SqlColumnAutoCreate}
+	SqlColumnComment, SqlColumnAutoCreate, SqlColumnValidLifetime, SqlColumnMaxValidLifetime,
+	SqlColumnMinValidLifetime, SqlColumnPreferredLifetime, SqlColumnDomainServers, SqlColumnDomainSearchList}
 
 type Reservation6 struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet6                   string      `json:"-" db:"ownby"`
-	Duid                      string      `json:"duid"`
-	HwAddress                 string      `json:"hwAddress"`
-	Hostname                  string      `json:"hostname"`
-	IpAddresses               []string    `json:"ipAddresses"`
-	Ips                       []net.IP    `json:"-"`
-	Prefixes                  []string    `json:"prefixes"`
-	Ipnets                    []net.IPNet `json:"-"`
-	Capacity                  string      `json:"capacity" rest:"description=readonly"`
-	UsedRatio                 string      `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64      `json:"usedCount" rest:"description=readonly" db:"-"`
-	Comment                   string      `json:"comment"`
-	AutoCreate                bool        `json:"autoCreate" rest:"description=readonly"`
+	Subnet6                   string          `json:"-" db:"ownby"`
+	Duid                      string          `json:"duid"`
+	HwAddress                 string          `json:"hwAddress"`
+	Hostname                  string          `json:"hostname"`
+	IpAddresses               []string        `json:"ipAddresses"`
+	Ips                       []net.IP        `json:"-"`
+	Prefixes                  []string        `json:"prefixes"`
+	Ipnets                    []net.IPNet     `json:"-"`
+	Capacity                  string          `json:"capacity" rest:"description=readonly"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
+	Comment                   string          `json:"comment"`
+	AutoCreate                bool            `json:"autoCreate" rest:"description=readonly"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	PreferredLifetime         uint32          `json:"preferredLifetime"`
+	DomainServers             []string        `json:"domainServers"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	Options                   []*OptionValue6 `json:"options" db:"-"`
 }
 
 func (r Reservation6) GetParents() []restresource.ResourceKind {
@@ -73,6 +81,10 @@ func (s Reservation6) GetActions() []restresource.Action {
 			Name:  ActionBatchDelete,
 			Input: &BatchDeleteInput{},
 		},
+		restresource.Action{
+			Name:   ActionNameEffectiveConfig,
+			Output: &EffectiveConfig{},
+		},
 	}
 }
 
@@ -111,6 +123,12 @@ func (r *Reservation6) GenCopyValues() []interface{} {
 		r.Capacity,
 		r.Comment,
 		r.AutoCreate,
+		r.ValidLifetime,
+		r.MaxValidLifetime,
+		r.MinValidLifetime,
+		r.PreferredLifetime,
+		r.DomainServers,
+		r.DomainSearchList,
 	}
 }
 
@@ -226,12 +244,39 @@ func (r *Reservation6) Validate() error {
 		return errorno.ErrExceedMaxCount(errorno.ErrNameComment, MaxCommentLength)
 	}
 
+	if err := r.ValidateOptions(); err != nil {
+		return err
+	}
+
 	r.IpAddresses = ips
 	r.Prefixes = prefixes
 	r.Capacity = capacity.String()
 	return nil
 }
 
+func (r *Reservation6) ValidateOptions() error {
+	if err := CheckOptionValue6s(r.Options, nil); err != nil {
+		return err
+	}
+
+	if err := checkOptionOverrides6(r.DomainServers, r.DomainSearchList); err != nil {
+		return err
+	}
+
+	return checkOptionValue6sConflictWithCodes(r.Options,
+		overrideOptionCodes6(r.DomainServers, r.DomainSearchList))
+}
+
+func (r *Reservation6) CheckLifetimes(subnet *Subnet6) error {
+	return checkLifetimes6(r.ValidLifetime, r.MinValidLifetime, r.MaxValidLifetime,
+		r.PreferredLifetime, subnet)
+}
+
+func (r *Reservation6) MergedLifetimes(subnet *Subnet6) (uint32, uint32, uint32, uint32) {
+	return mergeLifetimes6(r.ValidLifetime, r.MinValidLifetime, r.MaxValidLifetime,
+		r.PreferredLifetime, subnet)
+}
+
 func isIpnetIntersectPrefixes(prefixes []string, ipnet *net.IPNet, index int) (string, bool) {
 	for i := index + 1; i < len(prefixes); i++ {
 		if isPrefixIntersectWithIpnet(prefixes[i], ipnet) {
@@ -0,0 +1,87 @@
+package resource
+
+import (
+	"net"
+	"strings"
+
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type SearchType string
+
+const (
+	SearchTypeIp       SearchType = "ip"
+	SearchTypeMac      SearchType = "mac"
+	SearchTypeDuid     SearchType = "duid"
+	SearchTypeHostname SearchType = "hostname"
+
+	SearchVersion4 = "4"
+	SearchVersion6 = "6"
+)
+
+type Search struct {
+	restresource.ResourceBase `json:",inline"`
+	SearchType                SearchType      `json:"searchType"`
+	Version                   string          `json:"version"`
+	Address                   string          `json:"address"`
+	AddressType               AddressType     `json:"addressType"`
+	Subnet4                   *Subnet4        `json:"subnet4,omitempty"`
+	Subnet6                   *Subnet6        `json:"subnet6,omitempty"`
+	Lease4                    *SubnetLease4   `json:"lease4,omitempty"`
+	Lease6                    *SubnetLease6   `json:"lease6,omitempty"`
+	Reservation4s             []*Reservation4 `json:"reservation4s,omitempty"`
+	Reservation6s             []*Reservation6 `json:"reservation6s,omitempty"`
+	HwAddress                 string          `json:"hwAddress"`
+	HwAddressOrganization     string          `json:"hwAddressOrganization"`
+	Asset                     *Asset          `json:"asset,omitempty"`
+	Fingerprint               string          `json:"fingerprint"`
+	VendorId                  string          `json:"vendorId"`
+	OperatingSystem           string          `json:"operatingSystem"`
+	ClientType                string          `json:"clientType"`
+}
+
+func ParseSearchType(keyword, searchType string) (SearchType, string, error) {
+	keyword = strings.TrimSpace(keyword)
+	if keyword == "" {
+		return "", "", errorno.ErrEmpty(string(errorno.ErrNameSearchKeyword))
+	}
+
+	switch SearchType(searchType) {
+	case SearchTypeIp:
+		if ip := net.ParseIP(keyword); ip == nil {
+			return "", "", errorno.ErrInvalidParams(errorno.ErrNameIp, keyword)
+		} else {
+			return SearchTypeIp, ip.String(), nil
+		}
+	case SearchTypeMac:
+		if mac, err := util.NormalizeMac(keyword); err != nil {
+			return "", "", err
+		} else {
+			return SearchTypeMac, mac, nil
+		}
+	case SearchTypeDuid:
+		if err := parseDUID(keyword); err != nil {
+			return "", "", errorno.ErrInvalidParams(errorno.ErrNameDuid, keyword)
+		} else {
+			return SearchTypeDuid, keyword, nil
+		}
+	case SearchTypeHostname:
+		return SearchTypeHostname, keyword, nil
+	case "":
+	default:
+		return "", "", errorno.ErrInvalidParams(errorno.ErrNameSearchType, searchType)
+	}
+
+	if ip := net.ParseIP(keyword); ip != nil {
+		return SearchTypeIp, ip.String(), nil
+	} else if hw, err := net.ParseMAC(keyword); err == nil && len(hw) == 6 {
+		return SearchTypeMac, strings.ToUpper(hw.String()), nil
+	} else if strings.Contains(keyword, ":") && parseDUID(keyword) == nil {
+		return SearchTypeDuid, keyword, nil
+	} else {
+		return SearchTypeHostname, keyword, nil
+	}
+}
@@ -41,7 +41,7 @@ func (s *SharedNetwork4) Validate() error {
 	var sharedNetworks []*SharedNetwork4
 	var subnet4s []*Subnet4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if err := tx.FillEx(&subnet4s, genGetSubnetsSqlWithSubnetIds(s.SubnetIds)); err != nil {
+		if err := tx.FillEx(&subnet4s, genGetSubnetsSqlWithSubnetIds(TableSubnet4, s.SubnetIds)); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
 		}
 
@@ -61,9 +61,9 @@ func (s *SharedNetwork4) Validate() error {
 	return s.checkConflictWithOthers(sharedNetworks)
 }
 
-func genGetSubnetsSqlWithSubnetIds(subnetIds []uint64) string {
+func genGetSubnetsSqlWithSubnetIds(table restdb.ResourceType, subnetIds []uint64) string {
 	var buf bytes.Buffer
-	buf.WriteString("select * from gr_subnet4 where subnet_id in (")
+	buf.WriteString("select * from gr_" + string(table) + " where subnet_id in (")
 	for _, subnetId := range subnetIds {
 		buf.WriteString(strconv.FormatUint(subnetId, 10))
 		buf.WriteString(",")
@@ -0,0 +1,141 @@
+package resource
+
+import (
+	"github.com/linkingthing/clxone-utils/excel"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+var TableSharedNetwork6 = restdb.ResourceDBType(&SharedNetwork6{})
+
+type SharedNetwork6 struct {
+	restresource.ResourceBase `json:",inline"`
+	Name                      string   `json:"name" rest:"required=true" db:"uk"`
+	SubnetIds                 []uint64 `json:"subnetIds" rest:"required=true"`
+	Subnets                   []string `json:"subnets"`
+	Comment                   string   `json:"comment"`
+}
+
+func (s SharedNetwork6) GetActions() []restresource.Action {
+	return []restresource.Action{
+		restresource.Action{
+			Name:  excel.ActionNameImport,
+			Input: &excel.ImportFile{},
+		},
+		restresource.Action{
+			Name:   excel.ActionNameExport,
+			Output: &excel.ExportFile{},
+		},
+		restresource.Action{
+			Name:   excel.ActionNameExportTemplate,
+			Output: &excel.ExportFile{},
+		},
+	}
+}
+
+func (s *SharedNetwork6) Validate() error {
+	if err := s.ValidateParams(); err != nil {
+		return err
+	}
+
+	var sharedNetworks []*SharedNetwork6
+	var subnet6s []*Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.FillEx(&subnet6s, genGetSubnetsSqlWithSubnetIds(TableSubnet6, s.SubnetIds)); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+		}
+
+		if err := tx.FillEx(&sharedNetworks, "select * from gr_shared_network6 where id != $1", s.GetID()); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	if err := s.SetSharedNetworkSubnets(subnet6s); err != nil {
+		return err
+	}
+
+	return s.CheckConflictWithOthers(sharedNetworks)
+}
+
+func (s *SharedNetwork6) ValidateParams() error {
+	if len(s.Name) == 0 || util.ValidateStrings(util.RegexpTypeCommon, s.Name) != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, s.Name)
+	}
+
+	if len(s.SubnetIds) <= 1 {
+		return errorno.ErrSharedNetSubnetIds(s.Name)
+	}
+
+	if err := util.ValidateStrings(util.RegexpTypeComma, s.Comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, s.Comment)
+	}
+
+	return nil
+}
+
+func (s *SharedNetwork6) SetSharedNetworkSubnets(subnet6s []*Subnet6) error {
+	if len(s.SubnetIds) != len(subnet6s) {
+		return errorno.ErrExpect(errorno.ErrNameSharedNetwork,
+			getSubnet6Ids(subnet6s), s.SubnetIds)
+	}
+
+	nodeSet := getIntersectionNodes(subnet6s[0].Nodes, subnet6s[1].Nodes)
+	if nodeSet == nil {
+		return errorno.ErrNoIntersectionNodes(subnet6s[0].Subnet, subnet6s[1].Subnet)
+	}
+
+	var subnets []string
+	for _, subnet6 := range subnet6s {
+		if len(subnet6.Nodes) == 0 {
+			return errorno.ErrNoNode(errorno.ErrNameNetworkV6, subnet6.Subnet)
+		} else if !isFullyContains(subnet6.Nodes, nodeSet) {
+			return errorno.ErrNotContainNode(errorno.ErrNameNetworkV6,
+				subnet6.Subnet, nodeSet.ToSlice())
+		} else {
+			subnets = append(subnets, subnet6.Subnet)
+		}
+	}
+
+	s.Subnets = subnets
+	return nil
+}
+
+func getSubnet6Ids(subnets []*Subnet6) []string {
+	ids := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		ids = append(ids, subnet.GetID())
+	}
+
+	return ids
+}
+
+func (s *SharedNetwork6) CheckConflictWithOthers(sharedNetworks []*SharedNetwork6) error {
+	sharedNetworksIds := make(map[uint64]string)
+	for _, sharedNetwork := range sharedNetworks {
+		if sharedNetwork.Name == s.Name {
+			return errorno.ErrDuplicate(errorno.ErrNameSharedNetwork, s.Name)
+		}
+
+		for _, id := range sharedNetwork.SubnetIds {
+			sharedNetworksIds[id] = sharedNetwork.Name
+		}
+	}
+
+	for _, id := range s.SubnetIds {
+		if name, ok := sharedNetworksIds[id]; ok {
+			return errorno.ErrConflict(errorno.ErrNameSharedNetwork, errorno.ErrNameSharedNetwork,
+				s.Name, name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,542 @@
+package resource
+
+import (
+	"encoding/hex"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	"github.com/linkingthing/cement/slice"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	ActionNameSimulate = "simulate"
+
+	Option6CodeInterfaceId = 18
+)
+
+type SimulateRule string
+
+const (
+	SimulateRuleAdmitMac         SimulateRule = "admitmac"
+	SimulateRuleAdmitDuid        SimulateRule = "admitduid"
+	SimulateRuleAdmitFingerprint SimulateRule = "admitfingerprint"
+	SimulateRuleAdmitNotMatched  SimulateRule = "admit"
+	SimulateRuleNoSubnet         SimulateRule = "nosubnet"
+	SimulateRuleNoNode           SimulateRule = "nonode"
+	SimulateRuleWhiteClientClass SimulateRule = "whiteclientclass"
+	SimulateRuleBlackClientClass SimulateRule = "blackclientclass"
+	SimulateRuleNoPool           SimulateRule = "nopool"
+)
+
+type Simulate4Input struct {
+	HwAddress            string `json:"hwAddress"`
+	Hostname             string `json:"hostname"`
+	ParameterRequestList string `json:"parameterRequestList"`
+	VendorClass          string `json:"vendorClass"`
+	ClientId             string `json:"clientId"`
+	UserClass            string `json:"userClass"`
+	RelayAgentCircuitId  string `json:"relayAgentCircuitId"`
+	RelayAgentRemoteId   string `json:"relayAgentRemoteId"`
+	RelayAgentAddress    string `json:"relayAgentAddress"`
+	IfaceName            string `json:"ifaceName"`
+	RequestedIp          string `json:"requestedIp"`
+}
+
+func (s *Simulate4Input) Validate() error {
+	if hw, err := util.NormalizeMac(s.HwAddress); err != nil {
+		return err
+	} else {
+		s.HwAddress = hw
+	}
+
+	if s.RelayAgentAddress != "" {
+		if err := gohelperip.CheckIPv4sValid(s.RelayAgentAddress); err != nil {
+			return errorno.ErrInvalidAddress(s.RelayAgentAddress)
+		}
+	}
+
+	if s.RequestedIp != "" {
+		if err := gohelperip.CheckIPv4sValid(s.RequestedIp); err != nil {
+			return errorno.ErrInvalidAddress(s.RequestedIp)
+		}
+	}
+
+	return nil
+}
+
+func (s *Simulate4Input) optionValues() map[uint32]string {
+	values := make(map[uint32]string)
+	addOptionValueIfNotEmpty(values, uint32(Option4CodeHostName), s.Hostname)
+	addOptionValueIfNotEmpty(values, uint32(Option4CodeParameterRequestList), s.ParameterRequestList)
+	addOptionValueIfNotEmpty(values, uint32(Option4CodeClassIdentifier), s.VendorClass)
+	addOptionValueIfNotEmpty(values, uint32(Option4CodeClientIdentifier), s.ClientId)
+	addOptionValueIfNotEmpty(values, uint32(Option4CodeUserClassInformation), s.UserClass)
+	addOptionValueIfNotEmpty(values, uint32(Option4CodeRelayAgentInformation),
+		s.RelayAgentCircuitId+s.RelayAgentRemoteId)
+	return values
+}
+
+func addOptionValueIfNotEmpty(values map[uint32]string, code uint32, value string) {
+	if len(value) != 0 {
+		values[code] = value
+	}
+}
+
+type Simulate6Input struct {
+	Duid                  string `json:"duid"`
+	HwAddress             string `json:"hwAddress"`
+	Hostname              string `json:"hostname"`
+	ParameterRequestList  string `json:"parameterRequestList"`
+	VendorClass           string `json:"vendorClass"`
+	UserClass             string `json:"userClass"`
+	RelayAgentAddress     string `json:"relayAgentAddress"`
+	RelayAgentInterfaceId string `json:"relayAgentInterfaceId"`
+	IfaceName             string `json:"ifaceName"`
+	RequestedIp           string `json:"requestedIp"`
+}
+
+func (s *Simulate6Input) Validate() error {
+	if err := parseDUID(s.Duid); err != nil {
+		return err
+	}
+
+	if s.HwAddress != "" {
+		if hw, err := util.NormalizeMac(s.HwAddress); err != nil {
+			return err
+		} else {
+			s.HwAddress = hw
+		}
+	}
+
+	if s.RelayAgentAddress != "" {
+		if err := gohelperip.CheckIPv6sValid(s.RelayAgentAddress); err != nil {
+			return errorno.ErrInvalidAddress(s.RelayAgentAddress)
+		}
+	}
+
+	if s.RequestedIp != "" {
+		if err := gohelperip.CheckIPv6sValid(s.RequestedIp); err != nil {
+			return errorno.ErrInvalidAddress(s.RequestedIp)
+		}
+	}
+
+	return nil
+}
+
+func (s *Simulate6Input) optionValues() map[uint32]string {
+	values := make(map[uint32]string)
+	addOptionValueIfNotEmpty(values, uint32(Option6CodeClientID), s.Duid)
+	addOptionValueIfNotEmpty(values, uint32(Option6CodeORO), s.ParameterRequestList)
+	addOptionValueIfNotEmpty(values, uint32(Option6CodeUserClass), s.UserClass)
+	addOptionValueIfNotEmpty(values, uint32(Option6CodeVendorClass), s.VendorClass)
+	addOptionValueIfNotEmpty(values, uint32(Option6CodeFQDN), s.Hostname)
+	return values
+}
+
+type SimulateRejection struct {
+	Rule   SimulateRule `json:"rule"`
+	Target string       `json:"target"`
+}
+
+type SimulateResult struct {
+	Rejection     *SimulateRejection `json:"rejection"`
+	ClientType    string             `json:"clientType"`
+	ClientClasses []string           `json:"clientClasses"`
+	RateLimit     uint32             `json:"rateLimit"`
+	SharedNetwork string             `json:"sharedNetwork"`
+	Subnet        string             `json:"subnet"`
+	Pool          string             `json:"pool"`
+	Reservation   string             `json:"reservation"`
+	Address       string             `json:"address"`
+	AddressType   AddressType        `json:"addressType"`
+	AddressCode   string             `json:"addressCode"`
+	Options       []*EffectiveValue  `json:"options"`
+}
+
+func (r *SimulateResult) Reject(rule SimulateRule, target string) *SimulateResult {
+	r.Rejection = &SimulateRejection{Rule: rule, Target: target}
+	return r
+}
+
+// MatchClientClass4s evaluates client classes in order, expression classes
+// only see the member classes matched before them
+func MatchClientClass4s(input *Simulate4Input, clientClasses []*ClientClass4) []*ClientClass4 {
+	values := input.optionValues()
+	ctx := newSimulate4ExpressionContext(input, values)
+	var matched []*ClientClass4
+	for _, clientClass := range clientClasses {
+		if matchClientClass(values, ctx, clientClass.Condition, uint32(clientClass.Code),
+			clientClass.Regexp, clientClass.BeginIndex, clientClass.ParseExpression) {
+			matched = append(matched, clientClass)
+			ctx.members[clientClass.Name] = struct{}{}
+		}
+	}
+
+	return matched
+}
+
+func MatchClientClass6s(input *Simulate6Input, clientClasses []*ClientClass6) []*ClientClass6 {
+	values := input.optionValues()
+	ctx := newSimulate6ExpressionContext(input, values)
+	var matched []*ClientClass6
+	for _, clientClass := range clientClasses {
+		if matchClientClass(values, ctx, clientClass.Condition, uint32(clientClass.Code),
+			clientClass.Regexp, clientClass.BeginIndex, clientClass.ParseExpression) {
+			matched = append(matched, clientClass)
+			ctx.members[clientClass.Name] = struct{}{}
+		}
+	}
+
+	return matched
+}
+
+func matchClientClass(values map[uint32]string, ctx *simulateExpressionContext, condition OptionCondition, code uint32, expected string, beginIndex uint32, parseExpression func() (*ClientClassExpression, error)) bool {
+	if condition == OptionConditionExpression {
+		expression, err := parseExpression()
+		return err == nil && expression.Evaluate(ctx)
+	}
+
+	value, ok := values[code]
+	return ok && matchOptionCondition(condition, expected, beginIndex, value)
+}
+
+type simulateExpressionContext struct {
+	options      map[uint32][]byte
+	relayOptions map[uint32][]byte
+	hwAddress    []byte
+	members      map[string]struct{}
+}
+
+func newSimulate4ExpressionContext(input *Simulate4Input, values map[uint32]string) *simulateExpressionContext {
+	ctx := newSimulateExpressionContext(values, input.HwAddress)
+	if prl, ok := values[uint32(Option4CodeParameterRequestList)]; ok {
+		ctx.options[uint32(Option4CodeParameterRequestList)] = parameterRequestListToBytes(prl, 1)
+	}
+
+	addRelayOptionIfNotEmpty(ctx.relayOptions, 1, input.RelayAgentCircuitId)
+	addRelayOptionIfNotEmpty(ctx.relayOptions, 2, input.RelayAgentRemoteId)
+	return ctx
+}
+
+func newSimulate6ExpressionContext(input *Simulate6Input, values map[uint32]string) *simulateExpressionContext {
+	ctx := newSimulateExpressionContext(values, input.HwAddress)
+	if duid, err := hex.DecodeString(strings.Replace(input.Duid, ":", "", -1)); err == nil {
+		ctx.options[uint32(Option6CodeClientID)] = duid
+	}
+
+	if oro, ok := values[uint32(Option6CodeORO)]; ok {
+		ctx.options[uint32(Option6CodeORO)] = parameterRequestListToBytes(oro, 2)
+	}
+
+	addRelayOptionIfNotEmpty(ctx.relayOptions, Option6CodeInterfaceId, input.RelayAgentInterfaceId)
+	return ctx
+}
+
+func newSimulateExpressionContext(values map[uint32]string, hwAddress string) *simulateExpressionContext {
+	ctx := &simulateExpressionContext{
+		options:      make(map[uint32][]byte, len(values)),
+		relayOptions: make(map[uint32][]byte),
+		members:      make(map[string]struct{}),
+	}
+
+	for code, value := range values {
+		ctx.options[code] = []byte(value)
+	}
+
+	ctx.hwAddress, _ = net.ParseMAC(hwAddress)
+	return ctx
+}
+
+func addRelayOptionIfNotEmpty(relayOptions map[uint32][]byte, code uint32, value string) {
+	if len(value) != 0 {
+		relayOptions[code] = []byte(value)
+	}
+}
+
+func parameterRequestListToBytes(prl string, size int) []byte {
+	var data []byte
+	for _, field := range strings.Split(prl, ",") {
+		code, err := strconv.ParseUint(strings.TrimSpace(field), 10, size*8)
+		if err != nil {
+			continue
+		}
+
+		if size == 2 {
+			data = append(data, byte(code>>8))
+		}
+
+		data = append(data, byte(code))
+	}
+
+	return data
+}
+
+func (c *simulateExpressionContext) Option(code uint32) ([]byte, bool) {
+	data, ok := c.options[code]
+	return data, ok
+}
+
+func (c *simulateExpressionContext) RelayOption(nest, code uint32) ([]byte, bool) {
+	if nest != 0 {
+		return nil, false
+	}
+
+	data, ok := c.relayOptions[code]
+	return data, ok
+}
+
+func (c *simulateExpressionContext) HwAddress() []byte {
+	return c.hwAddress
+}
+
+func (c *simulateExpressionContext) Member(name string) bool {
+	_, ok := c.members[name]
+	return ok
+}
+
+func matchOptionCondition(condition OptionCondition, expected string, beginIndex uint32, value string) bool {
+	switch condition {
+	case OptionConditionExists:
+		return true
+	case OptionConditionEqual:
+		return value == expected
+	case OptionConditionSubstringEqual:
+		end := int(beginIndex) + len(expected)
+		return end <= len(value) && value[beginIndex:end] == expected
+	default:
+		return false
+	}
+}
+
+// CheckClientClassesAdmitted returns the white or black rule which rejects client with member classes
+func CheckClientClassesAdmitted(whiteStrategy string, whiteClasses []string, blackStrategy string, blackClasses []string, members map[string]struct{}) (SimulateRule, bool) {
+	if len(whiteClasses) != 0 && !matchClientClassStrategy(whiteStrategy, whiteClasses, members) {
+		return SimulateRuleWhiteClientClass, false
+	}
+
+	if len(blackClasses) != 0 && matchClientClassStrategy(blackStrategy, blackClasses, members) {
+		return SimulateRuleBlackClientClass, false
+	}
+
+	return "", true
+}
+
+func matchClientClassStrategy(strategy string, classes []string, members map[string]struct{}) bool {
+	for _, class := range classes {
+		_, ok := members[class]
+		if strategy == ClientClassStrategyAnd && !ok {
+			return false
+		} else if strategy == ClientClassStrategyOr && ok {
+			return true
+		}
+	}
+
+	return strategy == ClientClassStrategyAnd
+}
+
+// MatchFingerprint returns the fingerprint with same parameter request list, the one
+// with vendor id matched is preferred to the one without vendor id
+func MatchFingerprint(fingerprints []*DhcpFingerprint, parameterRequestList, vendorClass string) *DhcpFingerprint {
+	var matched *DhcpFingerprint
+	for _, fingerprint := range fingerprints {
+		if fingerprint.Fingerprint != parameterRequestList {
+			continue
+		}
+
+		if fingerprint.VendorId == "" {
+			if matched == nil {
+				matched = fingerprint
+			}
+		} else if matchVendorId(fingerprint.VendorId, fingerprint.MatchPattern, vendorClass) {
+			return fingerprint
+		}
+	}
+
+	return matched
+}
+
+func matchVendorId(vendorId string, matchPattern MatchPattern, vendorClass string) bool {
+	switch matchPattern {
+	case MatchPatternPrefix:
+		return strings.HasPrefix(vendorClass, vendorId)
+	case MatchPatternSuffix:
+		return strings.HasSuffix(vendorClass, vendorId)
+	case MatchPatternKeyword:
+		return strings.Contains(vendorClass, vendorId)
+	case MatchPatternRegexp:
+		matched, err := regexp.MatchString(vendorId, vendorClass)
+		return err == nil && matched
+	default:
+		return vendorId == vendorClass
+	}
+}
+
+func (c *EffectiveConfig) SetClientClassOption4s(options []*OptionValue4) {
+	for _, option := range options {
+		if c.get(option.Name, option.Code) == nil {
+			c.set(option.Name, option.Code, option.Value, ConfigSourceClientClass)
+		}
+	}
+}
+
+func (c *EffectiveConfig) SetClientClassOption6s(options []*OptionValue6) {
+	for _, option := range options {
+		if c.get(option.Name, option.Code) == nil {
+			c.set(option.Name, option.Code, option.Value, ConfigSourceClientClass)
+		}
+	}
+}
+
+// SelectSubnet4 follows the order of agent: relay agent info, relay agent address,
+// subnet contains relay agent address, and interface name for directly connected client
+func SelectSubnet4(subnets []*Subnet4, input *Simulate4Input) *Subnet4 {
+	if input.RelayAgentCircuitId != "" || input.RelayAgentRemoteId != "" {
+		for _, subnet := range subnets {
+			if (subnet.RelayAgentCircuitId != "" || subnet.RelayAgentRemoteId != "") &&
+				subnet.RelayAgentCircuitId == input.RelayAgentCircuitId &&
+				subnet.RelayAgentRemoteId == input.RelayAgentRemoteId {
+				return subnet
+			}
+		}
+	}
+
+	if input.RelayAgentAddress != "" {
+		for _, subnet := range subnets {
+			if slice.SliceIndex(subnet.RelayAgentAddresses, input.RelayAgentAddress) != -1 {
+				return subnet
+			}
+		}
+
+		for _, subnet := range subnets {
+			if subnet.Contains(input.RelayAgentAddress) {
+				return subnet
+			}
+		}
+
+		return nil
+	}
+
+	if input.IfaceName != "" {
+		for _, subnet := range subnets {
+			if subnet.IfaceName == input.IfaceName {
+				return subnet
+			}
+		}
+	}
+
+	return nil
+}
+
+func SelectSubnet6(subnets []*Subnet6, input *Simulate6Input) *Subnet6 {
+	if input.RelayAgentInterfaceId != "" {
+		for _, subnet := range subnets {
+			if subnet.RelayAgentInterfaceId == input.RelayAgentInterfaceId {
+				return subnet
+			}
+		}
+	}
+
+	if input.RelayAgentAddress != "" {
+		for _, subnet := range subnets {
+			if slice.SliceIndex(subnet.RelayAgentAddresses, input.RelayAgentAddress) != -1 {
+				return subnet
+			}
+		}
+
+		for _, subnet := range subnets {
+			if subnet.Contains(input.RelayAgentAddress) {
+				return subnet
+			}
+		}
+
+		return nil
+	}
+
+	if input.IfaceName != "" {
+		for _, subnet := range subnets {
+			if subnet.IfaceName == input.IfaceName {
+				return subnet
+			}
+		}
+	}
+
+	return nil
+}
+
+// SelectPool4 prefers the pool contains requested ip in pools permitted by client
+// classes, the ip in reserved pools will never be allocated
+func SelectPool4(pools []*Pool4, reservedPools []*ReservedPool4, requestedIp string, members map[string]struct{}) (*Pool4, string) {
+	var permittedPools []*Pool4
+	for _, pool := range pools {
+		if _, ok := CheckClientClassesAdmitted(pool.WhiteClientClassStrategy, pool.WhiteClientClasses,
+			pool.BlackClientClassStrategy, pool.BlackClientClasses, members); ok {
+			permittedPools = append(permittedPools, pool)
+		}
+	}
+
+	pools = permittedPools
+	if requestedIp != "" {
+		for _, pool := range pools {
+			if pool.ContainsIpstr(requestedIp) && !isIpInReservedPool4s(reservedPools, requestedIp) {
+				return pool, requestedIp
+			}
+		}
+	}
+
+	if len(pools) != 0 {
+		return pools[0], ""
+	}
+
+	return nil, ""
+}
+
+func isIpInReservedPool4s(reservedPools []*ReservedPool4, ip string) bool {
+	for _, reservedPool := range reservedPools {
+		if reservedPool.ContainsIpstr(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func SelectPool6(pools []*Pool6, reservedPools []*ReservedPool6, requestedIp string, members map[string]struct{}) (*Pool6, string) {
+	var permittedPools []*Pool6
+	for _, pool := range pools {
+		if _, ok := CheckClientClassesAdmitted(pool.WhiteClientClassStrategy, pool.WhiteClientClasses,
+			pool.BlackClientClassStrategy, pool.BlackClientClasses, members); ok {
+			permittedPools = append(permittedPools, pool)
+		}
+	}
+
+	pools = permittedPools
+	if requestedIp != "" {
+		for _, pool := range pools {
+			if pool.ContainsIpstr(requestedIp) && !isIpInReservedPool6s(reservedPools, requestedIp) {
+				return pool, requestedIp
+			}
+		}
+	}
+
+	if len(pools) != 0 {
+		return pools[0], ""
+	}
+
+	return nil, ""
+}
+
+func isIpInReservedPool6s(reservedPools []*ReservedPool6, ip string) bool {
+	for _, reservedPool := range reservedPools {
+		if reservedPool.ContainsIpstr(ip) {
+			return true
+		}
+	}
+
+	return false
+}
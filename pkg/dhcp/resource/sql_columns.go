@@ -23,6 +23,7 @@ const (
 	SqlColumnClassBeginIndex           = "begin_index"
 	SqlColumnClientClass               = "client_class"
 	SqlColumnClassDescription          = "description"
+	SqlColumnClassExpression           = "expression"
 	SqlColumnSubnetMask                = "subnet_mask"
 	SqlColumnRouters                   = "routers"
 	SqlColumnIfaceName                 = "iface_name"
@@ -51,10 +52,12 @@ const (
 	SqlColumnSubnet6                   = "subnet6"
 	SqlColumnSubnet4                   = "subnet4"
 	SqlColumnSubnet                    = "subnet"
+	SqlColumnIpnet                     = "ipnet"
 	SqlColumnBeginAddress              = "begin_address"
 	SqlColumnEndAddress                = "end_address"
 	SqlColumnBeginIp                   = "begin_ip"
 	SqlColumnEndIp                     = "end_ip"
+	SqlColumnPrefix                    = "prefix"
 	SqlColumnPrefixLen                 = "prefix_len"
 	SqlColumnPrefixes                  = "prefixes"
 	SqlColumnPrefixIpNet               = "prefix_ipnet"
@@ -71,6 +74,7 @@ const (
 	SqlColumnSubnetIds                 = "subnet_ids"
 	SqlColumnSubnets                   = "subnets"
 	SqlColumnPreferredLifetime         = "preferred_lifetime"
+	SqlColumnInheritedFields           = "inherited_fields"
 	SqlColumnExpirationTime            = "expiration_time"
 	SqlColumnFingerprint               = "fingerprint"
 	SqlColumnRelayAgentInterfaceId     = "relay_agent_interface_id"
@@ -97,6 +101,10 @@ const (
 	SqlColumnManufacturer              = "manufacturer"
 	SqlColumnModel                     = "model"
 	SqlColumnAccessNetworkTime         = "access_network_time"
+	SqlColumnLastSeenIp                = "last_seen_ip"
+	SqlColumnLastSeenSubnet            = "last_seen_subnet"
+	SqlColumnLastSeenTime              = "last_seen_time"
+	SqlColumnLeaseExpirationTime       = "lease_expiration_time"
 	SqlColumnRequestType               = "request_type"
 	SqlColumnRequestTime               = "request_time"
 	SqlColumnRequestSourceAddr         = "request_source_addr"
@@ -111,4 +119,25 @@ const (
 	SqlColumnSubnet6WhiteClientClasses = "subnet6_white_client_classes"
 	SqlColumnSubnet6BlackClientClasses = "subnet6_black_client_classes"
 	SqlColumnCaptivePortalUrl          = "captive_portal_url"
+	SqlColumnScope                     = "scope"
+	SqlColumnScopeId                   = "scope_id"
+	SqlColumnUseOption249              = "use_option249"
+	SqlColumnProfile                   = "profile"
+	SqlColumnData                      = "data"
+	SqlColumnPoolTemplates             = "pool_templates"
+	SqlColumnReservedPoolTemplates     = "reserved_pool_templates"
+	SqlColumnForwardZone               = "forward_zone"
+	SqlColumnReverseZone               = "reverse_zone"
+	SqlColumnServer                    = "server"
+	SqlColumnTsigKeyName               = "tsig_key_name"
+	SqlColumnTsigAlgorithm             = "tsig_algorithm"
+	SqlColumnTsigSecret                = "tsig_secret"
+	SqlColumnTtl                       = "ttl"
+	SqlColumnQualifyingSuffix          = "qualifying_suffix"
+	SqlColumnReplaceClientName         = "replace_client_name"
+	SqlColumnGeneratedPrefix           = "generated_prefix"
+	SqlColumnConflictResolution        = "conflict_resolution"
+	SqlColumnExpireAt                  = "expire_at"
+	SqlColumnVersion                   = "version"
+	SqlColumnNode                      = "node"
 )
@@ -0,0 +1,114 @@
+package resource
+
+import (
+	"bytes"
+	"net"
+	"strings"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+const (
+	Option4CodeClasslessStaticRoute   = 121
+	Option4CodeMsClasslessStaticRoute = 249
+)
+
+var TableStaticRoute4 = restdb.ResourceDBType(&StaticRoute4{})
+
+type StaticRoute4 struct {
+	restresource.ResourceBase `json:"-"`
+	Subnet4                   string   `json:"-"`
+	Destination               string   `json:"destination"`
+	NextHops                  []string `json:"nextHops"`
+}
+
+func checkStaticRoute4s(subnet *net.IPNet, routes []*StaticRoute4, routers []string) error {
+	destinations := make([]*net.IPNet, 0, len(routes))
+	for _, route := range routes {
+		destination, err := gohelperip.ParseCIDRv4(route.Destination)
+		if err != nil {
+			return errorno.ErrInvalidParams(errorno.ErrNameStaticRoute, route.Destination)
+		} else if ones, _ := destination.Mask.Size(); ones == 0 {
+			return errorno.ErrInvalidParams(errorno.ErrNameStaticRoute, route.Destination)
+		}
+
+		for _, dest := range destinations {
+			if dest.Contains(destination.IP) || destination.Contains(dest.IP) {
+				return errorno.ErrExistIntersection(route.Destination, dest.String())
+			}
+		}
+
+		if len(route.NextHops) == 0 {
+			return errorno.ErrEmpty(string(errorno.ErrNameNextHop))
+		}
+
+		nextHops := make(map[string]struct{}, len(route.NextHops))
+		for _, nextHop := range route.NextHops {
+			if ip, err := gohelperip.ParseIPv4(nextHop); err != nil {
+				return errorno.ErrInvalidParams(errorno.ErrNameNextHop, nextHop)
+			} else if !subnet.Contains(ip) {
+				return errorno.ErrNotBelongTo(errorno.ErrNameNextHop,
+					errorno.ErrNameNetworkV4, nextHop, subnet.String())
+			} else if _, ok := nextHops[nextHop]; ok {
+				return errorno.ErrDuplicate(errorno.ErrNameNextHop, nextHop)
+			} else {
+				nextHops[nextHop] = struct{}{}
+			}
+		}
+
+		route.Destination = destination.String()
+		destinations = append(destinations, destination)
+	}
+
+	if len(EncodeStaticRoute4s(routes, routers)) > MaxOptionDataLength {
+		return errorno.ErrExceedMaxCount(errorno.ErrNameStaticRoute, MaxOptionDataLength)
+	}
+
+	return nil
+}
+
+// EncodeStaticRoute4s encodes routes as RFC 3442 option 121, clients ignore option 3
+// when option 121 is present, so routers are appended as default routes
+func EncodeStaticRoute4s(routes []*StaticRoute4, routers []string) []byte {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	for _, route := range routes {
+		destination, err := gohelperip.ParseCIDRv4(route.Destination)
+		if err != nil {
+			continue
+		}
+
+		ones, _ := destination.Mask.Size()
+		for _, nextHop := range route.NextHops {
+			writeStaticRoute4(&buf, ones, destination.IP.To4(), nextHop)
+		}
+	}
+
+	for _, router := range routers {
+		writeStaticRoute4(&buf, 0, nil, router)
+	}
+
+	return buf.Bytes()
+}
+
+func writeStaticRoute4(buf *bytes.Buffer, ones int, destination net.IP, nextHop string) {
+	ip, err := gohelperip.ParseIPv4(nextHop)
+	if err != nil {
+		return
+	}
+
+	buf.WriteByte(byte(ones))
+	buf.Write(destination[:(ones+7)/8])
+	buf.Write(ip.To4())
+}
+
+func (r *StaticRoute4) String() string {
+	return r.Destination + PoolDelimiter + strings.Join(r.NextHops, ReservationAddrDelimiter)
+}
@@ -28,38 +28,42 @@ var TableSubnet4 = restdb.ResourceDBType(&Subnet4{})
 
 type Subnet4 struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet                    string    `json:"subnet" rest:"required=true,description=immutable" db:"suk"`
-	Ipnet                     net.IPNet `json:"-" db:"suk"`
-	SubnetId                  uint64    `json:"subnetId" rest:"description=readonly" db:"suk"`
-	Tags                      string    `json:"tags"`
-	IfaceName                 string    `json:"ifaceName"`
-	WhiteClientClassStrategy  string    `json:"whiteClientClassStrategy"`
-	WhiteClientClasses        []string  `json:"whiteClientClasses"`
-	BlackClientClassStrategy  string    `json:"blackClientClassStrategy"`
-	BlackClientClasses        []string  `json:"blackClientClasses"`
-	ValidLifetime             uint32    `json:"validLifetime"`
-	MaxValidLifetime          uint32    `json:"maxValidLifetime"`
-	MinValidLifetime          uint32    `json:"minValidLifetime"`
-	NextServer                string    `json:"nextServer"`
-	SubnetMask                string    `json:"subnetMask"`
-	Routers                   []string  `json:"routers"`
-	DomainServers             []string  `json:"domainServers"`
-	TftpServer                string    `json:"tftpServer"`
-	Bootfile                  string    `json:"bootfile"`
-	RelayAgentCircuitId       string    `json:"relayAgentCircuitId"`
-	RelayAgentRemoteId        string    `json:"relayAgentRemoteId"`
-	RelayAgentAddresses       []string  `json:"relayAgentAddresses"`
-	Ipv6OnlyPreferred         uint32    `json:"ipv6OnlyPreferred"`
-	CaptivePortalUrl          string    `json:"captivePortalUrl"`
-	CapWapACAddresses         []string  `json:"capWapACAddresses"`
-	DomainSearchList          []string  `json:"domainSearchList"`
-	AutoReservationType       uint32    `json:"autoReservationType"`
-	NodeIds                   []string  `json:"nodeIds" db:"-"`
-	NodeNames                 []string  `json:"nodeNames" db:"-"`
-	Nodes                     []string  `json:"nodes"`
-	Capacity                  uint64    `json:"capacity" rest:"description=readonly"`
-	UsedRatio                 string    `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64    `json:"usedCount" rest:"description=readonly" db:"-"`
+	Subnet                    string          `json:"subnet" rest:"required=true,description=immutable" db:"suk"`
+	Ipnet                     net.IPNet       `json:"-" db:"suk"`
+	SubnetId                  uint64          `json:"subnetId" rest:"description=readonly" db:"suk"`
+	Tags                      string          `json:"tags"`
+	IfaceName                 string          `json:"ifaceName"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	NextServer                string          `json:"nextServer"`
+	SubnetMask                string          `json:"subnetMask"`
+	Routers                   []string        `json:"routers"`
+	DomainServers             []string        `json:"domainServers"`
+	TftpServer                string          `json:"tftpServer"`
+	Bootfile                  string          `json:"bootfile"`
+	RelayAgentCircuitId       string          `json:"relayAgentCircuitId"`
+	RelayAgentRemoteId        string          `json:"relayAgentRemoteId"`
+	RelayAgentAddresses       []string        `json:"relayAgentAddresses"`
+	Ipv6OnlyPreferred         uint32          `json:"ipv6OnlyPreferred"`
+	CaptivePortalUrl          string          `json:"captivePortalUrl"`
+	CapWapACAddresses         []string        `json:"capWapACAddresses"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	AutoReservationType       uint32          `json:"autoReservationType"`
+	StaticRoutes              []*StaticRoute4 `json:"staticRoutes" db:"-"`
+	UseOption249              bool            `json:"useOption249"`
+	InheritedFields           []string        `json:"inheritedFields" rest:"description=readonly"`
+	Options                   []*OptionValue4 `json:"options" db:"-"`
+	NodeIds                   []string        `json:"nodeIds" db:"-"`
+	NodeNames                 []string        `json:"nodeNames" db:"-"`
+	Nodes                     []string        `json:"nodes"`
+	Capacity                  uint64          `json:"capacity" rest:"description=readonly"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
 }
 
 const (
@@ -95,6 +99,50 @@ func (s Subnet4) GetActions() []restresource.Action {
 			Input:  &SubnetListInput{},
 			Output: &Subnet4ListOutput{},
 		},
+		restresource.Action{
+			Name:   ActionNameExportLeases,
+			Input:  &ExportSubnetLeasesInput{},
+			Output: &excel.ExportFile{},
+		},
+		restresource.Action{
+			Name:   ActionNameEffectiveConfig,
+			Output: &EffectiveConfig{},
+		},
+		restresource.Action{
+			Name:   ActionNameSimulate,
+			Input:  &Simulate4Input{},
+			Output: &SimulateResult{},
+		},
+		restresource.Action{
+			Name:   ActionNameCreateFromTemplate,
+			Input:  &SubnetsFromTemplateInput{},
+			Output: &Subnet4ListOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameRenumber,
+			Input:  &RenumberSubnetInput{},
+			Output: &RenumberSubnetOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameSplit,
+			Input:  &SplitSubnetInput{},
+			Output: &Subnet4ListOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameMerge,
+			Input:  &MergeSubnetsInput{},
+			Output: &Subnet4{},
+		},
+		restresource.Action{
+			Name:   ActionNameAllocate,
+			Input:  &AllocateSubnetsInput{},
+			Output: &AllocateSubnetsOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameNextFreeAddress,
+			Input:  &NextFreeAddressInput{},
+			Output: &NextFreeAddressOutput{},
+		},
 	}
 }
 
@@ -134,10 +182,19 @@ func (s *Subnet4) Validate(dhcpConfig *DhcpConfig, clientClass4s []*ClientClass4
 		return err
 	}
 
-	return s.ValidateParams(clientClass4s)
+	if err := s.ValidateParams(clientClass4s); err != nil {
+		return err
+	}
+
+	return s.ValidateStaticRoutes()
+}
+
+func (s *Subnet4) ValidateStaticRoutes() error {
+	return checkStaticRoute4s(&s.Ipnet, s.StaticRoutes, s.Routers)
 }
 
 func (s *Subnet4) setSubnetDefaultValue(dhcpConfig *DhcpConfig) (err error) {
+	s.InheritedFields = nil
 	if s.ValidLifetime != 0 && s.MinValidLifetime != 0 && s.MaxValidLifetime != 0 &&
 		len(s.DomainServers) != 0 && len(s.DomainSearchList) != 0 && len(s.Routers) != 0 &&
 		len(s.WhiteClientClasses) != 0 && len(s.BlackClientClasses) != 0 {
@@ -153,39 +210,72 @@ func (s *Subnet4) setSubnetDefaultValue(dhcpConfig *DhcpConfig) (err error) {
 
 	if s.ValidLifetime == 0 {
 		s.ValidLifetime = dhcpConfig.ValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldValidLifetime)
 	}
 
 	if s.MinValidLifetime == 0 {
 		s.MinValidLifetime = dhcpConfig.MinValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldMinValidLifetime)
 	}
 
 	if s.MaxValidLifetime == 0 {
 		s.MaxValidLifetime = dhcpConfig.MaxValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldMaxValidLifetime)
 	}
 
-	if len(s.DomainServers) == 0 {
+	if len(s.DomainServers) == 0 && len(dhcpConfig.DomainServers) != 0 {
 		s.DomainServers = dhcpConfig.DomainServers
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldDomainServers)
 	}
 
-	if len(s.DomainSearchList) == 0 {
+	if len(s.DomainSearchList) == 0 && len(dhcpConfig.DomainSearchList) != 0 {
 		s.DomainSearchList = dhcpConfig.DomainSearchList
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldDomainSearchList)
 	}
 
-	if len(s.Routers) == 0 {
+	if len(s.Routers) == 0 && len(dhcpConfig.Routers) != 0 {
 		s.Routers = dhcpConfig.Routers
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldRouters)
 	}
 
-	if len(s.WhiteClientClasses) == 0 {
+	if len(s.WhiteClientClasses) == 0 && len(dhcpConfig.Subnet4WhiteClientClasses) != 0 {
 		s.WhiteClientClasses = dhcpConfig.Subnet4WhiteClientClasses
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldWhiteClientClasses)
 	}
 
-	if len(s.BlackClientClasses) == 0 {
+	if len(s.BlackClientClasses) == 0 && len(dhcpConfig.Subnet4BlackClientClasses) != 0 {
 		s.BlackClientClasses = dhcpConfig.Subnet4BlackClientClasses
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldBlackClientClasses)
 	}
 
 	return
 }
 
+// ResetInheritedFields keeps the fields inherited from dhcp config before if they are not changed
+func (s *Subnet4) ResetInheritedFields(oldSubnet *Subnet4) {
+	s.InheritedFields = nil
+	values := s.inheritableValues()
+	oldValues := oldSubnet.inheritableValues()
+	for _, field := range oldSubnet.InheritedFields {
+		if values[field] == oldValues[field] {
+			s.InheritedFields = append(s.InheritedFields, field)
+		}
+	}
+}
+
+func (s *Subnet4) inheritableValues() map[string]string {
+	return map[string]string{
+		ConfigFieldValidLifetime:      uint32ToValue(s.ValidLifetime),
+		ConfigFieldMinValidLifetime:   uint32ToValue(s.MinValidLifetime),
+		ConfigFieldMaxValidLifetime:   uint32ToValue(s.MaxValidLifetime),
+		ConfigFieldDomainServers:      stringsToValue(s.DomainServers),
+		ConfigFieldDomainSearchList:   stringsToValue(s.DomainSearchList),
+		ConfigFieldRouters:            stringsToValue(s.Routers),
+		ConfigFieldWhiteClientClasses: stringsToValue(s.WhiteClientClasses),
+		ConfigFieldBlackClientClasses: stringsToValue(s.BlackClientClasses),
+	}
+}
+
 func (s *Subnet4) ValidateParams(clientClass4s []*ClientClass4) error {
 	if err := checkTFTPValid(s.TftpServer, s.Bootfile); err != nil {
 		return err
@@ -252,9 +342,65 @@ func (s *Subnet4) ValidateParams(clientClass4s []*ClientClass4) error {
 		return err
 	}
 
+	if err := CheckOptionValue4s(s.Options, nil); err != nil {
+		return err
+	}
+
+	if err := checkOptionValue4sConflictWithCodes(s.Options, s.builtinOptionCodes()); err != nil {
+		return err
+	}
+
 	return checkNodesValid(s.Nodes)
 }
 
+func (s *Subnet4) builtinOptionCodes() map[uint32]errorno.ErrName {
+	codes := make(map[uint32]errorno.ErrName)
+	if len(s.SubnetMask) != 0 {
+		codes[1] = errorno.ErrNameNetworkMask
+	}
+
+	if len(s.Routers) != 0 {
+		codes[3] = errorno.ErrNameGateway
+	}
+
+	if len(s.DomainServers) != 0 {
+		codes[6] = errorno.ErrNameDNS
+	}
+
+	if len(s.TftpServer) != 0 {
+		codes[66] = errorno.ErrNameTftpServer
+	}
+
+	if len(s.Bootfile) != 0 {
+		codes[67] = errorno.ErrNameBootFile
+	}
+
+	if s.Ipv6OnlyPreferred != 0 {
+		codes[108] = errorno.ErrNameIpv6OnlyPreferred
+	}
+
+	if len(s.CaptivePortalUrl) != 0 {
+		codes[114] = errorno.ErrNameCaptivePortalUrl
+	}
+
+	if len(s.DomainSearchList) != 0 {
+		codes[119] = errorno.ErrNameDomainSearchList
+	}
+
+	if len(s.CapWapACAddresses) != 0 {
+		codes[138] = errorno.ErrNameCapWapACAddresses
+	}
+
+	if len(s.StaticRoutes) != 0 {
+		codes[Option4CodeClasslessStaticRoute] = errorno.ErrNameStaticRoute
+		if s.UseOption249 {
+			codes[Option4CodeMsClasslessStaticRoute] = errorno.ErrNameStaticRoute
+		}
+	}
+
+	return codes
+}
+
 func checkTFTPValid(tftpServer, bootfile string) error {
 	if len(bootfile) > 128 {
 		return errorno.ErrBiggerThan(errorno.ErrNameBootFile, len(bootfile), 128)
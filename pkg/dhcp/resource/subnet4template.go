@@ -0,0 +1,163 @@
+package resource
+
+import (
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const ActionNameCreateFromTemplate = "create_from_template"
+
+var TableSubnet4Template = restdb.ResourceDBType(&Subnet4Template{})
+
+type Subnet4Template struct {
+	restresource.ResourceBase `json:",inline"`
+	Name                      string          `json:"name" rest:"required=true" db:"uk"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	NextServer                string          `json:"nextServer"`
+	SubnetMask                string          `json:"subnetMask"`
+	DomainServers             []string        `json:"domainServers"`
+	TftpServer                string          `json:"tftpServer"`
+	Bootfile                  string          `json:"bootfile"`
+	Ipv6OnlyPreferred         uint32          `json:"ipv6OnlyPreferred"`
+	CaptivePortalUrl          string          `json:"captivePortalUrl"`
+	CapWapACAddresses         []string        `json:"capWapACAddresses"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	AutoReservationType       uint32          `json:"autoReservationType"`
+	UseOption249              bool            `json:"useOption249"`
+	Options                   []*OptionValue4 `json:"options" db:"-"`
+	Nodes                     []string        `json:"nodes"`
+	PoolTemplates             []string        `json:"poolTemplates"`
+	ReservedPoolTemplates     []string        `json:"reservedPoolTemplates"`
+	Comment                   string          `json:"comment"`
+}
+
+type SubnetFromTemplate struct {
+	Subnet              string   `json:"subnet"`
+	Tags                string   `json:"tags"`
+	IfaceName           string   `json:"ifaceName"`
+	Routers             []string `json:"routers"`
+	RelayAgentAddresses []string `json:"relayAgentAddresses"`
+}
+
+type SubnetsFromTemplateInput struct {
+	Template string                `json:"template"`
+	Subnets  []*SubnetFromTemplate `json:"subnets"`
+}
+
+func (s *SubnetsFromTemplateInput) Validate() error {
+	if len(s.Template) == 0 {
+		return errorno.ErrEmpty(string(errorno.ErrNameSubnetTemplate))
+	}
+
+	if len(s.Subnets) == 0 {
+		return errorno.ErrEmpty(string(errorno.ErrNameNetwork))
+	}
+
+	return nil
+}
+
+func (t *Subnet4Template) Validate(clientClass4s []*ClientClass4) error {
+	if len(t.Name) == 0 || util.ValidateStrings(util.RegexpTypeCommon, t.Name) != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, t.Name)
+	}
+
+	if err := util.ValidateStrings(util.RegexpTypeComma, t.Comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, t.Comment)
+	}
+
+	if err := checkTemplateNamesValid(t.PoolTemplates, t.ReservedPoolTemplates); err != nil {
+		return err
+	}
+
+	subnet := t.NewSubnet4(&SubnetFromTemplate{})
+	if err := subnet.setSubnetDefaultValue(nil); err != nil {
+		return err
+	}
+
+	if err := subnet.ValidateParams(clientClass4s); err != nil {
+		return err
+	}
+
+	t.Options = subnet.Options
+	return nil
+}
+
+func checkTemplateNamesValid(poolTemplates, reservedPoolTemplates []string) error {
+	names := make(map[string]struct{}, len(poolTemplates)+len(reservedPoolTemplates))
+	for _, name := range append(append([]string{}, poolTemplates...), reservedPoolTemplates...) {
+		if len(name) == 0 {
+			return errorno.ErrEmpty(string(errorno.ErrNameTemplate))
+		} else if _, ok := names[name]; ok {
+			return errorno.ErrDuplicate(errorno.ErrNameTemplate, name)
+		} else {
+			names[name] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// NewSubnet4 instantiates a subnet with template settings, the options are
+// copied so that every subnet owns its option values
+func (t *Subnet4Template) NewSubnet4(subnetFromTemplate *SubnetFromTemplate) *Subnet4 {
+	subnet := &Subnet4{
+		Subnet:                   subnetFromTemplate.Subnet,
+		Tags:                     subnetFromTemplate.Tags,
+		IfaceName:                subnetFromTemplate.IfaceName,
+		Routers:                  subnetFromTemplate.Routers,
+		RelayAgentAddresses:      subnetFromTemplate.RelayAgentAddresses,
+		WhiteClientClassStrategy: t.WhiteClientClassStrategy,
+		WhiteClientClasses:       t.WhiteClientClasses,
+		BlackClientClassStrategy: t.BlackClientClassStrategy,
+		BlackClientClasses:       t.BlackClientClasses,
+		ValidLifetime:            t.ValidLifetime,
+		MaxValidLifetime:         t.MaxValidLifetime,
+		MinValidLifetime:         t.MinValidLifetime,
+		NextServer:               t.NextServer,
+		SubnetMask:               t.SubnetMask,
+		DomainServers:            t.DomainServers,
+		TftpServer:               t.TftpServer,
+		Bootfile:                 t.Bootfile,
+		Ipv6OnlyPreferred:        t.Ipv6OnlyPreferred,
+		CaptivePortalUrl:         t.CaptivePortalUrl,
+		CapWapACAddresses:        t.CapWapACAddresses,
+		DomainSearchList:         t.DomainSearchList,
+		AutoReservationType:      t.AutoReservationType,
+		UseOption249:             t.UseOption249,
+		Nodes:                    t.Nodes,
+	}
+
+	for _, option := range t.Options {
+		subnet.Options = append(subnet.Options, &OptionValue4{
+			Name:  option.Name,
+			Code:  option.Code,
+			Value: option.Value,
+			Data:  option.Data,
+		})
+	}
+
+	return subnet
+}
+
+func (t *Subnet4Template) NewPool4s() ([]*Pool4, []*ReservedPool4) {
+	pools := make([]*Pool4, 0, len(t.PoolTemplates))
+	for _, template := range t.PoolTemplates {
+		pools = append(pools, &Pool4{Template: template})
+	}
+
+	reservedPools := make([]*ReservedPool4, 0, len(t.ReservedPoolTemplates))
+	for _, template := range t.ReservedPoolTemplates {
+		reservedPools = append(reservedPools, &ReservedPool4{Template: template})
+	}
+
+	return pools, reservedPools
+}
@@ -23,39 +23,41 @@ var TableSubnet6 = restdb.ResourceDBType(&Subnet6{})
 
 type Subnet6 struct {
 	restresource.ResourceBase `json:",inline"`
-	Subnet                    string    `json:"subnet" rest:"required=true,description=immutable" db:"suk"`
-	Ipnet                     net.IPNet `json:"-" db:"suk"`
-	SubnetId                  uint64    `json:"subnetId" rest:"description=readonly" db:"suk"`
-	Tags                      string    `json:"tags"`
-	IfaceName                 string    `json:"ifaceName"`
-	WhiteClientClassStrategy  string    `json:"whiteClientClassStrategy"`
-	WhiteClientClasses        []string  `json:"whiteClientClasses"`
-	BlackClientClassStrategy  string    `json:"blackClientClassStrategy"`
-	BlackClientClasses        []string  `json:"blackClientClasses"`
-	ValidLifetime             uint32    `json:"validLifetime"`
-	MaxValidLifetime          uint32    `json:"maxValidLifetime"`
-	MinValidLifetime          uint32    `json:"minValidLifetime"`
-	PreferredLifetime         uint32    `json:"preferredLifetime"`
-	RelayAgentAddresses       []string  `json:"relayAgentAddresses"`
-	RapidCommit               bool      `json:"rapidCommit"`
-	RelayAgentInterfaceId     string    `json:"relayAgentInterfaceId"`
-	DomainServers             []string  `json:"domainServers"`
-	DomainSearchList          []string  `json:"domainSearchList"`
-	InformationRefreshTime    uint32    `json:"informationRefreshTime"`
-	CapWapACAddresses         []string  `json:"capWapACAddresses"`
-	CaptivePortalUrl          string    `json:"captivePortalUrl"`
-	V6Prefix64                string    `json:"v6Prefix64"`
-	EmbedIpv4                 bool      `json:"embedIpv4"`
-	UseEui64                  bool      `json:"useEui64"`
-	AddressCode               string    `json:"addressCode"`
-	AddressCodeName           string    `json:"addressCodeName" db:"-"`
-	AutoReservationType       uint32    `json:"autoReservationType"`
-	Nodes                     []string  `json:"nodes"`
-	NodeIds                   []string  `json:"nodeIds" db:"-"`
-	NodeNames                 []string  `json:"nodeNames" db:"-"`
-	Capacity                  string    `json:"capacity" rest:"description=readonly"`
-	UsedRatio                 string    `json:"usedRatio" rest:"description=readonly" db:"-"`
-	UsedCount                 uint64    `json:"usedCount" rest:"description=readonly" db:"-"`
+	Subnet                    string          `json:"subnet" rest:"required=true,description=immutable" db:"suk"`
+	Ipnet                     net.IPNet       `json:"-" db:"suk"`
+	SubnetId                  uint64          `json:"subnetId" rest:"description=readonly" db:"suk"`
+	Tags                      string          `json:"tags"`
+	IfaceName                 string          `json:"ifaceName"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	PreferredLifetime         uint32          `json:"preferredLifetime"`
+	RelayAgentAddresses       []string        `json:"relayAgentAddresses"`
+	RapidCommit               bool            `json:"rapidCommit"`
+	RelayAgentInterfaceId     string          `json:"relayAgentInterfaceId"`
+	DomainServers             []string        `json:"domainServers"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	InformationRefreshTime    uint32          `json:"informationRefreshTime"`
+	CapWapACAddresses         []string        `json:"capWapACAddresses"`
+	CaptivePortalUrl          string          `json:"captivePortalUrl"`
+	V6Prefix64                string          `json:"v6Prefix64"`
+	EmbedIpv4                 bool            `json:"embedIpv4"`
+	UseEui64                  bool            `json:"useEui64"`
+	AddressCode               string          `json:"addressCode"`
+	AddressCodeName           string          `json:"addressCodeName" db:"-"`
+	AutoReservationType       uint32          `json:"autoReservationType"`
+	InheritedFields           []string        `json:"inheritedFields" rest:"description=readonly"`
+	Nodes                     []string        `json:"nodes"`
+	NodeIds                   []string        `json:"nodeIds" db:"-"`
+	NodeNames                 []string        `json:"nodeNames" db:"-"`
+	Capacity                  string          `json:"capacity" rest:"description=readonly"`
+	UsedRatio                 string          `json:"usedRatio" rest:"description=readonly" db:"-"`
+	UsedCount                 uint64          `json:"usedCount" rest:"description=readonly" db:"-"`
+	Options                   []*OptionValue6 `json:"options" db:"-"`
 }
 
 func (s Subnet6) GetActions() []restresource.Action {
@@ -85,6 +87,50 @@ func (s Subnet6) GetActions() []restresource.Action {
 			Input:  &SubnetListInput{},
 			Output: &Subnet6ListOutput{},
 		},
+		restresource.Action{
+			Name:   ActionNameExportLeases,
+			Input:  &ExportSubnetLeasesInput{},
+			Output: &excel.ExportFile{},
+		},
+		restresource.Action{
+			Name:   ActionNameEffectiveConfig,
+			Output: &EffectiveConfig{},
+		},
+		restresource.Action{
+			Name:   ActionNameSimulate,
+			Input:  &Simulate6Input{},
+			Output: &SimulateResult{},
+		},
+		restresource.Action{
+			Name:   ActionNameCreateFromTemplate,
+			Input:  &SubnetsFromTemplateInput{},
+			Output: &Subnet6ListOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameRenumber,
+			Input:  &RenumberSubnetInput{},
+			Output: &RenumberSubnetOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameSplit,
+			Input:  &SplitSubnetInput{},
+			Output: &Subnet6ListOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameMerge,
+			Input:  &MergeSubnetsInput{},
+			Output: &Subnet6{},
+		},
+		restresource.Action{
+			Name:   ActionNameAllocate,
+			Input:  &AllocateSubnetsInput{},
+			Output: &AllocateSubnetsOutput{},
+		},
+		restresource.Action{
+			Name:   ActionNameNextFreeAddress,
+			Input:  &NextFreeAddressInput{},
+			Output: &NextFreeAddressOutput{},
+		},
 	}
 }
 
@@ -121,6 +167,7 @@ func (s *Subnet6) Validate(dhcpConfig *DhcpConfig, clientClass6s []*ClientClass6
 }
 
 func (s *Subnet6) setSubnet6DefaultValue(dhcpConfig *DhcpConfig) (err error) {
+	s.InheritedFields = nil
 	if s.ValidLifetime != 0 && s.MinValidLifetime != 0 && s.MaxValidLifetime != 0 &&
 		len(s.DomainServers) != 0 && len(s.DomainSearchList) != 0 &&
 		len(s.WhiteClientClasses) != 0 && len(s.BlackClientClasses) != 0 {
@@ -136,39 +183,72 @@ func (s *Subnet6) setSubnet6DefaultValue(dhcpConfig *DhcpConfig) (err error) {
 
 	if s.ValidLifetime == 0 {
 		s.ValidLifetime = dhcpConfig.ValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldValidLifetime)
 	}
 
 	if s.MinValidLifetime == 0 {
 		s.MinValidLifetime = dhcpConfig.MinValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldMinValidLifetime)
 	}
 
 	if s.MaxValidLifetime == 0 {
 		s.MaxValidLifetime = dhcpConfig.MaxValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldMaxValidLifetime)
 	}
 
 	if s.PreferredLifetime == 0 {
 		s.PreferredLifetime = dhcpConfig.ValidLifetime
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldPreferredLifetime)
 	}
 
-	if len(s.DomainServers) == 0 {
+	if len(s.DomainServers) == 0 && len(dhcpConfig.DomainServers) != 0 {
 		s.DomainServers = dhcpConfig.DomainServers
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldDomainServers)
 	}
 
-	if len(s.DomainSearchList) == 0 {
+	if len(s.DomainSearchList) == 0 && len(dhcpConfig.DomainSearchList) != 0 {
 		s.DomainSearchList = dhcpConfig.DomainSearchList
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldDomainSearchList)
 	}
 
-	if len(s.WhiteClientClasses) == 0 {
+	if len(s.WhiteClientClasses) == 0 && len(dhcpConfig.Subnet6WhiteClientClasses) != 0 {
 		s.WhiteClientClasses = dhcpConfig.Subnet6WhiteClientClasses
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldWhiteClientClasses)
 	}
 
-	if len(s.BlackClientClasses) == 0 {
+	if len(s.BlackClientClasses) == 0 && len(dhcpConfig.Subnet6BlackClientClasses) != 0 {
 		s.BlackClientClasses = dhcpConfig.Subnet6BlackClientClasses
+		s.InheritedFields = append(s.InheritedFields, ConfigFieldBlackClientClasses)
 	}
 
 	return
 }
 
+// ResetInheritedFields keeps the fields inherited from dhcp config before if they are not changed
+func (s *Subnet6) ResetInheritedFields(oldSubnet *Subnet6) {
+	s.InheritedFields = nil
+	values := s.inheritableValues()
+	oldValues := oldSubnet.inheritableValues()
+	for _, field := range oldSubnet.InheritedFields {
+		if values[field] == oldValues[field] {
+			s.InheritedFields = append(s.InheritedFields, field)
+		}
+	}
+}
+
+func (s *Subnet6) inheritableValues() map[string]string {
+	return map[string]string{
+		ConfigFieldValidLifetime:      uint32ToValue(s.ValidLifetime),
+		ConfigFieldMinValidLifetime:   uint32ToValue(s.MinValidLifetime),
+		ConfigFieldMaxValidLifetime:   uint32ToValue(s.MaxValidLifetime),
+		ConfigFieldPreferredLifetime:  uint32ToValue(s.PreferredLifetime),
+		ConfigFieldDomainServers:      stringsToValue(s.DomainServers),
+		ConfigFieldDomainSearchList:   stringsToValue(s.DomainSearchList),
+		ConfigFieldWhiteClientClasses: stringsToValue(s.WhiteClientClasses),
+		ConfigFieldBlackClientClasses: stringsToValue(s.BlackClientClasses),
+	}
+}
+
 func (s *Subnet6) ValidateParams(clientClass6s []*ClientClass6, addressCodes []*AddressCode) error {
 	if utf8.RuneCountInString(s.Tags) > MaxNameLength {
 		return errorno.ErrExceedResourceMaxCount(errorno.ErrNameName, errorno.ErrNameCharacter, MaxNameLength)
@@ -226,9 +306,46 @@ func (s *Subnet6) ValidateParams(clientClass6s []*ClientClass6, addressCodes []*
 		s.V6Prefix64 = prefix64
 	}
 
+	if err := CheckOptionValue6s(s.Options, nil); err != nil {
+		return err
+	}
+
+	if err := checkOptionValue6sConflictWithCodes(s.Options, s.builtinOptionCodes()); err != nil {
+		return err
+	}
+
 	return checkNodesValid(s.Nodes)
 }
 
+func (s *Subnet6) builtinOptionCodes() map[uint32]errorno.ErrName {
+	codes := make(map[uint32]errorno.ErrName)
+	if len(s.DomainServers) != 0 {
+		codes[23] = errorno.ErrNameDNS
+	}
+
+	if len(s.DomainSearchList) != 0 {
+		codes[24] = errorno.ErrNameDomainSearchList
+	}
+
+	if s.InformationRefreshTime != 0 {
+		codes[32] = errorno.ErrNameInformationRefreshTime
+	}
+
+	if len(s.CapWapACAddresses) != 0 {
+		codes[52] = errorno.ErrNameCapWapACAddresses
+	}
+
+	if len(s.CaptivePortalUrl) != 0 {
+		codes[103] = errorno.ErrNameCaptivePortalUrl
+	}
+
+	if len(s.V6Prefix64) != 0 {
+		codes[113] = errorno.ErrNameV6Prefix64
+	}
+
+	return codes
+}
+
 func checkClientClass6s(whiteClientClasses, blackClientClasses []string, clientClass6s []*ClientClass6) (err error) {
 	if len(whiteClientClasses) == 0 && len(blackClientClasses) == 0 {
 		return
@@ -0,0 +1,114 @@
+package resource
+
+import (
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+var TableSubnet6Template = restdb.ResourceDBType(&Subnet6Template{})
+
+type Subnet6Template struct {
+	restresource.ResourceBase `json:",inline"`
+	Name                      string          `json:"name" rest:"required=true" db:"uk"`
+	WhiteClientClassStrategy  string          `json:"whiteClientClassStrategy"`
+	WhiteClientClasses        []string        `json:"whiteClientClasses"`
+	BlackClientClassStrategy  string          `json:"blackClientClassStrategy"`
+	BlackClientClasses        []string        `json:"blackClientClasses"`
+	ValidLifetime             uint32          `json:"validLifetime"`
+	MaxValidLifetime          uint32          `json:"maxValidLifetime"`
+	MinValidLifetime          uint32          `json:"minValidLifetime"`
+	PreferredLifetime         uint32          `json:"preferredLifetime"`
+	RapidCommit               bool            `json:"rapidCommit"`
+	DomainServers             []string        `json:"domainServers"`
+	DomainSearchList          []string        `json:"domainSearchList"`
+	InformationRefreshTime    uint32          `json:"informationRefreshTime"`
+	CapWapACAddresses         []string        `json:"capWapACAddresses"`
+	CaptivePortalUrl          string          `json:"captivePortalUrl"`
+	AutoReservationType       uint32          `json:"autoReservationType"`
+	Options                   []*OptionValue6 `json:"options" db:"-"`
+	Nodes                     []string        `json:"nodes"`
+	PoolTemplates             []string        `json:"poolTemplates"`
+	ReservedPoolTemplates     []string        `json:"reservedPoolTemplates"`
+	Comment                   string          `json:"comment"`
+}
+
+func (t *Subnet6Template) Validate(clientClass6s []*ClientClass6) error {
+	if len(t.Name) == 0 || util.ValidateStrings(util.RegexpTypeCommon, t.Name) != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, t.Name)
+	}
+
+	if err := util.ValidateStrings(util.RegexpTypeComma, t.Comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, t.Comment)
+	}
+
+	if err := checkTemplateNamesValid(t.PoolTemplates, t.ReservedPoolTemplates); err != nil {
+		return err
+	}
+
+	subnet := t.NewSubnet6(&SubnetFromTemplate{})
+	if err := subnet.setSubnet6DefaultValue(nil); err != nil {
+		return err
+	}
+
+	if err := subnet.ValidateParams(clientClass6s, nil); err != nil {
+		return err
+	}
+
+	t.Options = subnet.Options
+	return nil
+}
+
+// NewSubnet6 instantiates a subnet with template settings, routers of
+// subnetFromTemplate are ignored as DHCPv6 has no router option
+func (t *Subnet6Template) NewSubnet6(subnetFromTemplate *SubnetFromTemplate) *Subnet6 {
+	subnet := &Subnet6{
+		Subnet:                   subnetFromTemplate.Subnet,
+		Tags:                     subnetFromTemplate.Tags,
+		IfaceName:                subnetFromTemplate.IfaceName,
+		RelayAgentAddresses:      subnetFromTemplate.RelayAgentAddresses,
+		WhiteClientClassStrategy: t.WhiteClientClassStrategy,
+		WhiteClientClasses:       t.WhiteClientClasses,
+		BlackClientClassStrategy: t.BlackClientClassStrategy,
+		BlackClientClasses:       t.BlackClientClasses,
+		ValidLifetime:            t.ValidLifetime,
+		MaxValidLifetime:         t.MaxValidLifetime,
+		MinValidLifetime:         t.MinValidLifetime,
+		PreferredLifetime:        t.PreferredLifetime,
+		RapidCommit:              t.RapidCommit,
+		DomainServers:            t.DomainServers,
+		DomainSearchList:         t.DomainSearchList,
+		InformationRefreshTime:   t.InformationRefreshTime,
+		CapWapACAddresses:        t.CapWapACAddresses,
+		CaptivePortalUrl:         t.CaptivePortalUrl,
+		AutoReservationType:      t.AutoReservationType,
+		Nodes:                    t.Nodes,
+	}
+
+	for _, option := range t.Options {
+		subnet.Options = append(subnet.Options, &OptionValue6{
+			Name:  option.Name,
+			Code:  option.Code,
+			Value: option.Value,
+			Data:  option.Data,
+		})
+	}
+
+	return subnet
+}
+
+func (t *Subnet6Template) NewPool6s() ([]*Pool6, []*ReservedPool6) {
+	pools := make([]*Pool6, 0, len(t.PoolTemplates))
+	for _, template := range t.PoolTemplates {
+		pools = append(pools, &Pool6{Template: template})
+	}
+
+	reservedPools := make([]*ReservedPool6, 0, len(t.ReservedPoolTemplates))
+	for _, template := range t.ReservedPoolTemplates {
+		reservedPools = append(reservedPools, &ReservedPool6{Template: template})
+	}
+
+	return pools, reservedPools
+}
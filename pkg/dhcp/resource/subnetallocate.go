@@ -0,0 +1,49 @@
+package resource
+
+import (
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+const (
+	ActionNameAllocate = "allocate"
+
+	AllocateStrategyFirstFit = "first-fit"
+	AllocateStrategyBestFit  = "best-fit"
+
+	MaxAllocateSubnetsCount = 256
+)
+
+type AllocateSubnetsInput struct {
+	Parent    string   `json:"parent"`
+	PrefixLen uint32   `json:"prefixLen"`
+	Count     uint32   `json:"count"`
+	Strategy  string   `json:"strategy"`
+	Create    bool     `json:"create"`
+	Template  string   `json:"template"`
+	Tags      string   `json:"tags"`
+	Nodes     []string `json:"nodes"`
+}
+
+func (a *AllocateSubnetsInput) Validate() error {
+	if a.Count == 0 {
+		a.Count = 1
+	} else if a.Count > MaxAllocateSubnetsCount {
+		return errorno.ErrNotInRange(errorno.ErrNameCount, 1, MaxAllocateSubnetsCount)
+	}
+
+	switch a.Strategy {
+	case "":
+		a.Strategy = AllocateStrategyFirstFit
+	case AllocateStrategyFirstFit, AllocateStrategyBestFit:
+	default:
+		return errorno.ErrInvalidParams(errorno.ErrNameAllocateStrategy, a.Strategy)
+	}
+
+	return nil
+}
+
+type AllocateSubnetsOutput struct {
+	Subnets  []string   `json:"subnets"`
+	Subnet4s []*Subnet4 `json:"subnet4s,omitempty"`
+	Subnet6s []*Subnet6 `json:"subnet6s,omitempty"`
+}
@@ -0,0 +1,148 @@
+package resource
+
+import (
+	"encoding/base64"
+	"net"
+	"strings"
+
+	"github.com/cuityhj/g53"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/ddns"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+var TableSubnetDdns4 = restdb.ResourceDBType(&SubnetDdns4{})
+
+type DdnsReplaceClientName string
+
+const (
+	DdnsReplaceClientNameNever          DdnsReplaceClientName = "never"
+	DdnsReplaceClientNameAlways         DdnsReplaceClientName = "always"
+	DdnsReplaceClientNameWhenPresent    DdnsReplaceClientName = "when-present"
+	DdnsReplaceClientNameWhenNotPresent DdnsReplaceClientName = "when-not-present"
+)
+
+type DdnsConflictResolution string
+
+const (
+	DdnsConflictResolutionCheckWithDhcid      DdnsConflictResolution = "check-with-dhcid"
+	DdnsConflictResolutionNoCheckWithDhcid    DdnsConflictResolution = "no-check-with-dhcid"
+	DdnsConflictResolutionNoCheckWithoutDhcid DdnsConflictResolution = "no-check-without-dhcid"
+)
+
+const (
+	TsigAlgorithmHmacMD5    = "hmac-md5"
+	TsigAlgorithmHmacSHA1   = "hmac-sha1"
+	TsigAlgorithmHmacSHA256 = "hmac-sha256"
+	TsigAlgorithmHmacSHA512 = "hmac-sha512"
+
+	DefaultDdnsGeneratedPrefix = "dhcp"
+)
+
+type SubnetDdns4 struct {
+	restresource.ResourceBase `json:",inline"`
+	Subnet4                   string                 `json:"-" db:"ownby"`
+	Enabled                   bool                   `json:"enabled"`
+	ForwardZone               string                 `json:"forwardZone"`
+	ReverseZone               string                 `json:"reverseZone"`
+	Server                    string                 `json:"server"`
+	TsigKeyName               string                 `json:"tsigKeyName"`
+	TsigAlgorithm             string                 `json:"tsigAlgorithm"`
+	TsigSecret                string                 `json:"tsigSecret,omitempty"`
+	Ttl                       uint32                 `json:"ttl"`
+	QualifyingSuffix          string                 `json:"qualifyingSuffix"`
+	ReplaceClientName         DdnsReplaceClientName  `json:"replaceClientName"`
+	GeneratedPrefix           string                 `json:"generatedPrefix"`
+	ConflictResolution        DdnsConflictResolution `json:"conflictResolution"`
+}
+
+func (s SubnetDdns4) GetParents() []restresource.ResourceKind {
+	return []restresource.ResourceKind{Subnet4{}}
+}
+
+func (s *SubnetDdns4) Validate() error {
+	return validateDdnsSettings(s.Enabled, &s.ForwardZone, &s.ReverseZone, s.Server,
+		s.TsigKeyName, s.TsigAlgorithm, s.TsigSecret, &s.QualifyingSuffix,
+		&s.ReplaceClientName, &s.GeneratedPrefix, &s.ConflictResolution)
+}
+
+func validateDdnsSettings(enabled bool, forwardZone, reverseZone *string, server, tsigKeyName, tsigAlgorithm, tsigSecret string, qualifyingSuffix *string, replaceClientName *DdnsReplaceClientName, generatedPrefix *string, conflictResolution *DdnsConflictResolution) error {
+	for _, zone := range []*string{forwardZone, reverseZone, qualifyingSuffix} {
+		if *zone == "" {
+			continue
+		}
+
+		if _, err := g53.NameFromString(*zone); err != nil {
+			return errorno.ErrInvalidParams(errorno.ErrNameDnsZone, *zone)
+		}
+
+		*zone = ddns.Fqdn(*zone)
+	}
+
+	if enabled {
+		if *forwardZone == "" && *reverseZone == "" {
+			return errorno.ErrEmpty(string(errorno.ErrNameDnsZone))
+		}
+
+		if server == "" {
+			return errorno.ErrEmpty(string(errorno.ErrNameDnsServer))
+		}
+	}
+
+	if server != "" {
+		host, _, err := net.SplitHostPort(server)
+		if err != nil {
+			host = server
+		}
+
+		if net.ParseIP(host) == nil {
+			return errorno.ErrInvalidParams(errorno.ErrNameDnsServer, server)
+		}
+	}
+
+	if tsigKeyName != "" {
+		if _, err := g53.NameFromString(tsigKeyName); err != nil {
+			return errorno.ErrInvalidParams(errorno.ErrNameTsigKey, tsigKeyName)
+		}
+
+		switch tsigAlgorithm {
+		case TsigAlgorithmHmacMD5, TsigAlgorithmHmacSHA1, TsigAlgorithmHmacSHA256,
+			TsigAlgorithmHmacSHA512:
+		default:
+			return errorno.ErrInvalidParams(errorno.ErrNameTsigKey, tsigAlgorithm)
+		}
+
+		if _, err := base64.StdEncoding.DecodeString(tsigSecret); err != nil || tsigSecret == "" {
+			return errorno.ErrInvalidParams(errorno.ErrNameTsigKey, tsigKeyName)
+		}
+	}
+
+	if *generatedPrefix == "" {
+		*generatedPrefix = DefaultDdnsGeneratedPrefix
+	} else if _, err := g53.NameFromString(*generatedPrefix); err != nil ||
+		strings.Contains(*generatedPrefix, ".") {
+		return errorno.ErrInvalidParams(errorno.ErrNamePrefix, *generatedPrefix)
+	}
+
+	switch *replaceClientName {
+	case "":
+		*replaceClientName = DdnsReplaceClientNameNever
+	case DdnsReplaceClientNameNever, DdnsReplaceClientNameAlways,
+		DdnsReplaceClientNameWhenPresent, DdnsReplaceClientNameWhenNotPresent:
+	default:
+		return errorno.ErrInvalidParams(errorno.ErrNameDdnsReplaceClientName, *replaceClientName)
+	}
+
+	switch *conflictResolution {
+	case "":
+		*conflictResolution = DdnsConflictResolutionCheckWithDhcid
+	case DdnsConflictResolutionCheckWithDhcid, DdnsConflictResolutionNoCheckWithDhcid,
+		DdnsConflictResolutionNoCheckWithoutDhcid:
+	default:
+		return errorno.ErrInvalidParams(errorno.ErrNameDdnsConflictResolution, *conflictResolution)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package resource
+
+import (
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+var TableSubnetDdns6 = restdb.ResourceDBType(&SubnetDdns6{})
+
+type SubnetDdns6 struct {
+	restresource.ResourceBase `json:",inline"`
+	Subnet6                   string                 `json:"-" db:"ownby"`
+	Enabled                   bool                   `json:"enabled"`
+	ForwardZone               string                 `json:"forwardZone"`
+	ReverseZone               string                 `json:"reverseZone"`
+	Server                    string                 `json:"server"`
+	TsigKeyName               string                 `json:"tsigKeyName"`
+	TsigAlgorithm             string                 `json:"tsigAlgorithm"`
+	TsigSecret                string                 `json:"tsigSecret,omitempty"`
+	Ttl                       uint32                 `json:"ttl"`
+	QualifyingSuffix          string                 `json:"qualifyingSuffix"`
+	ReplaceClientName         DdnsReplaceClientName  `json:"replaceClientName"`
+	GeneratedPrefix           string                 `json:"generatedPrefix"`
+	ConflictResolution        DdnsConflictResolution `json:"conflictResolution"`
+}
+
+func (s SubnetDdns6) GetParents() []restresource.ResourceKind {
+	return []restresource.ResourceKind{Subnet6{}}
+}
+
+func (s *SubnetDdns6) Validate() error {
+	return validateDdnsSettings(s.Enabled, &s.ForwardZone, &s.ReverseZone, s.Server,
+		s.TsigKeyName, s.TsigAlgorithm, s.TsigSecret, &s.QualifyingSuffix,
+		&s.ReplaceClientName, &s.GeneratedPrefix, &s.ConflictResolution)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/linkingthing/cement/uuid"
+	"github.com/linkingthing/clxone-utils/excel"
 	restdb "github.com/linkingthing/gorest/db"
 	restresource "github.com/linkingthing/gorest/resource"
 )
@@ -90,6 +91,7 @@ func (l *SubnetLease4) Equal(another *SubnetLease4) bool {
 
 const (
 	ActionFingerprintStatistics = "fingerprint_statistics"
+	ActionForceRenew            = "force_renew"
 )
 
 func (s SubnetLease4) GetActions() []restresource.Action {
@@ -111,6 +113,16 @@ func (s SubnetLease4) GetActions() []restresource.Action {
 			Name:   ActionFingerprintStatistics,
 			Output: &FingerprintStatistics{},
 		},
+		{
+			Name:   excel.ActionNameExport,
+			Input:  &ExportLeasesInput{},
+			Output: &excel.ExportFile{},
+		},
+		{
+			Name:   ActionForceRenew,
+			Input:  &ForceRenewLeasesInput{},
+			Output: &ForceRenewLeasesOutput{},
+		},
 	}
 }
 
@@ -118,6 +130,36 @@ type BatchDeleteLeasesInput struct {
 	Addresses []string `json:"addresses"`
 }
 
+type ForceRenewLeasesInput struct {
+	Addresses    []string `json:"addresses"`
+	ReleaseLease bool     `json:"releaseLease"`
+}
+
+type ForceRenewLeasesOutput struct {
+	Results []*ForceRenewResult `json:"results"`
+}
+
+type ForceRenewResult struct {
+	Address      string `json:"address"`
+	Succeed      bool   `json:"succeed"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+type ExportLeasesInput struct {
+	LeaseStates    []string `json:"leaseStates"`
+	ClientType     string   `json:"clientType"`
+	Hostname       string   `json:"hostname"`
+	ExpirationFrom string   `json:"expirationFrom"`
+	ExpirationTo   string   `json:"expirationTo"`
+}
+
+const ActionNameExportLeases = "export_leases"
+
+type ExportSubnetLeasesInput struct {
+	Subnets           []string `json:"subnets"`
+	ExportLeasesInput `json:",inline"`
+}
+
 type ConvToReservationInput struct {
 	Addresses       []string                `json:"addresses"`
 	ReservationType ReservationType         `json:"reservationType"`
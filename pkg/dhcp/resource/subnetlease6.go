@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/linkingthing/cement/uuid"
+	"github.com/linkingthing/clxone-utils/excel"
 	restdb "github.com/linkingthing/gorest/db"
 
 	restresource "github.com/linkingthing/gorest/resource"
@@ -127,5 +128,15 @@ func (s SubnetLease6) GetActions() []restresource.Action {
 			Name:   ActionFingerprintStatistics,
 			Output: &FingerprintStatistics{},
 		},
+		{
+			Name:   excel.ActionNameExport,
+			Input:  &ExportLeasesInput{},
+			Output: &excel.ExportFile{},
+		},
+		{
+			Name:   ActionForceRenew,
+			Input:  &ForceRenewLeasesInput{},
+			Output: &ForceRenewLeasesOutput{},
+		},
 	}
 }
@@ -0,0 +1,54 @@
+package resource
+
+import (
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+const (
+	ActionNameNextFreeAddress = "next_free_address"
+
+	NextFreeAddressCreateReservation  = "reservation"
+	NextFreeAddressCreateReservedPool = "reserved_pool"
+
+	MaxNextFreeAddressCount = 256
+)
+
+type NextFreeAddressInput struct {
+	BeginAddress string `json:"beginAddress"`
+	EndAddress   string `json:"endAddress"`
+	Count        uint32 `json:"count"`
+	Ping         bool   `json:"ping"`
+	CreateMode   string `json:"createMode"`
+	HwAddress    string `json:"hwAddress"`
+	Duid         string `json:"duid"`
+	Hostname     string `json:"hostname"`
+	Comment      string `json:"comment"`
+}
+
+func (n *NextFreeAddressInput) Validate() error {
+	if n.Count == 0 {
+		n.Count = 1
+	} else if n.Count > MaxNextFreeAddressCount {
+		return errorno.ErrNotInRange(errorno.ErrNameCount, 1, MaxNextFreeAddressCount)
+	}
+
+	switch n.CreateMode {
+	case "", NextFreeAddressCreateReservedPool:
+	case NextFreeAddressCreateReservation:
+		if n.Count != 1 {
+			return errorno.ErrInvalidParams(errorno.ErrNameCount, n.Count)
+		}
+	default:
+		return errorno.ErrInvalidParams(errorno.ErrNameCreateMode, n.CreateMode)
+	}
+
+	return nil
+}
+
+type NextFreeAddressOutput struct {
+	Addresses     []string       `json:"addresses"`
+	Reservation4  *Reservation4  `json:"reservation4,omitempty"`
+	ReservedPool4 *ReservedPool4 `json:"reservedPool4,omitempty"`
+	Reservation6  *Reservation6  `json:"reservation6,omitempty"`
+	ReservedPool6 *ReservedPool6 `json:"reservedPool6,omitempty"`
+}
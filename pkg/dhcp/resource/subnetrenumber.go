@@ -0,0 +1,88 @@
+package resource
+
+import (
+	"net"
+	"strings"
+)
+
+const ActionNameRenumber = "renumber"
+
+type RenumberSubnetInput struct {
+	Subnet  string `json:"subnet"`
+	Preview bool   `json:"preview"`
+}
+
+type RenumberSubnetOutput struct {
+	OldSubnet       string             `json:"oldSubnet"`
+	NewSubnet       string             `json:"newSubnet"`
+	LeasesCount     uint64             `json:"leasesCount"`
+	Applied         bool               `json:"applied"`
+	SubnetAddresses []*RenumberMapping `json:"subnetAddresses"`
+	Pools           []*RenumberMapping `json:"pools"`
+	ReservedPools   []*RenumberMapping `json:"reservedPools"`
+	Reservations    []*RenumberMapping `json:"reservations"`
+	PdPools         []*RenumberMapping `json:"pdPools,omitempty"`
+	ReservedPdPools []*RenumberMapping `json:"reservedPdPools,omitempty"`
+	Options         []*RenumberMapping `json:"options,omitempty"`
+	Overrides       []*RenumberMapping `json:"overrides,omitempty"`
+}
+
+type RenumberMapping struct {
+	Id  string `json:"id,omitempty"`
+	Old string `json:"old"`
+	New string `json:"new"`
+}
+
+// ip not in oldIpnet is returned as it is
+func RenumberIp(ip net.IP, oldIpnet, newIpnet net.IPNet) net.IP {
+	if !oldIpnet.Contains(ip) {
+		return ip
+	}
+
+	base, mask := newIpnet.IP.To16(), oldIpnet.Mask
+	if ip4 := ip.To4(); ip4 != nil {
+		ip, base = ip4, newIpnet.IP.To4()
+	} else {
+		ip = ip.To16()
+	}
+
+	if len(mask) > len(ip) {
+		mask = mask[len(mask)-len(ip):]
+	}
+
+	newIp := make(net.IP, len(ip))
+	for i := range ip {
+		newIp[i] = base[i] | ip[i]&^mask[i]
+	}
+
+	return newIp
+}
+
+func RenumberAddress(address string, oldIpnet, newIpnet net.IPNet) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return RenumberIp(ip, oldIpnet, newIpnet).String()
+	}
+
+	return address
+}
+
+func RenumberOptionValue(value string, oldIpnet, newIpnet net.IPNet) string {
+	fields := strings.Split(value, OptionValueDelimiter)
+	for i, field := range fields {
+		if address := strings.TrimSpace(field); net.ParseIP(address) != nil {
+			fields[i] = strings.Replace(field, address,
+				RenumberAddress(address, oldIpnet, newIpnet), 1)
+		}
+	}
+
+	return strings.Join(fields, OptionValueDelimiter)
+}
+
+func RenumberPrefix(prefix string, oldIpnet, newIpnet net.IPNet) string {
+	if _, ipnet, err := net.ParseCIDR(prefix); err == nil {
+		ipnet.IP = RenumberIp(ipnet.IP, oldIpnet, newIpnet)
+		return ipnet.String()
+	}
+
+	return prefix
+}
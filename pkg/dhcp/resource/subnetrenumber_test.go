@@ -0,0 +1,33 @@
+package resource
+
+import (
+	"net"
+	"testing"
+)
+
+func TestRenumberOptionValue(t *testing.T) {
+	_, oldIpnet4, _ := net.ParseCIDR("10.0.0.0/24")
+	_, newIpnet4, _ := net.ParseCIDR("10.1.0.0/24")
+	_, oldIpnet6, _ := net.ParseCIDR("2001:db8:1::/64")
+	_, newIpnet6, _ := net.ParseCIDR("2001:db8:2::/64")
+	cases := []struct {
+		oldIpnet *net.IPNet
+		newIpnet *net.IPNet
+		value    string
+		newValue string
+	}{
+		{oldIpnet4, newIpnet4, "10.0.0.1", "10.1.0.1"},
+		{oldIpnet4, newIpnet4, "10.0.0.1, 8.8.8.8,10.0.0.254", "10.1.0.1, 8.8.8.8,10.1.0.254"},
+		{oldIpnet4, newIpnet4, "10.0.0.0/24,10.0.0.1", "10.0.0.0/24,10.1.0.1"},
+		{oldIpnet4, newIpnet4, "pxe,10.0.0.5", "pxe,10.1.0.5"},
+		{oldIpnet4, newIpnet4, "boot.example.com", "boot.example.com"},
+		{oldIpnet6, newIpnet6, "2001:db8:1::53,2001:db8:3::53", "2001:db8:2::53,2001:db8:3::53"},
+	}
+
+	for _, c := range cases {
+		if newValue := RenumberOptionValue(c.value, *c.oldIpnet, *c.newIpnet); newValue != c.newValue {
+			t.Errorf("renumber %s from %s to %s got %s, want %s", c.value, c.oldIpnet.String(),
+				c.newIpnet.String(), newValue, c.newValue)
+		}
+	}
+}
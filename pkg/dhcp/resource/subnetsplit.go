@@ -0,0 +1,16 @@
+package resource
+
+const (
+	ActionNameSplit = "split"
+	ActionNameMerge = "merge"
+
+	MaxSplitSubnetsCount = 256
+)
+
+type SplitSubnetInput struct {
+	PrefixLen uint32 `json:"prefixLen"`
+}
+
+type MergeSubnetsInput struct {
+	SubnetIds []string `json:"subnetIds"`
+}
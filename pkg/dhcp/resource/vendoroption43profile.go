@@ -0,0 +1,127 @@
+package resource
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"unicode/utf8"
+
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	Option4CodeVendorEncapsulatedOptions = 43
+	Option4NameVendorEncapsulatedOptions = "vendor-encapsulated-options"
+	MaxVendorSubOption43Code             = 254
+)
+
+var vendorSubOption43Types = map[OptionType]struct{}{
+	OptionTypeIp:      {},
+	OptionTypeIpList:  {},
+	OptionTypeUint8:   {},
+	OptionTypeUint16:  {},
+	OptionTypeUint32:  {},
+	OptionTypeString:  {},
+	OptionTypeHex:     {},
+	OptionTypeBoolean: {},
+}
+
+var TableVendorOption43Profile = restdb.ResourceDBType(&VendorOption43Profile{})
+
+type VendorOption43Profile struct {
+	restresource.ResourceBase `json:",inline"`
+	Name                      string               `json:"name" rest:"required=true,description=immutable" db:"uk"`
+	Condition                 OptionCondition      `json:"condition" rest:"required=true,options=exists|equal|substring"`
+	Regexp                    string               `json:"regexp"`
+	BeginIndex                uint32               `json:"beginIndex"`
+	SubOptions                []*VendorSubOption43 `json:"subOptions" db:"-"`
+	Data                      string               `json:"data" rest:"description=readonly"`
+	Comment                   string               `json:"comment"`
+}
+
+var TableVendorSubOption43 = restdb.ResourceDBType(&VendorSubOption43{})
+
+type VendorSubOption43 struct {
+	restresource.ResourceBase `json:"-"`
+	Profile                   string     `json:"-"`
+	Code                      uint32     `json:"code"`
+	Type                      OptionType `json:"type"`
+	Value                     string     `json:"value"`
+}
+
+func (p *VendorOption43Profile) Validate() error {
+	if len(p.Name) == 0 || (p.Condition != OptionConditionExists && len(p.Regexp) == 0) {
+		return errorno.ErrEmpty(string(errorno.ErrNameName), string(errorno.ErrNameRegexp))
+	} else if err := util.ValidateStrings(util.RegexpTypeCommon, p.Name); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameName, p.Name)
+	} else if utf8.RuneCountInString(p.Name) > MaxNameLength {
+		return errorno.ErrExceedResourceMaxCount(errorno.ErrNameName,
+			errorno.ErrNameCharacter, MaxNameLength)
+	} else if err := util.ValidateStrings(util.RegexpTypeSlash, p.Regexp); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameRegexp, p.Regexp)
+	} else if err := util.ValidateStrings(util.RegexpTypeComma, p.Comment); err != nil {
+		return errorno.ErrInvalidParams(errorno.ErrNameComment, p.Comment)
+	} else if utf8.RuneCountInString(p.Comment) > MaxCommentLength {
+		return errorno.ErrExceedMaxCount(errorno.ErrNameComment, MaxCommentLength)
+	} else {
+		return p.encodeSubOptions()
+	}
+}
+
+func (p *VendorOption43Profile) encodeSubOptions() error {
+	if len(p.SubOptions) == 0 {
+		return errorno.ErrEmpty(string(errorno.ErrNameSubOption))
+	}
+
+	var buf bytes.Buffer
+	codes := make(map[uint32]struct{}, len(p.SubOptions))
+	for _, subOption := range p.SubOptions {
+		code := strconv.FormatUint(uint64(subOption.Code), 10)
+		if subOption.Code == 0 || subOption.Code > MaxVendorSubOption43Code {
+			return errorno.ErrNotInRange(errorno.ErrNameSubOption, 1, MaxVendorSubOption43Code)
+		} else if _, ok := codes[subOption.Code]; ok {
+			return errorno.ErrDuplicate(errorno.ErrNameSubOption, code)
+		} else if _, ok := vendorSubOption43Types[subOption.Type]; !ok {
+			return errorno.ErrInvalidParams(errorno.ErrNameOptionType, subOption.Type)
+		}
+
+		data, err := encodeOptionField(true, subOption.Type, subOption.Value)
+		if err != nil {
+			return errorno.ErrInvalidOptionValue(code, subOption.Value, err.Error())
+		} else if len(data) > MaxOptionDataLength {
+			return errorno.ErrExceedMaxCount(errorno.ErrNameSubOption, MaxOptionDataLength)
+		}
+
+		codes[subOption.Code] = struct{}{}
+		buf.WriteByte(byte(subOption.Code))
+		buf.WriteByte(byte(len(data)))
+		buf.Write(data)
+	}
+
+	if buf.Len() > MaxOptionDataLength {
+		return errorno.ErrExceedMaxCount(errorno.ErrNameVendorOption43Profile, MaxOptionDataLength)
+	}
+
+	p.Data = hex.EncodeToString(buf.Bytes())
+	return nil
+}
+
+func (p *VendorOption43Profile) ToClientClass4() *ClientClass4 {
+	return &ClientClass4{
+		Name:        p.Name,
+		Code:        Option4CodeClassIdentifier,
+		Condition:   p.Condition,
+		Regexp:      p.Regexp,
+		BeginIndex:  p.BeginIndex,
+		Description: code4ToDescription(uint8(Option4CodeClassIdentifier)),
+		Options: []*OptionValue4{&OptionValue4{
+			Name: Option4NameVendorEncapsulatedOptions,
+			Code: Option4CodeVendorEncapsulatedOptions,
+			Data: p.Data,
+		}},
+	}
+}
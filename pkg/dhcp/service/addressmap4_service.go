@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	transport "github.com/linkingthing/clxone-dhcp/pkg/transport/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	DefaultAddressMapPageSize = 256
+	MaxAddressMapPageSize     = 1024
+)
+
+type AddressMap4Service struct {
+}
+
+func NewAddressMap4Service() *AddressMap4Service {
+	return &AddressMap4Service{}
+}
+
+// List returns a page of addresses of subnet from page_token, the address
+// after the page is returned as next page token, and total count is the
+// count of all addresses of subnet
+func (a *AddressMap4Service) List(subnet *resource.Subnet4, filters []restresource.Filter) ([]*resource.AddressMap4, string, uint64, error) {
+	pageSize := uint64(DefaultAddressMapPageSize)
+	if size, ok := util.GetFilterValueWithEqModifierFromFilters(FilterNamePageSize, filters); ok {
+		var err error
+		if pageSize, err = strconv.ParseUint(strings.TrimSpace(size), 10, 32); err != nil ||
+			pageSize == 0 || pageSize > MaxAddressMapPageSize {
+			return nil, "", 0, errorno.ErrNotInRange(errorno.ErrNamePageSize, 1, MaxAddressMapPageSize)
+		}
+	}
+
+	var subnet4 *resource.Subnet4
+	var pools []*resource.Pool4
+	var reservedPools []*resource.ReservedPool4
+	var reservations []*resource.Reservation4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		if subnet4, err = getSubnet4FromDB(tx, subnet.GetID()); err != nil {
+			return err
+		}
+
+		if pools, err = getPool4sWithSubnetId(tx, subnet.GetID()); err != nil {
+			return err
+		}
+
+		if reservedPools, err = getReservedPool4sWithSubnetId(tx, subnet.GetID()); err != nil {
+			return err
+		}
+
+		reservations, err = getReservation4sWithSubnetId(tx, subnet.GetID())
+		return err
+	}); err != nil {
+		return nil, "", 0, err
+	}
+
+	ones, _ := subnet4.Ipnet.Mask.Size()
+	network := gohelperip.IPv4ToUint32(subnet4.Ipnet.IP)
+	broadcast := network | ^(^uint32(0) << (32 - uint(ones)))
+	totalCount := uint64(broadcast-network) + 1
+	begin := network
+	if pageToken, ok := util.GetFilterValueWithEqModifierFromFilters(FilterNamePageToken, filters); ok && pageToken != "" {
+		ip, err := gohelperip.ParseIPv4(pageToken)
+		if err != nil || !subnet4.Ipnet.Contains(ip) {
+			return nil, "", 0, errorno.ErrNotBelongTo(errorno.ErrNameIp, errorno.ErrNameNetworkV4,
+				pageToken, subnet4.Subnet)
+		}
+
+		begin = gohelperip.IPv4ToUint32(ip)
+	}
+
+	end := broadcast
+	if uint64(end-begin) >= pageSize {
+		end = begin + uint32(pageSize) - 1
+	}
+
+	reservationMap := reservationMapFromReservation4s(reservations)
+	addressMaps := make([]*resource.AddressMap4, 0, end-begin+1)
+	addresses := make([]string, 0, end-begin+1)
+	for i := uint64(begin); i <= uint64(end); i++ {
+		address := gohelperip.IPv4FromUint32(uint32(i)).String()
+		isNetworkOrBroadcast := ones < 31 && (uint32(i) == network || uint32(i) == broadcast)
+		addressMap := &resource.AddressMap4{
+			Address: address,
+			AddressType: getAddressMap4Type(subnet4, address, isNetworkOrBroadcast,
+				reservationMap, reservedPools, pools),
+		}
+		addressMap.SetID(address)
+		addressMaps = append(addressMaps, addressMap)
+		addresses = append(addresses, address)
+	}
+
+	if err := setAddressMap4sLeases(subnet4, addressMaps, addresses, reservationMap); err != nil {
+		return nil, "", 0, err
+	}
+
+	var nextPageToken string
+	if end != broadcast {
+		nextPageToken = gohelperip.IPv4FromUint32(end + 1).String()
+	}
+
+	return addressMaps, nextPageToken, totalCount, nil
+}
+
+func getAddressMap4Type(subnet *resource.Subnet4, address string, isNetworkOrBroadcast bool, reservationMap map[string]*resource.Reservation4, reservedPools []*resource.ReservedPool4, pools []*resource.Pool4) resource.AddressType {
+	if isNetworkOrBroadcast {
+		return resource.AddressTypeUnmanaged
+	}
+
+	if _, ok := reservationMap[address]; ok {
+		return resource.AddressTypeReservation
+	}
+
+	for _, reservedPool := range reservedPools {
+		if reservedPool.ContainsIpstr(address) {
+			return resource.AddressTypeReserve
+		}
+	}
+
+	for _, pool := range pools {
+		if pool.ContainsIpstr(address) {
+			return resource.AddressTypeDynamic
+		}
+	}
+
+	for _, router := range subnet.Routers {
+		if router == address {
+			return resource.AddressTypeUnmanaged
+		}
+	}
+
+	return resource.AddressTypeExclusion
+}
+
+func setAddressMap4sLeases(subnet *resource.Subnet4, addressMaps []*resource.AddressMap4, addresses []string, reservationMap map[string]*resource.Reservation4) error {
+	if len(subnet.Nodes) == 0 {
+		return nil
+	}
+
+	var reclaimedSubnetLeases []*resource.SubnetLease4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		reclaimedSubnetLeases, err = getReclaimedSubnetLease4sWithIps(tx, subnet.GetID(), addresses)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	var resp *pbdhcpagent.GetLeases4Response
+	if err := transport.CallDhcpAgentGrpc4(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+		resp, err = client.GetSubnet4LeasesWithIps(ctx,
+			ipsToPbGetSubnet4LeasesWithIpsRequest(subnet.SubnetId, addresses))
+		return err
+	}); err != nil {
+		return errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+	}
+
+	leases, _ := subnetLease4sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases, reservationMap, false)
+	leaseMap := make(map[string]*resource.SubnetLease4, len(leases))
+	for _, lease := range leases {
+		leaseMap[lease.Address] = lease
+	}
+
+	for _, addressMap := range addressMaps {
+		if lease, ok := leaseMap[addressMap.Address]; ok {
+			addressMap.LeaseState = lease.LeaseState
+			addressMap.HwAddress = lease.HwAddress
+			addressMap.Hostname = lease.Hostname
+			addressMap.ExpirationTime = lease.ExpirationTime
+			addressMap.ClientType = lease.ClientType
+		}
+	}
+
+	return nil
+}
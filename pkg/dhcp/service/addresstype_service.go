@@ -0,0 +1,66 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+func GetIPv4AddressType(tx restdb.Transaction, subnetId, ip string) (resource.AddressType, error) {
+	addressType := resource.AddressTypeExclusion
+	if exists, err := tx.Exists(resource.TableReservation4,
+		map[string]interface{}{resource.SqlColumnIpAddress: ip, resource.SqlColumnSubnet4: subnetId}); err != nil {
+		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
+	} else if exists {
+		return resource.AddressTypeReservation, nil
+	}
+
+	if count, err := tx.CountEx(resource.TableReservedPool4,
+		"select count(*) from gr_reserved_pool4 where subnet4 = $1 and begin_ip <= $2 and end_ip >= $3",
+		subnetId, ip, ip); err != nil {
+		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
+	} else if count != 0 {
+		return resource.AddressTypeReserve, nil
+	}
+
+	if count, err := tx.CountEx(resource.TablePool4,
+		"select count(*) from gr_pool4 where subnet4 = $1 and begin_ip <= $2 and end_ip >= $3",
+		subnetId, ip, ip); err != nil {
+		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
+	} else if count != 0 {
+		return resource.AddressTypeDynamic, nil
+	}
+
+	return addressType, nil
+}
+
+func GetIPv6AddressType(tx restdb.Transaction, subnetId, ip string) (resource.AddressType, error) {
+	addressType := resource.AddressTypeExclusion
+	if count, err := tx.CountEx(resource.TableReservation6,
+		"select count(*) from gr_reservation6 where subnet6 = $1 and $2::text = any(ip_addresses)",
+		subnetId, ip); err != nil {
+		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
+	} else if count != 0 {
+		return resource.AddressTypeReservation, nil
+	}
+
+	if count, err := tx.CountEx(resource.TableReservedPool6,
+		"select count(*) from gr_reserved_pool6 where subnet6 = $1 and begin_ip <= $2 and end_ip >= $3",
+		subnetId, ip, ip); err != nil {
+		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
+	} else if count != 0 {
+		return resource.AddressTypeReserve, nil
+	}
+
+	if count, err := tx.CountEx(resource.TablePool6,
+		"select count(*) from gr_pool6 where subnet6 = $1 and begin_ip <= $2 and end_ip >= $3",
+		subnetId, ip, ip); err != nil {
+		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
+	} else if count != 0 {
+		return resource.AddressTypeDynamic, nil
+	}
+
+	return addressType, nil
+}
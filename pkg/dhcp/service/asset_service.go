@@ -10,11 +10,13 @@ import (
 	pg "github.com/linkingthing/clxone-utils/postgresql"
 	restdb "github.com/linkingthing/gorest/db"
 
+	"github.com/linkingthing/clxone-dhcp/config"
 	"github.com/linkingthing/clxone-dhcp/pkg/db"
 	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
 	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
 	"github.com/linkingthing/clxone-dhcp/pkg/kafka"
 	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	pbdhcp "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-server"
 	"github.com/linkingthing/clxone-dhcp/pkg/util"
 )
 
@@ -22,6 +24,8 @@ const (
 	AssetFileNamePrefix       = "dhcp-asset-"
 	AssetTemplateFileName     = "dhcp-asset-template"
 	AssetImportFileNamePrefix = "dhcp-asset-import"
+
+	FilterNameState = "state"
 )
 
 type AssetService struct{}
@@ -70,7 +74,7 @@ func assetToPbCreateAssetRequest(asset *resource.Asset) *pbdhcpagent.CreateAsset
 	}
 }
 
-func (a *AssetService) List(conditions map[string]interface{}) ([]*resource.Asset, error) {
+func (a *AssetService) List(conditions map[string]interface{}, state string) ([]*resource.Asset, error) {
 	if hwaddrInterface, ok := conditions[resource.SqlColumnHwAddress]; ok {
 		if hwaddr, ok := hwaddrInterface.(string); ok {
 			conditions[resource.SqlColumnHwAddress] = strings.ToUpper(hwaddr)
@@ -85,7 +89,20 @@ func (a *AssetService) List(conditions map[string]interface{}) ([]*resource.Asse
 			string(errorno.ErrNameAsset), pg.Error(err).Error())
 	}
 
-	return assets, nil
+	return filterAssetsWithState(assets, state), nil
+}
+
+func filterAssetsWithState(assets []*resource.Asset, state string) []*resource.Asset {
+	now := time.Now()
+	inactiveDays := config.GetAssetInactiveDays()
+	filtered := assets[:0]
+	for _, asset := range assets {
+		if asset.CalculateState(now, inactiveDays) == resource.AssetState(state) || state == "" {
+			filtered = append(filtered, asset)
+		}
+	}
+
+	return filtered
 }
 
 func (a *AssetService) Get(id string) (*resource.Asset, error) {
@@ -98,6 +115,7 @@ func (a *AssetService) Get(id string) (*resource.Asset, error) {
 		return nil, errorno.ErrNotFound(errorno.ErrNameAsset, id)
 	}
 
+	assets[0].CalculateState(time.Now(), config.GetAssetInactiveDays())
 	return assets[0], nil
 }
 
@@ -365,3 +383,48 @@ func (a *AssetService) BatchDelete(ids []string) error {
 func sendDeleteAssetsCmdToDHCPAgent(deleteAssetsRequest *pbdhcpagent.DeleteAssetsRequest) error {
 	return kafka.SendDHCP6Cmd(kafka.DeleteAssets, deleteAssetsRequest, nil)
 }
+
+func updateAssetWithLease4(requestType string, lease4 pbdhcp.Lease4) {
+	updateAssetWithLease(requestType, lease4.GetHwAddress(), lease4.GetAddress(),
+		lease4.GetSubnet(), lease4.GetValidLifetime(), lease4.GetLeaseState())
+}
+
+func updateAssetWithLease6(requestType string, lease6 pbdhcp.Lease6) {
+	updateAssetWithLease(requestType, lease6.GetHwAddress(), lease6.GetAddress(),
+		lease6.GetSubnet(), lease6.GetValidLifetime(), lease6.GetLeaseState())
+}
+
+func updateAssetWithLease(requestType, hwAddress, address, subnet string, validLifetime, leaseState uint32) {
+	hwAddress, err := util.NormalizeMac(hwAddress)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		switch requestType {
+		case LeaseRequestTypeRequest:
+			if pbdhcpagent.LeaseState(leaseState) == pbdhcpagent.LeaseState_NORMAL {
+				_, err := tx.Update(resource.TableAsset, map[string]interface{}{
+					resource.SqlColumnLastSeenIp:     address,
+					resource.SqlColumnLastSeenSubnet: subnet,
+					resource.SqlColumnLastSeenTime:   now.Format(time.RFC3339),
+					resource.SqlColumnLeaseExpirationTime: now.Add(
+						time.Duration(validLifetime) * time.Second).Format(time.RFC3339),
+				}, map[string]interface{}{resource.SqlColumnHwAddress: hwAddress})
+				return err
+			}
+		}
+
+		_, err := tx.Update(resource.TableAsset, map[string]interface{}{
+			resource.SqlColumnLeaseExpirationTime: now.Format(time.RFC3339),
+		}, map[string]interface{}{
+			resource.SqlColumnHwAddress:  hwAddress,
+			resource.SqlColumnLastSeenIp: address,
+		})
+		return err
+	}); err != nil {
+		log.Warnf("update asset %s with lease %s failed: %s",
+			hwAddress, address, pg.Error(err).Error())
+	}
+}
@@ -2,8 +2,10 @@ package service
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/linkingthing/cement/log"
+	"github.com/linkingthing/cement/slice"
 	pg "github.com/linkingthing/clxone-utils/postgresql"
 	restdb "github.com/linkingthing/gorest/db"
 
@@ -35,10 +37,27 @@ func (c *ClientClass4Service) Create(clientClass *resource.ClientClass4) error {
 	}
 
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if exists, err := tx.Exists(resource.TableVendorOption43Profile,
+			map[string]interface{}{restdb.IDField: clientClass.Name}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameExists, clientClass.Name,
+				pg.Error(err).Error())
+		} else if exists {
+			return errorno.ErrDuplicate(errorno.ErrNameClientClass, clientClass.Name)
+		}
+
+		if err := checkClientClass4ExpressionMembers(tx, clientClass); err != nil {
+			return err
+		}
+
 		if _, err := tx.Insert(clientClass); err != nil {
 			return util.FormatDbInsertError(errorno.ErrNameClientClass, clientClass.Name, err)
 		}
 
+		if err := saveOptionValue4s(tx, resource.OptionScopeClientClass4, clientClass.GetID(),
+			"", clientClass.Options); err != nil {
+			return err
+		}
+
 		return sendCreateClientClass4CmdToAgent(clientClass)
 	})
 }
@@ -46,9 +65,10 @@ func (c *ClientClass4Service) Create(clientClass *resource.ClientClass4) error {
 func sendCreateClientClass4CmdToAgent(clientClass4 *resource.ClientClass4) error {
 	return kafka.SendDHCP4Cmd(kafka.CreateClientClass4,
 		&pbdhcpagent.CreateClientClass4Request{
-			Name:   clientClass4.Name,
-			Code:   uint32(clientClass4.Code),
-			Regexp: genClientClass4Regexp(clientClass4),
+			Name:         clientClass4.Name,
+			Code:         uint32(clientClass4.Code),
+			Regexp:       genClientClass4Regexp(clientClass4),
+			ClassOptions: pbSubnetOptionsFromOptionValue4s(clientClass4.Options),
 		}, func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeleteClientClass4,
@@ -66,6 +86,8 @@ func genClientClass4Regexp(clientclass *resource.ClientClass4) string {
 	case resource.OptionConditionSubstringEqual:
 		return fmt.Sprintf(ClientClassOptionSubstringEqual, clientclass.Code,
 			clientclass.BeginIndex, len(clientclass.Regexp), clientclass.Regexp)
+	case resource.OptionConditionExpression:
+		return clientclass.Regexp
 	default:
 		return fmt.Sprintf(ClientClassOptionExists, clientclass.Description)
 	}
@@ -74,9 +96,24 @@ func genClientClass4Regexp(clientclass *resource.ClientClass4) string {
 func (c *ClientClass4Service) List(conditions map[string]interface{}) ([]*resource.ClientClass4, error) {
 	var clientClasses []*resource.ClientClass4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		return tx.Fill(conditions, &clientClasses)
+		if err := tx.Fill(conditions, &clientClasses); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameClientClass), pg.Error(err).Error())
+		}
+
+		valuesMap, err := getOptionValue4sMap(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeClientClass4})
+		if err != nil {
+			return err
+		}
+
+		for _, clientClass := range clientClasses {
+			clientClass.Options = valuesMap[clientClass.GetID()]
+		}
+
+		return nil
 	}); err != nil {
-		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameClientClass), pg.Error(err).Error())
+		return nil, err
 	}
 
 	return clientClasses, nil
@@ -85,11 +122,19 @@ func (c *ClientClass4Service) List(conditions map[string]interface{}) ([]*resour
 func (c *ClientClass4Service) Get(id string) (*resource.ClientClass4, error) {
 	var clientClasses []*resource.ClientClass4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		return tx.Fill(map[string]interface{}{restdb.IDField: id}, &clientClasses)
+		if err := tx.Fill(map[string]interface{}{restdb.IDField: id}, &clientClasses); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		} else if len(clientClasses) == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameClientClass, id)
+		}
+
+		options, err := getOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeClientClass4,
+			resource.SqlColumnScopeId: id})
+		clientClasses[0].Options = options
+		return err
 	}); err != nil {
-		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
-	} else if len(clientClasses) == 0 {
-		return nil, errorno.ErrNotFound(errorno.ErrNameClientClass, id)
+		return nil, err
 	}
 
 	return clientClasses[0], nil
@@ -101,12 +146,17 @@ func (c *ClientClass4Service) Update(clientClass *resource.ClientClass4) error {
 	}
 
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkClientClass4ExpressionMembers(tx, clientClass); err != nil {
+			return err
+		}
+
 		if rows, err := tx.Update(resource.TableClientClass4,
 			map[string]interface{}{
 				resource.SqlColumnClassCondition:   clientClass.Condition,
 				resource.SqlColumnClassRegexp:      clientClass.Regexp,
 				resource.SqlColumnClassBeginIndex:  clientClass.BeginIndex,
 				resource.SqlColumnClassDescription: clientClass.Description,
+				resource.SqlColumnClassExpression:  clientClass.Expression,
 			},
 			map[string]interface{}{restdb.IDField: clientClass.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, clientClass.GetID(), pg.Error(err).Error())
@@ -114,6 +164,11 @@ func (c *ClientClass4Service) Update(clientClass *resource.ClientClass4) error {
 			return errorno.ErrNotFound(errorno.ErrNameClientClass, clientClass.GetID())
 		}
 
+		if err := saveOptionValue4s(tx, resource.OptionScopeClientClass4, clientClass.GetID(),
+			"", clientClass.Options); err != nil {
+			return err
+		}
+
 		return sendUpdateClientClass4CmdToDHCPAgent(clientClass)
 	})
 }
@@ -121,9 +176,10 @@ func (c *ClientClass4Service) Update(clientClass *resource.ClientClass4) error {
 func sendUpdateClientClass4CmdToDHCPAgent(clientClass *resource.ClientClass4) error {
 	return kafka.SendDHCP4Cmd(kafka.UpdateClientClass4,
 		&pbdhcpagent.UpdateClientClass4Request{
-			Name:   clientClass.Name,
-			Code:   uint32(clientClass.Code),
-			Regexp: genClientClass4Regexp(clientClass),
+			Name:         clientClass.Name,
+			Code:         uint32(clientClass.Code),
+			Regexp:       genClientClass4Regexp(clientClass),
+			ClassOptions: pbSubnetOptionsFromOptionValue4s(clientClass.Options),
 		}, nil)
 }
 
@@ -137,6 +193,22 @@ func (c *ClientClass4Service) Delete(id string) error {
 			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
 		}
 
+		if count, err := tx.CountEx(resource.TablePool4,
+			"select count(*) from gr_pool4 where $1::text = any(white_client_classes) or $1::text = any(black_client_classes)",
+			id); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameCount, string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
+		} else if count != 0 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+
+		if count, err := tx.CountEx(resource.TableSubnet4Template,
+			"select count(*) from gr_subnet4_template where $1::text = any(white_client_classes) or $1::text = any(black_client_classes)",
+			id); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameCount, string(errorno.ErrNameSubnetTemplate), pg.Error(err).Error())
+		} else if count != 0 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+
 		if count, err := tx.CountEx(resource.TableDhcpConfig,
 			"select count(*) from gr_dhcp_config where $1::text = any(subnet4_white_client_classes) or $1::text = any(subnet4_black_client_classes)",
 			id); err != nil {
@@ -145,6 +217,10 @@ func (c *ClientClass4Service) Delete(id string) error {
 			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
 		}
 
+		if err := checkClientClass4UsedByExpression(tx, id); err != nil {
+			return err
+		}
+
 		if rows, err := tx.Delete(resource.TableClientClass4,
 			map[string]interface{}{restdb.IDField: id}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
@@ -152,6 +228,12 @@ func (c *ClientClass4Service) Delete(id string) error {
 			return errorno.ErrNotFound(errorno.ErrNameClientClass, id)
 		}
 
+		if err := deleteOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeClientClass4,
+			resource.SqlColumnScopeId: id}); err != nil {
+			return err
+		}
+
 		return sendDeleteClientClass4CmdToDHCPAgent(id)
 	})
 }
@@ -160,3 +242,94 @@ func sendDeleteClientClass4CmdToDHCPAgent(clientClassID string) error {
 	return kafka.SendDHCP4Cmd(kafka.DeleteClientClass4,
 		&pbdhcpagent.DeleteClientClass4Request{Name: clientClassID}, nil)
 }
+
+func checkClientClass4ExpressionMembers(tx restdb.Transaction, clientClass *resource.ClientClass4) error {
+	expression, err := clientClass.ParseExpression()
+	if err != nil || expression == nil {
+		return err
+	}
+
+	for _, member := range expression.Members() {
+		if exists, err := tx.Exists(resource.TableClientClass4,
+			map[string]interface{}{restdb.IDField: member}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameExists, member, pg.Error(err).Error())
+		} else if !exists {
+			return errorno.ErrNotFound(errorno.ErrNameClientClass, member)
+		}
+	}
+
+	var clientClasses []*resource.ClientClass4
+	if err := tx.Fill(map[string]interface{}{
+		resource.SqlColumnClassCondition: resource.OptionConditionExpression},
+		&clientClasses); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameClientClass), pg.Error(err).Error())
+	}
+
+	classMembers := make(map[string][]string, len(clientClasses)+1)
+	for _, class := range clientClasses {
+		if classExpression, err := class.ParseExpression(); err == nil && classExpression != nil {
+			classMembers[class.GetID()] = classExpression.Members()
+		}
+	}
+
+	classMembers[clientClass.GetID()] = expression.Members()
+	if cycle := findClientClassMemberCycle(classMembers, clientClass.GetID()); len(cycle) != 0 {
+		return errorno.ErrInvalidExpression(clientClass.Expression,
+			"member cycle "+strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+// findClientClassMemberCycle returns the classes on the path from id back to id
+// through member references, or nil if id is not on any cycle
+func findClientClassMemberCycle(classMembers map[string][]string, id string) []string {
+	visited := make(map[string]struct{})
+	var path []string
+	var walk func(string) bool
+	walk = func(current string) bool {
+		path = append(path, current)
+		for _, member := range classMembers[current] {
+			if member == id {
+				path = append(path, member)
+				return true
+			}
+
+			if _, ok := visited[member]; !ok {
+				visited[member] = struct{}{}
+				if walk(member) {
+					return true
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		return false
+	}
+
+	if walk(id) {
+		return path
+	}
+
+	return nil
+}
+
+func checkClientClass4UsedByExpression(tx restdb.Transaction, id string) error {
+	var clientClasses []*resource.ClientClass4
+	if err := tx.Fill(map[string]interface{}{
+		resource.SqlColumnClassCondition: resource.OptionConditionExpression},
+		&clientClasses); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameClientClass), pg.Error(err).Error())
+	}
+
+	for _, clientClass := range clientClasses {
+		if expression, err := clientClass.ParseExpression(); err == nil &&
+			slice.SliceIndex(expression.Members(), id) != -1 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFindClientClassMemberCycle(t *testing.T) {
+	cases := []struct {
+		classMembers map[string][]string
+		id           string
+		cycle        string
+	}{
+		{map[string][]string{"a": {"b"}, "b": {"c"}}, "a", ""},
+		{map[string][]string{"a": {"b"}, "b": {"a"}}, "a", "a -> b -> a"},
+		{map[string][]string{"a": {"a"}}, "a", "a -> a"},
+		{map[string][]string{"a": {"b", "c"}, "b": {"d"}, "c": {"d"}, "d": {"a"}}, "a", "a -> b -> d -> a"},
+		{map[string][]string{"a": {"b"}, "b": {"c"}, "c": {"b"}}, "a", ""},
+	}
+
+	for _, c := range cases {
+		if cycle := strings.Join(findClientClassMemberCycle(c.classMembers, c.id), " -> "); cycle != c.cycle {
+			t.Errorf("find cycle of %s in %v got %q, want %q", c.id, c.classMembers, cycle, c.cycle)
+		}
+	}
+}
@@ -2,10 +2,12 @@ package service
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/linkingthing/clxone-dhcp/pkg/util"
 
 	"github.com/linkingthing/cement/log"
+	"github.com/linkingthing/cement/slice"
 	pg "github.com/linkingthing/clxone-utils/postgresql"
 	restdb "github.com/linkingthing/gorest/db"
 
@@ -30,10 +32,19 @@ func (c *ClientClass6Service) Create(clientClass *resource.ClientClass6) error {
 	}
 
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkClientClass6ExpressionMembers(tx, clientClass); err != nil {
+			return err
+		}
+
 		if _, err := tx.Insert(clientClass); err != nil {
 			return util.FormatDbInsertError(errorno.ErrNameClientClass, clientClass.Name, err)
 		}
 
+		if err := saveOptionValue6s(tx, resource.OptionScopeClientClass6, clientClass.GetID(),
+			"", clientClass.Options); err != nil {
+			return err
+		}
+
 		return sendCreateClientClass6CmdToAgent(clientClass)
 	})
 }
@@ -41,9 +52,10 @@ func (c *ClientClass6Service) Create(clientClass *resource.ClientClass6) error {
 func sendCreateClientClass6CmdToAgent(clientClass *resource.ClientClass6) error {
 	return kafka.SendDHCP6Cmd(kafka.CreateClientClass6,
 		&pbdhcpagent.CreateClientClass6Request{
-			Name:   clientClass.Name,
-			Code:   uint32(clientClass.Code),
-			Regexp: genClientClass6Regexp(clientClass),
+			Name:         clientClass.Name,
+			Code:         uint32(clientClass.Code),
+			Regexp:       genClientClass6Regexp(clientClass),
+			ClassOptions: pbSubnetOptionsFromOptionValue6s(clientClass.Options),
 		}, func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeleteClientClass6,
@@ -61,6 +73,8 @@ func genClientClass6Regexp(clientclass *resource.ClientClass6) string {
 	case resource.OptionConditionSubstringEqual:
 		return fmt.Sprintf(ClientClassOptionSubstringEqual, clientclass.Code,
 			clientclass.BeginIndex, len(clientclass.Regexp), clientclass.Regexp)
+	case resource.OptionConditionExpression:
+		return clientclass.Regexp
 	default:
 		return fmt.Sprintf(ClientClassOptionExists, clientclass.Description)
 	}
@@ -69,9 +83,24 @@ func genClientClass6Regexp(clientclass *resource.ClientClass6) string {
 func (c *ClientClass6Service) List(conditions map[string]interface{}) ([]*resource.ClientClass6, error) {
 	var clientClasses []*resource.ClientClass6
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		return tx.Fill(conditions, &clientClasses)
+		if err := tx.Fill(conditions, &clientClasses); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameClientClass), pg.Error(err).Error())
+		}
+
+		valuesMap, err := getOptionValue6sMap(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeClientClass6})
+		if err != nil {
+			return err
+		}
+
+		for _, clientClass := range clientClasses {
+			clientClass.Options = valuesMap[clientClass.GetID()]
+		}
+
+		return nil
 	}); err != nil {
-		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameClientClass), pg.Error(err).Error())
+		return nil, err
 	}
 
 	return clientClasses, nil
@@ -80,11 +109,19 @@ func (c *ClientClass6Service) List(conditions map[string]interface{}) ([]*resour
 func (c *ClientClass6Service) Get(id string) (*resource.ClientClass6, error) {
 	var clientClasses []*resource.ClientClass6
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		return tx.Fill(map[string]interface{}{restdb.IDField: id}, &clientClasses)
+		if err := tx.Fill(map[string]interface{}{restdb.IDField: id}, &clientClasses); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		} else if len(clientClasses) == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameClientClass, id)
+		}
+
+		options, err := getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeClientClass6,
+			resource.SqlColumnScopeId: id})
+		clientClasses[0].Options = options
+		return err
 	}); err != nil {
-		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
-	} else if len(clientClasses) == 0 {
-		return nil, errorno.ErrNotFound(errorno.ErrNameClientClass, id)
+		return nil, err
 	}
 
 	return clientClasses[0], nil
@@ -96,17 +133,27 @@ func (c *ClientClass6Service) Update(clientClass *resource.ClientClass6) error {
 	}
 
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkClientClass6ExpressionMembers(tx, clientClass); err != nil {
+			return err
+		}
+
 		if rows, err := tx.Update(resource.TableClientClass6, map[string]interface{}{
 			resource.SqlColumnClassCondition:   clientClass.Condition,
 			resource.SqlColumnClassRegexp:      clientClass.Regexp,
 			resource.SqlColumnClassBeginIndex:  clientClass.BeginIndex,
 			resource.SqlColumnClassDescription: clientClass.Description,
+			resource.SqlColumnClassExpression:  clientClass.Expression,
 		}, map[string]interface{}{restdb.IDField: clientClass.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, clientClass.GetID(), pg.Error(err).Error())
 		} else if rows == 0 {
 			return errorno.ErrNotFound(errorno.ErrNameClientClass, clientClass.GetID())
 		}
 
+		if err := saveOptionValue6s(tx, resource.OptionScopeClientClass6, clientClass.GetID(),
+			"", clientClass.Options); err != nil {
+			return err
+		}
+
 		return sendUpdateClientClass6CmdToDHCPAgent(clientClass)
 	})
 }
@@ -114,9 +161,10 @@ func (c *ClientClass6Service) Update(clientClass *resource.ClientClass6) error {
 func sendUpdateClientClass6CmdToDHCPAgent(clientClass *resource.ClientClass6) error {
 	return kafka.SendDHCP6Cmd(kafka.UpdateClientClass6,
 		&pbdhcpagent.UpdateClientClass6Request{
-			Name:   clientClass.Name,
-			Code:   uint32(clientClass.Code),
-			Regexp: genClientClass6Regexp(clientClass),
+			Name:         clientClass.Name,
+			Code:         uint32(clientClass.Code),
+			Regexp:       genClientClass6Regexp(clientClass),
+			ClassOptions: pbSubnetOptionsFromOptionValue6s(clientClass.Options),
 		}, nil)
 }
 
@@ -130,6 +178,30 @@ func (c *ClientClass6Service) Delete(id string) error {
 			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
 		}
 
+		if count, err := tx.CountEx(resource.TablePool6,
+			"select count(*) from gr_pool6 where $1::text = any(white_client_classes) or $1::text = any(black_client_classes)",
+			id); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameCount, string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
+		} else if count != 0 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+
+		if count, err := tx.CountEx(resource.TablePdPool,
+			"select count(*) from gr_pdpool where $1::text = any(white_client_classes) or $1::text = any(black_client_classes)",
+			id); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameCount, string(errorno.ErrNamePdPool), pg.Error(err).Error())
+		} else if count != 0 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+
+		if count, err := tx.CountEx(resource.TableSubnet6Template,
+			"select count(*) from gr_subnet6_template where $1::text = any(white_client_classes) or $1::text = any(black_client_classes)",
+			id); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameCount, string(errorno.ErrNameSubnetTemplate), pg.Error(err).Error())
+		} else if count != 0 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+
 		if count, err := tx.CountEx(resource.TableDhcpConfig,
 			"select count(*) from gr_dhcp_config where $1::text = any(subnet6_white_client_classes) or $1::text = any(subnet6_black_client_classes)",
 			id); err != nil {
@@ -138,6 +210,10 @@ func (c *ClientClass6Service) Delete(id string) error {
 			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
 		}
 
+		if err := checkClientClass6UsedByExpression(tx, id); err != nil {
+			return err
+		}
+
 		if rows, err := tx.Delete(resource.TableClientClass6,
 			map[string]interface{}{restdb.IDField: id}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
@@ -145,6 +221,12 @@ func (c *ClientClass6Service) Delete(id string) error {
 			return errorno.ErrNotFound(errorno.ErrNameClientClass, id)
 		}
 
+		if err := deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeClientClass6,
+			resource.SqlColumnScopeId: id}); err != nil {
+			return err
+		}
+
 		return sendDeleteClientClass6CmdToDHCPAgent(id)
 	})
 }
@@ -153,3 +235,61 @@ func sendDeleteClientClass6CmdToDHCPAgent(clientClassID string) error {
 	return kafka.SendDHCP6Cmd(kafka.DeleteClientClass6,
 		&pbdhcpagent.DeleteClientClass6Request{Name: clientClassID}, nil)
 }
+
+func checkClientClass6ExpressionMembers(tx restdb.Transaction, clientClass *resource.ClientClass6) error {
+	expression, err := clientClass.ParseExpression()
+	if err != nil || expression == nil {
+		return err
+	}
+
+	for _, member := range expression.Members() {
+		if exists, err := tx.Exists(resource.TableClientClass6,
+			map[string]interface{}{restdb.IDField: member}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameExists, member, pg.Error(err).Error())
+		} else if !exists {
+			return errorno.ErrNotFound(errorno.ErrNameClientClass, member)
+		}
+	}
+
+	var clientClasses []*resource.ClientClass6
+	if err := tx.Fill(map[string]interface{}{
+		resource.SqlColumnClassCondition: resource.OptionConditionExpression},
+		&clientClasses); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameClientClass), pg.Error(err).Error())
+	}
+
+	classMembers := make(map[string][]string, len(clientClasses)+1)
+	for _, class := range clientClasses {
+		if classExpression, err := class.ParseExpression(); err == nil && classExpression != nil {
+			classMembers[class.GetID()] = classExpression.Members()
+		}
+	}
+
+	classMembers[clientClass.GetID()] = expression.Members()
+	if cycle := findClientClassMemberCycle(classMembers, clientClass.GetID()); len(cycle) != 0 {
+		return errorno.ErrInvalidExpression(clientClass.Expression,
+			"member cycle "+strings.Join(cycle, " -> "))
+	}
+
+	return nil
+}
+
+func checkClientClass6UsedByExpression(tx restdb.Transaction, id string) error {
+	var clientClasses []*resource.ClientClass6
+	if err := tx.Fill(map[string]interface{}{
+		resource.SqlColumnClassCondition: resource.OptionConditionExpression},
+		&clientClasses); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameClientClass), pg.Error(err).Error())
+	}
+
+	for _, clientClass := range clientClasses {
+		if expression, err := clientClass.ParseExpression(); err == nil &&
+			slice.SliceIndex(expression.Members(), id) != -1 {
+			return errorno.ErrBeenUsed(errorno.ErrNameClientClass, id)
+		}
+	}
+
+	return nil
+}
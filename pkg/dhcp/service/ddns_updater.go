@@ -0,0 +1,510 @@
+package service
+
+import (
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/ddns"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	pbdhcp "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-server"
+)
+
+const (
+	DdnsTaskQueueSize  = 1024
+	DdnsWorkerCount    = 4
+	DdnsSweepInterval  = time.Minute
+	DdnsSweepBatchSize = 1000
+	DdnsRetryInterval  = 5 * time.Minute
+
+	LeaseStateNormal    = 0
+	LeaseStateDeclined  = 1
+	LeaseStateReclaimed = 2
+
+	DhcidHtypeEthernet = 1
+)
+
+type ddnsTask struct {
+	isAdd          bool
+	isV6           bool
+	expired        bool
+	subnetId       string
+	ip             net.IP
+	hostname       string
+	identifierType ddns.DhcidIdentifierType
+	identifier     []byte
+	validLifetime  uint32
+	forward        bool
+	reverse        bool
+}
+
+type ddnsSetting struct {
+	enabled            bool
+	forwardZone        string
+	reverseZone        string
+	server             string
+	tsigKey            *ddns.TsigKey
+	ttl                uint32
+	qualifyingSuffix   string
+	replaceClientName  resource.DdnsReplaceClientName
+	generatedPrefix    string
+	conflictResolution resource.DdnsConflictResolution
+}
+
+var ddnsTasks []chan *ddnsTask
+
+func startDdnsUpdater() {
+	ddnsTasks = make([]chan *ddnsTask, DdnsWorkerCount)
+	for i := range ddnsTasks {
+		ddnsTasks[i] = make(chan *ddnsTask, DdnsTaskQueueSize)
+		go func(tasks chan *ddnsTask) {
+			for task := range tasks {
+				runDdnsTask(task)
+			}
+		}(ddnsTasks[i])
+	}
+
+	go sweepExpiredDdnsRecords()
+}
+
+// tasks of the same address go to the same worker to keep their order
+func submitDdnsTask(task *ddnsTask) {
+	if ddnsTasks == nil {
+		return
+	}
+
+	ddnsTasks[int(task.ip[len(task.ip)-1])%DdnsWorkerCount] <- task
+}
+
+func updateDdnsWithLease4(requestType string, lease4 pbdhcp.Lease4) {
+	isAdd, ok := ddnsActionFromLease(requestType, lease4.GetLeaseState())
+	if !ok {
+		return
+	}
+
+	ip := net.ParseIP(lease4.GetAddress()).To4()
+	if ip == nil {
+		return
+	}
+
+	identifierType, identifier := ddns.DhcidIdentifierTypeClientId, parseHexIdentifier(lease4.GetClientId())
+	if len(identifier) == 0 {
+		if mac, err := net.ParseMAC(lease4.GetHwAddress()); err == nil {
+			identifierType = ddns.DhcidIdentifierTypeHwAddress
+			identifier = append([]byte{DhcidHtypeEthernet}, mac...)
+		}
+	}
+
+	submitDdnsTask(&ddnsTask{
+		isAdd:          isAdd,
+		subnetId:       strconv.FormatUint(lease4.GetSubnetId(), 10),
+		ip:             ip,
+		hostname:       lease4.GetHostname(),
+		identifierType: identifierType,
+		identifier:     identifier,
+		validLifetime:  lease4.GetValidLifetime(),
+		forward:        lease4.GetFqdnFwd() || !lease4.GetFqdnRev(),
+		reverse:        lease4.GetFqdnRev() || !lease4.GetFqdnFwd(),
+	})
+}
+
+func updateDdnsWithLease6(requestType string, lease6 pbdhcp.Lease6) {
+	if lease6.GetLeaseType() != "IA_NA" {
+		return
+	}
+
+	isAdd, ok := ddnsActionFromLease(requestType, lease6.GetLeaseState())
+	if !ok {
+		return
+	}
+
+	ip := net.ParseIP(lease6.GetAddress())
+	if ip == nil || ip.To4() != nil {
+		return
+	}
+
+	submitDdnsTask(&ddnsTask{
+		isAdd:          isAdd,
+		isV6:           true,
+		subnetId:       strconv.FormatUint(lease6.GetSubnetId(), 10),
+		ip:             ip,
+		hostname:       lease6.GetHostname(),
+		identifierType: ddns.DhcidIdentifierTypeDuid,
+		identifier:     parseHexIdentifier(lease6.GetDuid()),
+		validLifetime:  lease6.GetValidLifetime(),
+		forward:        lease6.GetFqdnFwd() || !lease6.GetFqdnRev(),
+		reverse:        lease6.GetFqdnRev() || !lease6.GetFqdnFwd(),
+	})
+}
+
+// ddnsActionFromLease only knows the request types sent by dhcp agent,
+// records of released or expired leases are removed by sweepExpiredDdnsRecords
+func ddnsActionFromLease(requestType string, leaseState uint32) (isAdd bool, ok bool) {
+	switch {
+	case requestType == LeaseRequestTypeDecline, leaseState == LeaseStateDeclined,
+		leaseState == LeaseStateReclaimed:
+		return false, true
+	case requestType == LeaseRequestTypeRequest && leaseState == LeaseStateNormal:
+		return true, true
+	default:
+		return false, false
+	}
+}
+
+func parseHexIdentifier(identifier string) []byte {
+	identifier = strings.NewReplacer(":", "", "-", "").Replace(identifier)
+	if data, err := hex.DecodeString(identifier); err == nil {
+		return data
+	} else {
+		return nil
+	}
+}
+
+func sweepExpiredDdnsRecords() {
+	ticker := time.NewTicker(DdnsSweepInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		var records []*resource.DdnsRecord
+		if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+			return tx.FillEx(&records, "select * from gr_ddns_record where expire_at < $1 limit $2",
+				time.Now().Unix(), DdnsSweepBatchSize)
+		}); err != nil {
+			log.Warnf("get expired ddns records failed: %s", pg.Error(err).Error())
+			continue
+		}
+
+		for _, record := range records {
+			if ip := ddnsRecordIp(record); ip != nil {
+				submitDdnsTask(&ddnsTask{
+					isV6:     record.IsV6,
+					expired:  true,
+					subnetId: record.SubnetId,
+					ip:       ip,
+				})
+			}
+		}
+	}
+}
+
+func ddnsRecordIp(record *resource.DdnsRecord) net.IP {
+	if ip := net.ParseIP(record.GetID()); ip == nil || record.IsV6 {
+		return ip
+	} else {
+		return ip.To4()
+	}
+}
+
+func runDdnsTask(task *ddnsTask) {
+	record, err := getDdnsRecord(task.ip)
+	if err != nil {
+		log.Warnf("get ddns record of %s failed: %s", task.ip.String(), err.Error())
+		return
+	} else if task.expired && (record == nil || record.ExpireAt >= time.Now().Unix()) {
+		return
+	}
+
+	setting, err := getDdnsSetting(task.subnetId, task.isV6)
+	if err != nil {
+		log.Warnf("get ddns setting of subnet %s failed: %s", task.subnetId, err.Error())
+		return
+	} else if setting == nil || !setting.enabled {
+		if record != nil && !task.isAdd {
+			deleteDdnsRecord(record)
+		}
+		return
+	}
+
+	cli, err := ddns.NewClient(setting.server, setting.tsigKey, ddns.DefaultTimeout)
+	if err != nil {
+		log.Warnf("new ddns client of subnet %s failed: %s", task.subnetId, err.Error())
+		return
+	}
+
+	if task.isAdd {
+		addDdns(cli, setting, task, record)
+	} else {
+		removeDdns(cli, setting, task, record)
+	}
+}
+
+func addDdns(cli *ddns.Client, setting *ddnsSetting, task *ddnsTask, record *resource.DdnsRecord) {
+	fqdn, ok := setting.genFqdn(task.hostname, task.ip)
+	if !ok {
+		return
+	}
+
+	// the client changed its name, records of the old name are removed first
+	if record != nil && record.Fqdn != fqdn && !removeDdns(cli, setting, task, record) {
+		return
+	}
+
+	ttl := setting.getTtl(task.validLifetime)
+	forward := task.forward && setting.inForwardZone(fqdn)
+	if forward {
+		if err := cli.AddForward(setting.forwardUpdate(fqdn, task.ip, ttl,
+			task.identifierType, task.identifier)); err != nil {
+			log.Warnf("ddns add forward %s of %s to %s failed: %s",
+				fqdn, task.ip.String(), cli.Server(), err.Error())
+			return
+		}
+	}
+
+	reverse := task.reverse && setting.inReverseZone(task.ip)
+	if reverse {
+		if err := cli.AddReverse(setting.reverseZone, task.ip, fqdn, ttl); err != nil {
+			log.Warnf("ddns add reverse of %s to %s failed: %s",
+				task.ip.String(), cli.Server(), err.Error())
+			reverse = false
+		}
+	}
+
+	if forward || reverse {
+		saveDdnsRecord(&resource.DdnsRecord{
+			IsV6:           task.isV6,
+			SubnetId:       task.subnetId,
+			Fqdn:           fqdn,
+			Forward:        forward,
+			Reverse:        reverse,
+			IdentifierType: uint16(task.identifierType),
+			Identifier:     hex.EncodeToString(task.identifier),
+			ExpireAt:       time.Now().Add(time.Duration(task.validLifetime) * time.Second).Unix(),
+		}, task.ip)
+	}
+}
+
+// removeDdns removes records saved in record when it exists, a failed
+// removal is retried after DdnsRetryInterval
+func removeDdns(cli *ddns.Client, setting *ddnsSetting, task *ddnsTask, record *resource.DdnsRecord) bool {
+	fqdn, hasFqdn := "", false
+	forward, reverse := task.forward, task.reverse
+	identifierType, identifier := task.identifierType, task.identifier
+	if record != nil {
+		fqdn, hasFqdn = record.Fqdn, record.Fqdn != ""
+		forward, reverse = record.Forward, record.Reverse
+		identifierType = ddns.DhcidIdentifierType(record.IdentifierType)
+		identifier, _ = hex.DecodeString(record.Identifier)
+	} else {
+		fqdn, hasFqdn = setting.genFqdn(task.hostname, task.ip)
+	}
+
+	succeed := true
+	if hasFqdn && forward && setting.inForwardZone(fqdn) {
+		if err := cli.RemoveForward(setting.forwardUpdate(fqdn, task.ip, 0,
+			identifierType, identifier)); err != nil {
+			log.Warnf("ddns remove forward %s of %s to %s failed: %s",
+				fqdn, task.ip.String(), cli.Server(), err.Error())
+			succeed = false
+		}
+	}
+
+	// ptr record is removed with the address alone, so it is still removed
+	// when the fqdn is unknown or the forward removal failed
+	if reverse && setting.inReverseZone(task.ip) {
+		if err := cli.RemoveReverse(setting.reverseZone, task.ip); err != nil {
+			log.Warnf("ddns remove reverse of %s to %s failed: %s",
+				task.ip.String(), cli.Server(), err.Error())
+			succeed = false
+		}
+	}
+
+	if record != nil {
+		if succeed {
+			deleteDdnsRecord(record)
+		} else {
+			record.ExpireAt = time.Now().Add(DdnsRetryInterval).Unix()
+			saveDdnsRecord(record, task.ip)
+		}
+	}
+
+	return succeed
+}
+
+func getDdnsRecord(ip net.IP) (*resource.DdnsRecord, error) {
+	var records []*resource.DdnsRecord
+	if err := db.GetResources(map[string]interface{}{restdb.IDField: ip.String()},
+		&records); err != nil {
+		return nil, err
+	} else if len(records) == 0 {
+		return nil, nil
+	} else {
+		return records[0], nil
+	}
+}
+
+func saveDdnsRecord(record *resource.DdnsRecord, ip net.IP) {
+	record.SetID(ip.String())
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Delete(resource.TableDdnsRecord,
+			map[string]interface{}{restdb.IDField: record.GetID()}); err != nil {
+			return err
+		}
+
+		_, err := tx.Insert(record)
+		return err
+	}); err != nil {
+		log.Warnf("save ddns record of %s failed: %s", record.GetID(), pg.Error(err).Error())
+	}
+}
+
+func deleteDdnsRecord(record *resource.DdnsRecord) {
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		_, err := tx.Delete(resource.TableDdnsRecord,
+			map[string]interface{}{restdb.IDField: record.GetID()})
+		return err
+	}); err != nil {
+		log.Warnf("delete ddns record of %s failed: %s", record.GetID(), pg.Error(err).Error())
+	}
+}
+
+func getDdnsSetting(subnetId string, isV6 bool) (*ddnsSetting, error) {
+	var setting *ddnsSetting
+	err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if isV6 {
+			var settings []*resource.SubnetDdns6
+			if err := tx.Fill(map[string]interface{}{restdb.IDField: subnetId},
+				&settings); err != nil || len(settings) == 0 {
+				return err
+			}
+
+			s := settings[0]
+			setting = newDdnsSetting(s.Enabled, s.ForwardZone, s.ReverseZone, s.Server,
+				s.TsigKeyName, s.TsigAlgorithm, s.TsigSecret, s.Ttl, s.QualifyingSuffix,
+				s.ReplaceClientName, s.GeneratedPrefix, s.ConflictResolution)
+		} else {
+			var settings []*resource.SubnetDdns4
+			if err := tx.Fill(map[string]interface{}{restdb.IDField: subnetId},
+				&settings); err != nil || len(settings) == 0 {
+				return err
+			}
+
+			s := settings[0]
+			setting = newDdnsSetting(s.Enabled, s.ForwardZone, s.ReverseZone, s.Server,
+				s.TsigKeyName, s.TsigAlgorithm, s.TsigSecret, s.Ttl, s.QualifyingSuffix,
+				s.ReplaceClientName, s.GeneratedPrefix, s.ConflictResolution)
+		}
+
+		return nil
+	})
+
+	return setting, err
+}
+
+func newDdnsSetting(enabled bool, forwardZone, reverseZone, server, tsigKeyName, tsigAlgorithm, tsigSecret string, ttl uint32, qualifyingSuffix string, replaceClientName resource.DdnsReplaceClientName, generatedPrefix string, conflictResolution resource.DdnsConflictResolution) *ddnsSetting {
+	setting := &ddnsSetting{
+		enabled:            enabled,
+		forwardZone:        forwardZone,
+		reverseZone:        reverseZone,
+		server:             server,
+		ttl:                ttl,
+		qualifyingSuffix:   qualifyingSuffix,
+		replaceClientName:  replaceClientName,
+		generatedPrefix:    generatedPrefix,
+		conflictResolution: conflictResolution,
+	}
+
+	if tsigKeyName != "" {
+		setting.tsigKey = &ddns.TsigKey{
+			Name:      tsigKeyName,
+			Algorithm: tsigAlgorithm,
+			Secret:    tsigSecret,
+		}
+	}
+
+	return setting
+}
+
+func (s *ddnsSetting) getTtl(validLifetime uint32) uint32 {
+	if s.ttl != 0 {
+		return s.ttl
+	} else {
+		return validLifetime / 3
+	}
+}
+
+func (s *ddnsSetting) inForwardZone(fqdn string) bool {
+	return s.forwardZone != "" && ddns.IsSubDomain(fqdn, s.forwardZone)
+}
+
+func (s *ddnsSetting) inReverseZone(ip net.IP) bool {
+	return s.reverseZone != "" && ddns.IsSubDomain(ddns.ReverseName(ip), s.reverseZone)
+}
+
+func (s *ddnsSetting) forwardUpdate(fqdn string, ip net.IP, ttl uint32, identifierType ddns.DhcidIdentifierType, identifier []byte) *ddns.ForwardUpdate {
+	update := &ddns.ForwardUpdate{
+		Zone:       s.forwardZone,
+		Fqdn:       fqdn,
+		Ip:         ip,
+		Ttl:        ttl,
+		CheckDhcid: s.conflictResolution == resource.DdnsConflictResolutionCheckWithDhcid,
+	}
+
+	if s.conflictResolution != resource.DdnsConflictResolutionNoCheckWithoutDhcid &&
+		len(identifier) != 0 {
+		update.Dhcid = ddns.GenDhcid(identifierType, identifier, fqdn)
+	} else {
+		update.CheckDhcid = false
+	}
+
+	return update
+}
+
+func (s *ddnsSetting) genFqdn(hostname string, ip net.IP) (string, bool) {
+	hostname = sanitizeHostname(hostname)
+	switch s.replaceClientName {
+	case resource.DdnsReplaceClientNameAlways:
+		hostname = s.genHostname(ip)
+	case resource.DdnsReplaceClientNameWhenPresent:
+		if hostname != "" {
+			hostname = s.genHostname(ip)
+		}
+	case resource.DdnsReplaceClientNameWhenNotPresent:
+		if hostname == "" {
+			hostname = s.genHostname(ip)
+		}
+	}
+
+	if hostname == "" {
+		return "", false
+	}
+
+	fqdn := ddns.Fqdn(hostname)
+	if s.forwardZone != "" && ddns.IsSubDomain(fqdn, s.forwardZone) {
+		return fqdn, true
+	}
+
+	suffix := s.qualifyingSuffix
+	if suffix == "" {
+		suffix = s.forwardZone
+	}
+
+	if suffix == "" {
+		return fqdn, true
+	}
+
+	return ddns.Fqdn(strings.TrimSuffix(hostname, ".") + "." + suffix), true
+}
+
+func (s *ddnsSetting) genHostname(ip net.IP) string {
+	return s.generatedPrefix + "-" + strings.NewReplacer(".", "-", ":", "-").Replace(ip.String())
+}
+
+func sanitizeHostname(hostname string) string {
+	hostname = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(hostname)), ".")
+	var builder strings.Builder
+	for _, c := range hostname {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '.' {
+			builder.WriteRune(c)
+		} else {
+			builder.WriteRune('-')
+		}
+	}
+
+	return strings.Trim(builder.String(), "-.")
+}
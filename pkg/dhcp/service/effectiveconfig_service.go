@@ -0,0 +1,230 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+func getEffectiveConfig4(subnetId, poolId, reservationId string) (*resource.EffectiveConfig, error) {
+	var config *resource.EffectiveConfig
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if subnet.Options, err = getOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeSubnet4, resource.SqlColumnScopeId: subnetId,
+		}); err != nil {
+			return err
+		}
+
+		routesMap, err := getStaticRoute4sMapWithSubnetIds(tx, []string{subnetId})
+		if err != nil {
+			return err
+		}
+
+		subnet.StaticRoutes = routesMap[subnetId]
+		sharedNetwork, err := getSharedNetwork4NameWithSubnetId(tx, subnet.SubnetId)
+		if err != nil {
+			return err
+		}
+
+		var reservation *resource.Reservation4
+		if reservationId != "" {
+			if reservation, err = getReservation4WithOptions(tx, subnetId, reservationId); err != nil {
+				return err
+			}
+		}
+
+		var pool *resource.Pool4
+		if poolId != "" {
+			pool, err = getPool4WithOptions(tx, subnetId, poolId)
+		} else if reservation != nil {
+			pool, err = getPool4WithOptionsContainsIp(tx, subnetId, reservation)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		config = resource.NewEffectiveConfig4(sharedNetwork, subnet, pool, reservation)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func getSharedNetwork4NameWithSubnetId(tx restdb.Transaction, subnetId uint64) (string, error) {
+	var sharedNetwork4s []*resource.SharedNetwork4
+	if err := tx.FillEx(&sharedNetwork4s,
+		"select * from gr_shared_network4 where $1::numeric = any(subnet_ids)",
+		subnetId); err != nil {
+		return "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	} else if len(sharedNetwork4s) == 0 {
+		return "", nil
+	} else {
+		return sharedNetwork4s[0].Name, nil
+	}
+}
+
+func getReservation4WithOptions(tx restdb.Transaction, subnetId, reservationId string) (*resource.Reservation4, error) {
+	reservations, err := getReservation4sWithCondition(tx, map[string]interface{}{
+		restdb.IDField: reservationId, resource.SqlColumnSubnet4: subnetId})
+	if err != nil {
+		return nil, err
+	} else if len(reservations) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameDhcpReservation, reservationId)
+	}
+
+	if reservations[0].Options, err = getOptionValue4s(tx, map[string]interface{}{
+		resource.SqlColumnScope:   resource.OptionScopeReservation4,
+		resource.SqlColumnScopeId: reservationId}); err != nil {
+		return nil, err
+	}
+
+	return reservations[0], nil
+}
+
+func getPool4WithOptions(tx restdb.Transaction, subnetId, poolId string) (*resource.Pool4, error) {
+	pools, err := getPool4sWithCondition(tx, map[string]interface{}{
+		restdb.IDField: poolId, resource.SqlColumnSubnet4: subnetId})
+	if err != nil {
+		return nil, err
+	} else if len(pools) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameDhcpPool, poolId)
+	}
+
+	if pools[0].Options, err = getOptionValue4s(tx, map[string]interface{}{
+		resource.SqlColumnScope:   resource.OptionScopePool4,
+		resource.SqlColumnScopeId: poolId}); err != nil {
+		return nil, err
+	}
+
+	return pools[0], nil
+}
+
+func getPool4WithOptionsContainsIp(tx restdb.Transaction, subnetId string, reservation *resource.Reservation4) (*resource.Pool4, error) {
+	pools, err := getPool4sWithBeginAndEndIp(tx, subnetId, reservation.Ip, reservation.Ip)
+	if err != nil || len(pools) == 0 {
+		return nil, err
+	}
+
+	return getPool4WithOptions(tx, subnetId, pools[0].GetID())
+}
+
+func getEffectiveConfig6(subnetId, poolId, reservationId string) (*resource.EffectiveConfig, error) {
+	var config *resource.EffectiveConfig
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if subnet.Options, err = getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeSubnet6, resource.SqlColumnScopeId: subnetId,
+		}); err != nil {
+			return err
+		}
+
+		sharedNetwork, err := getSharedNetwork6NameWithSubnetId(tx, subnet.SubnetId)
+		if err != nil {
+			return err
+		}
+
+		var reservation *resource.Reservation6
+		if reservationId != "" {
+			if reservation, err = getReservation6WithOptions(tx, subnetId, reservationId); err != nil {
+				return err
+			}
+		}
+
+		var pool *resource.Pool6
+		if poolId != "" {
+			pool, err = getPool6WithOptions(tx, subnetId, poolId)
+		} else if reservation != nil {
+			pool, err = getPool6WithOptionsContainsIp(tx, subnetId, reservation)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		config = resource.NewEffectiveConfig6(sharedNetwork, subnet, pool, reservation)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func getSharedNetwork6NameWithSubnetId(tx restdb.Transaction, subnetId uint64) (string, error) {
+	var sharedNetwork6s []*resource.SharedNetwork6
+	if err := tx.FillEx(&sharedNetwork6s,
+		"select * from gr_shared_network6 where $1::numeric = any(subnet_ids)",
+		subnetId); err != nil {
+		return "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	} else if len(sharedNetwork6s) == 0 {
+		return "", nil
+	} else {
+		return sharedNetwork6s[0].Name, nil
+	}
+}
+
+func getReservation6WithOptions(tx restdb.Transaction, subnetId, reservationId string) (*resource.Reservation6, error) {
+	reservations, err := getReservation6sWithCondition(tx, map[string]interface{}{
+		restdb.IDField: reservationId, resource.SqlColumnSubnet6: subnetId})
+	if err != nil {
+		return nil, err
+	} else if len(reservations) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameDhcpReservation, reservationId)
+	}
+
+	if reservations[0].Options, err = getOptionValue6s(tx, map[string]interface{}{
+		resource.SqlColumnScope:   resource.OptionScopeReservation6,
+		resource.SqlColumnScopeId: reservationId}); err != nil {
+		return nil, err
+	}
+
+	return reservations[0], nil
+}
+
+func getPool6WithOptions(tx restdb.Transaction, subnetId, poolId string) (*resource.Pool6, error) {
+	pools, err := getPool6sWithCondition(tx, map[string]interface{}{
+		restdb.IDField: poolId, resource.SqlColumnSubnet6: subnetId})
+	if err != nil {
+		return nil, err
+	} else if len(pools) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameDhcpPool, poolId)
+	}
+
+	if pools[0].Options, err = getOptionValue6s(tx, map[string]interface{}{
+		resource.SqlColumnScope:   resource.OptionScopePool6,
+		resource.SqlColumnScopeId: poolId}); err != nil {
+		return nil, err
+	}
+
+	return pools[0], nil
+}
+
+func getPool6WithOptionsContainsIp(tx restdb.Transaction, subnetId string, reservation *resource.Reservation6) (*resource.Pool6, error) {
+	if len(reservation.Ips) == 0 {
+		return nil, nil
+	}
+
+	pools, err := getPool6sWithBeginAndEndIp(tx, subnetId, reservation.Ips[0], reservation.Ips[0])
+	if err != nil || len(pools) == 0 {
+		return nil, err
+	}
+
+	return getPool6WithOptions(tx, subnetId, pools[0].GetID())
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"strings"
+
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const FilterNameClientId = "client_id"
+
+type Lease4Service struct{}
+
+func NewLease4Service() *Lease4Service {
+	return &Lease4Service{}
+}
+
+func (l *Lease4Service) List(ctx *restresource.Context) ([]*resource.Lease4, error) {
+	mac, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameMac, ctx.GetFilters())
+	hostname, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameHostname, ctx.GetFilters())
+	clientId, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameClientId, ctx.GetFilters())
+	if mac != "" {
+		var err error
+		if mac, err = util.NormalizeMac(mac); err != nil {
+			return nil, err
+		}
+	}
+
+	var leases []*resource.SubnetLease4
+	var err error
+	switch {
+	case mac != "":
+		leases, err = GetSubnets4LeasesWithMacs([]string{mac})
+	case hostname != "":
+		leases, err = GetSubnets4LeasesWithHostnames([]string{hostname})
+	case clientId != "":
+		leases, err = GetSubnets4LeasesWithClientIds([]string{clientId})
+	default:
+		return nil, errorno.ErrEmpty(string(errorno.ErrNameMac),
+			string(errorno.ErrNameHostname), string(errorno.ErrNameClientId))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if leases, err = FilterReclaimedSubnetLease4s(leases); err != nil {
+		return nil, err
+	}
+
+	matchedLeases := make([]*resource.SubnetLease4, 0, len(leases))
+	for _, lease := range leases {
+		if (mac == "" || strings.EqualFold(lease.HwAddress, mac)) &&
+			(hostname == "" || lease.Hostname == hostname) &&
+			(clientId == "" || lease.ClientId == clientId) {
+			matchedLeases = append(matchedLeases, lease)
+		}
+	}
+
+	return lease4sWithSubnets(matchedLeases)
+}
+
+func lease4sWithSubnets(leases []*resource.SubnetLease4) ([]*resource.Lease4, error) {
+	subnetIds := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		subnetIds = append(subnetIds, lease.Subnet4)
+	}
+
+	var subnets []*resource.Subnet4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&subnets, "select * from gr_subnet4 where id = any($1::text[])", subnetIds)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+	}
+
+	subnetMap := make(map[string]*resource.Subnet4, len(subnets))
+	for _, subnet := range subnets {
+		subnetMap[subnet.GetID()] = subnet
+	}
+
+	lease4s := make([]*resource.Lease4, 0, len(leases))
+	for _, lease := range leases {
+		lease4 := &resource.Lease4{Subnet4: lease.Subnet4, Subnet: lease.Subnet, Lease: lease}
+		if subnet, ok := subnetMap[lease.Subnet4]; ok {
+			lease4.Subnet = subnet.Subnet
+			lease4.SubnetTags = subnet.Tags
+		}
+
+		lease4.SetID(lease.Address)
+		lease4s = append(lease4s, lease4)
+	}
+
+	return lease4s, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"strings"
+
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type Lease6Service struct{}
+
+func NewLease6Service() *Lease6Service {
+	return &Lease6Service{}
+}
+
+func (l *Lease6Service) List(ctx *restresource.Context) ([]*resource.Lease6, error) {
+	mac, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameMac, ctx.GetFilters())
+	hostname, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameHostname, ctx.GetFilters())
+	duid, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameDuid, ctx.GetFilters())
+	if mac != "" {
+		var err error
+		if mac, err = util.NormalizeMac(mac); err != nil {
+			return nil, err
+		}
+	}
+
+	var leases []*resource.SubnetLease6
+	var err error
+	switch {
+	case mac != "":
+		leases, err = GetSubnets6LeasesWithMacs([]string{mac})
+	case hostname != "":
+		leases, err = GetSubnets6LeasesWithHostnames([]string{hostname})
+	case duid != "":
+		leases, err = GetSubnets6LeasesWithDuids([]string{duid})
+	default:
+		return nil, errorno.ErrEmpty(string(errorno.ErrNameMac),
+			string(errorno.ErrNameHostname), string(errorno.ErrNameDuid))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if leases, err = FilterReclaimedSubnetLease6s(leases); err != nil {
+		return nil, err
+	}
+
+	matchedLeases := make([]*resource.SubnetLease6, 0, len(leases))
+	for _, lease := range leases {
+		if (mac == "" || strings.EqualFold(lease.HwAddress, mac)) &&
+			(hostname == "" || lease.Hostname == hostname) &&
+			(duid == "" || strings.EqualFold(lease.Duid, duid)) {
+			matchedLeases = append(matchedLeases, lease)
+		}
+	}
+
+	return lease6sWithSubnets(matchedLeases)
+}
+
+func lease6sWithSubnets(leases []*resource.SubnetLease6) ([]*resource.Lease6, error) {
+	subnetIds := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		subnetIds = append(subnetIds, lease.Subnet6)
+	}
+
+	var subnets []*resource.Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&subnets, "select * from gr_subnet6 where id = any($1::text[])", subnetIds)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+	}
+
+	subnetMap := make(map[string]*resource.Subnet6, len(subnets))
+	for _, subnet := range subnets {
+		subnetMap[subnet.GetID()] = subnet
+	}
+
+	lease6s := make([]*resource.Lease6, 0, len(leases))
+	for _, lease := range leases {
+		lease6 := &resource.Lease6{Subnet6: lease.Subnet6, Subnet: lease.Subnet, Lease: lease}
+		if subnet, ok := subnetMap[lease.Subnet6]; ok {
+			lease6.Subnet = subnet.Subnet
+			lease6.SubnetTags = subnet.Tags
+		}
+
+		lease6.SetID(lease.Address)
+		lease6s = append(lease6s, lease6)
+	}
+
+	return lease6s, nil
+}
@@ -18,9 +18,13 @@ import (
 const (
 	LeaseRequestTypeRequest = "Request"
 	LeaseRequestTypeDecline = "Decline"
+
+	LeaseRequestTypeForceRenew       = "ForceRenew"
+	LeaseRequestTypeForceRenewFailed = "ForceRenewFailed"
 )
 
 func ConsumeLease() {
+	startDdnsUpdater()
 	go consumeLease4()
 	go consumeLease6()
 }
@@ -49,6 +53,9 @@ func consumeLease4() {
 		addFingerprintWithLease4(lease4)
 		addOuiWithLease4(lease4)
 		autoReservation4IfNeed(string(message.Key), lease4)
+		updateDdnsWithLease4(string(message.Key), lease4)
+		addLeaseHistoryWithLease4(string(message.Key), lease4)
+		updateAssetWithLease4(string(message.Key), lease4)
 	}
 }
 
@@ -215,6 +222,9 @@ func consumeLease6() {
 		addFingerprintWithLease6(lease6)
 		addOuiWithLease6(lease6)
 		autoReservation6IfNeed(string(message.Key), lease6)
+		updateDdnsWithLease6(string(message.Key), lease6)
+		addLeaseHistoryWithLease6(string(message.Key), lease6)
+		updateAssetWithLease6(string(message.Key), lease6)
 	}
 }
 
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"strconv"
+	"strings"
+
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	FilterNameMac             = "mac"
+	FilterNameClientType      = "client_type"
+	FilterNameOperatingSystem = "operating_system"
+	FilterNameLeaseState      = "lease_state"
+	FilterNameAllocateMode    = "allocate_mode"
+	FilterNameExpirationFrom  = "expiration_from"
+	FilterNameExpirationTo    = "expiration_to"
+	FilterNameSortBy          = "sort_by"
+	FilterNameSortOrder       = "sort_order"
+	FilterNamePageSize        = "page_size"
+	FilterNamePageToken       = "page_token"
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+
+	HeaderNextPageToken = "X-Next-Page-Token"
+	HeaderTotalCount    = "X-Total-Count"
+
+	DefaultLeasePageSize = 100
+	MaxLeasePageSize     = 1000
+)
+
+var leaseFilterNames = []string{FilterNameMac, FilterNameHostname, FilterNameClientType,
+	FilterNameOperatingSystem, FilterNameLeaseState, FilterNameAllocateMode,
+	FilterNameExpirationFrom, FilterNameExpirationTo, FilterNameSortBy, FilterNameSortOrder,
+	FilterNamePageSize, FilterNamePageToken}
+
+var LeaseSortFields = []string{
+	"address", "hw_address", "hostname", "client_type",
+	"operating_system", "lease_state", "expiration_time",
+}
+
+// LeaseFilterFromFilters returns nil if no filter other than ip is given,
+// then all leases of subnet will be returned as before, ip can not be
+// combined with other filters
+func LeaseFilterFromFilters(filters []restresource.Filter) (*pbdhcpagent.LeaseFilter, error) {
+	ip, _ := util.GetFilterValueWithEqModifierFromFilters(util.FilterNameIp, filters)
+	values := make(map[string]string)
+	for _, name := range leaseFilterNames {
+		if value, ok := util.GetFilterValueWithEqModifierFromFilters(name, filters); ok {
+			if ip != "" {
+				return nil, errorno.ErrConflict(errorno.ErrNameIp, errorno.ErrName(name), ip, value)
+			}
+
+			values[name] = strings.TrimSpace(value)
+		}
+	}
+
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	filter := &pbdhcpagent.LeaseFilter{
+		Hostname:        values[FilterNameHostname],
+		ClientType:      values[FilterNameClientType],
+		OperatingSystem: values[FilterNameOperatingSystem],
+		PageToken:       values[FilterNamePageToken],
+	}
+
+	if mac := values[FilterNameMac]; mac != "" {
+		mac = strings.ToUpper(strings.ReplaceAll(mac, "-", ":"))
+		if strings.Trim(mac, "0123456789ABCDEF:") != "" {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameMac, values[FilterNameMac])
+		}
+
+		filter.HwAddressPrefix = mac
+	}
+
+	if states := values[FilterNameLeaseState]; states != "" {
+		for _, state := range strings.Split(states, ",") {
+			state = strings.ToUpper(strings.TrimSpace(state))
+			if _, ok := pbdhcpagent.LeaseState_value[state]; !ok {
+				return nil, errorno.ErrInvalidParams(errorno.ErrNameLeaseState, state)
+			}
+
+			filter.LeaseStates = append(filter.LeaseStates, state)
+		}
+	}
+
+	if mode := values[FilterNameAllocateMode]; mode != "" {
+		mode = strings.ToUpper(mode)
+		if _, ok := pbdhcpagent.LeaseAllocateMode_value[mode]; !ok {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameAllocateMode, mode)
+		}
+
+		filter.AllocateMode = mode
+	}
+
+	if from := values[FilterNameExpirationFrom]; from != "" {
+		if t, err := parseLeaseHistoryTime(from, util.TimeFromSuffix); err != nil {
+			return nil, err
+		} else {
+			filter.ExpirationFrom = t.Unix()
+		}
+	}
+
+	if to := values[FilterNameExpirationTo]; to != "" {
+		if t, err := parseLeaseHistoryTime(to, util.TimeToSuffix); err != nil {
+			return nil, err
+		} else {
+			filter.ExpirationTo = t.Unix()
+		}
+	}
+
+	if sortBy := values[FilterNameSortBy]; sortBy != "" {
+		if !isLeaseSortField(sortBy) {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameSortBy, sortBy)
+		}
+
+		filter.SortBy = sortBy
+	}
+
+	switch order := values[FilterNameSortOrder]; order {
+	case "", SortOrderAsc:
+	case SortOrderDesc:
+		filter.SortDesc = true
+	default:
+		return nil, errorno.ErrInvalidParams(errorno.ErrNameSortOrder, order)
+	}
+
+	if size := values[FilterNamePageSize]; size != "" {
+		if pageSize, err := strconv.ParseUint(size, 10, 32); err != nil ||
+			pageSize == 0 || pageSize > MaxLeasePageSize {
+			return nil, errorno.ErrNotInRange(errorno.ErrNamePageSize, 1, MaxLeasePageSize)
+		} else {
+			filter.PageSize = uint32(pageSize)
+		}
+	} else {
+		filter.PageSize = DefaultLeasePageSize
+	}
+
+	return filter, nil
+}
+
+func isLeaseSortField(field string) bool {
+	for _, f := range LeaseSortFields {
+		if f == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+func SetLeasePageHeaders(ctx *restresource.Context, nextPageToken string, totalCount uint64) {
+	ctx.Response.Header().Set(HeaderNextPageToken, nextPageToken)
+	ctx.Response.Header().Set(HeaderTotalCount, strconv.FormatUint(totalCount, 10))
+}
@@ -0,0 +1,413 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/config"
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	pbdhcp "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-server"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	FilterNameHwAddress = "hw_address"
+	FilterNameDuid      = "duid"
+	FilterNameHostname  = "hostname"
+	FilterNameAtTime    = "time"
+
+	MaxLeaseHistoriesCount = 1000
+
+	LeaseHistoryPartitionPrefix     = "gr_lease_history_"
+	LeaseHistoryPartitionDateFormat = "20060102"
+	LeaseHistoryPartitionPreDays    = 3
+	LeaseHistoryMaintainInterval    = time.Hour
+
+	LeaseHistoryQueueSize     = 10240
+	LeaseHistoryBatchSize     = 512
+	LeaseHistoryFlushInterval = time.Second
+)
+
+const (
+	sqlCreateLeaseHistory = `create table if not exists gr_lease_history (
+		id text not null,
+		create_time timestamp with time zone not null default now(),
+		version text,
+		address text,
+		hw_address text,
+		duid text,
+		client_id text,
+		hostname text,
+		request_type text,
+		lease_state text,
+		subnet_id bigint,
+		subnet text,
+		node text,
+		valid_lifetime bigint,
+		expiration_time text,
+		primary key (id, create_time)
+	) partition by range (create_time)`
+	sqlCreateLeaseHistoryPartition = "create table if not exists %s partition of gr_lease_history for values from ('%s') to ('%s')"
+	sqlCreateLeaseHistoryDefault   = "create table if not exists gr_lease_history_default partition of gr_lease_history default"
+	sqlDeleteExpiredLeaseHistory   = "delete from gr_lease_history_default where create_time < $1"
+	sqlDropLeaseHistoryPartitions  = `do $$ declare r record; begin
+		for r in select c.relname from pg_inherits i join pg_class c on i.inhrelid = c.oid
+			where i.inhparent = 'gr_lease_history'::regclass and c.relname < '%s'
+		loop execute 'drop table if exists ' || quote_ident(r.relname); end loop; end $$`
+)
+
+var sqlCreateLeaseHistoryIndexes = []string{
+	"create index if not exists idx_lease_history_address on gr_lease_history (address, create_time)",
+	"create index if not exists idx_lease_history_hw_address on gr_lease_history (hw_address, create_time)",
+	"create index if not exists idx_lease_history_duid on gr_lease_history (duid, create_time)",
+	"create index if not exists idx_lease_history_hostname on gr_lease_history (hostname, create_time)",
+}
+
+type LeaseHistoryService struct{}
+
+func NewLeaseHistoryService() *LeaseHistoryService {
+	return &LeaseHistoryService{}
+}
+
+type LeaseHistoryConditions struct {
+	Address   string
+	HwAddress string
+	Duid      string
+	Hostname  string
+	From      string
+	To        string
+	AtTime    string
+}
+
+var leaseHistories chan *resource.LeaseHistory
+
+func InitLeaseHistory() error {
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Exec(sqlCreateLeaseHistory); err != nil {
+			return err
+		}
+
+		// rows out of the pre-created partitions, such as the clock going
+		// forward, fall into the default partition instead of failing
+		if _, err := tx.Exec(sqlCreateLeaseHistoryDefault); err != nil {
+			return err
+		}
+
+		for _, sql := range sqlCreateLeaseHistoryIndexes {
+			if _, err := tx.Exec(sql); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameInsert, string(errorno.ErrNameLeaseHistory),
+			pg.Error(err).Error())
+	}
+
+	maintainLeaseHistoryPartitions()
+	leaseHistories = make(chan *resource.LeaseHistory, LeaseHistoryQueueSize)
+	go writeLeaseHistories()
+	go func() {
+		ticker := time.NewTicker(LeaseHistoryMaintainInterval)
+		defer ticker.Stop()
+		for range ticker.C {
+			maintainLeaseHistoryPartitions()
+		}
+	}()
+
+	return nil
+}
+
+func maintainLeaseHistoryPartitions() {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	expiredDay := today.AddDate(0, 0, -config.GetLeaseHistoryRetentionDays())
+	for i := 0; i < LeaseHistoryPartitionPreDays; i++ {
+		day := today.AddDate(0, 0, i)
+		if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+			_, err := tx.Exec(fmt.Sprintf(sqlCreateLeaseHistoryPartition,
+				leaseHistoryPartitionName(day), day.Format(time.RFC3339),
+				day.AddDate(0, 0, 1).Format(time.RFC3339)))
+			return err
+		}); err != nil {
+			log.Warnf("create lease history partition %s failed: %s",
+				leaseHistoryPartitionName(day), pg.Error(err).Error())
+		}
+	}
+
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Exec(fmt.Sprintf(sqlDropLeaseHistoryPartitions,
+			leaseHistoryPartitionName(expiredDay))); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(sqlDeleteExpiredLeaseHistory, expiredDay)
+		return err
+	}); err != nil {
+		log.Warnf("drop expired lease histories failed: %s", pg.Error(err).Error())
+	}
+}
+
+func leaseHistoryPartitionName(day time.Time) string {
+	return LeaseHistoryPartitionPrefix + day.Format(LeaseHistoryPartitionDateFormat)
+}
+
+func addLeaseHistoryWithLease4(requestType string, lease4 pbdhcp.Lease4) {
+	hwAddress, err := util.NormalizeMac(lease4.GetHwAddress())
+	if err != nil {
+		hwAddress = lease4.GetHwAddress()
+	}
+
+	addLeaseHistory(&resource.LeaseHistory{
+		Version:        resource.LeaseHistoryVersion4,
+		Address:        lease4.GetAddress(),
+		HwAddress:      hwAddress,
+		ClientId:       lease4.GetClientId(),
+		Hostname:       lease4.GetHostname(),
+		RequestType:    requestType,
+		LeaseState:     pbdhcpagent.LeaseState(lease4.GetLeaseState()).String(),
+		SubnetId:       lease4.GetSubnetId(),
+		Subnet:         lease4.GetSubnet(),
+		Node:           lease4.GetNode(),
+		ValidLifetime:  lease4.GetValidLifetime(),
+		ExpirationTime: lease4.GetExpirationTime(),
+	})
+}
+
+func addLeaseHistoryWithLease6(requestType string, lease6 pbdhcp.Lease6) {
+	hwAddress, err := util.NormalizeMac(lease6.GetHwAddress())
+	if err != nil {
+		hwAddress = lease6.GetHwAddress()
+	}
+
+	addLeaseHistory(&resource.LeaseHistory{
+		Version:        resource.LeaseHistoryVersion6,
+		Address:        lease6.GetAddress(),
+		HwAddress:      hwAddress,
+		Duid:           lease6.GetDuid(),
+		Hostname:       lease6.GetHostname(),
+		RequestType:    requestType,
+		LeaseState:     pbdhcpagent.LeaseState(lease6.GetLeaseState()).String(),
+		SubnetId:       lease6.GetSubnetId(),
+		Subnet:         lease6.GetSubnet(),
+		Node:           lease6.GetNode(),
+		ValidLifetime:  lease6.GetValidLifetime(),
+		ExpirationTime: lease6.GetExpirationTime(),
+	})
+}
+
+func addLeaseHistory(history *resource.LeaseHistory) {
+	if leaseHistories == nil {
+		return
+	}
+
+	history.SetCreationTimestamp(time.Now())
+	leaseHistories <- history
+}
+
+func writeLeaseHistories() {
+	ticker := time.NewTicker(LeaseHistoryFlushInterval)
+	defer ticker.Stop()
+	values := make([][]interface{}, 0, LeaseHistoryBatchSize)
+	for {
+		select {
+		case history := <-leaseHistories:
+			if values = append(values, history.GenCopyValues()); len(values) < LeaseHistoryBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(values) == 0 {
+				continue
+			}
+		}
+
+		if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+			_, err := tx.CopyFromEx(resource.TableLeaseHistory, resource.LeaseHistoryColumns, values)
+			return err
+		}); err != nil {
+			log.Warnf("add %d lease histories failed: %s", len(values), pg.Error(err).Error())
+		}
+
+		values = make([][]interface{}, 0, LeaseHistoryBatchSize)
+	}
+}
+
+func (l *LeaseHistoryService) List(ctx *restresource.Context) ([]*resource.LeaseHistory, error) {
+	filters := ctx.GetFilters()
+	conditions := &LeaseHistoryConditions{}
+	conditions.Address, _ = util.GetFilterValueWithEqModifierFromFilters(util.FilterNameIp, filters)
+	conditions.HwAddress, _ = util.GetFilterValueWithEqModifierFromFilters(FilterNameHwAddress, filters)
+	conditions.Duid, _ = util.GetFilterValueWithEqModifierFromFilters(FilterNameDuid, filters)
+	conditions.Hostname, _ = util.GetFilterValueWithEqModifierFromFilters(FilterNameHostname, filters)
+	conditions.From, _ = util.GetFilterValueWithEqModifierFromFilters(util.FilterNameTimeFrom, filters)
+	conditions.To, _ = util.GetFilterValueWithEqModifierFromFilters(util.FilterNameTimeTo, filters)
+	conditions.AtTime, _ = util.GetFilterValueWithEqModifierFromFilters(FilterNameAtTime, filters)
+	pagination := ctx.GetPagination()
+	histories, count, err := listLeaseHistories(conditions, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	setPagination(ctx, pagination != nil && pagination.PageSize > 0 && pagination.PageNum > 0, count)
+	return histories, nil
+}
+
+func ListLeaseHistories(conditions *LeaseHistoryConditions) ([]*resource.LeaseHistory, error) {
+	histories, _, err := listLeaseHistories(conditions, nil)
+	return histories, err
+}
+
+func listLeaseHistories(conditions *LeaseHistoryConditions, pagination *restresource.Pagination) ([]*resource.LeaseHistory, int, error) {
+	if conditions.AtTime != "" {
+		histories, err := getLeaseHistoryAtTime(conditions.Address, conditions.AtTime)
+		return histories, len(histories), err
+	}
+
+	sql, params, err := genLeaseHistoriesSqlAndParams(conditions)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var count int64
+	var histories []*resource.LeaseHistory
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if pagination != nil && pagination.PageSize > 0 && pagination.PageNum > 0 {
+			if count, err = tx.CountEx(resource.TableLeaseHistory,
+				strings.Replace(sql, "*", "count(*)", 1), params...); err != nil {
+				return err
+			}
+
+			sql += " order by create_time desc"
+			sql += fmt.Sprintf(" limit $%d offset $%d", len(params)+1, len(params)+2)
+			params = append(params, pagination.PageSize, (pagination.PageNum-1)*pagination.PageSize)
+		} else {
+			sql += " order by create_time desc limit " + strconv.Itoa(MaxLeaseHistoriesCount)
+		}
+
+		return tx.FillEx(&histories, sql, params...)
+	}); err != nil {
+		return nil, 0, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameLeaseHistory), pg.Error(err).Error())
+	}
+
+	return histories, int(count), nil
+}
+
+func genLeaseHistoriesSqlAndParams(conditions *LeaseHistoryConditions) (string, []interface{}, error) {
+	var whereSqls []string
+	var params []interface{}
+	appendCondition := func(column string, value interface{}, op string) {
+		params = append(params, value)
+		whereSqls = append(whereSqls, column+" "+op+" $"+strconv.Itoa(len(params)))
+	}
+
+	if conditions.Address != "" {
+		appendCondition(resource.SqlColumnAddress, conditions.Address, "=")
+	}
+
+	if conditions.HwAddress != "" {
+		hwAddress, err := util.NormalizeMac(conditions.HwAddress)
+		if err != nil {
+			return "", nil, errorno.ErrInvalidParams(errorno.ErrNameMac, conditions.HwAddress)
+		}
+
+		appendCondition(resource.SqlColumnHwAddress, hwAddress, "=")
+	}
+
+	if conditions.Duid != "" {
+		appendCondition(resource.SqlColumnDuid, conditions.Duid, "=")
+	}
+
+	if conditions.Hostname != "" {
+		appendCondition(resource.SqlColumnHostname, conditions.Hostname, "=")
+	}
+
+	if conditions.From != "" {
+		from, err := parseLeaseHistoryTime(conditions.From, util.TimeFromSuffix)
+		if err != nil {
+			return "", nil, err
+		}
+
+		appendCondition(restdb.CreateTimeField, from, ">=")
+	}
+
+	if conditions.To != "" {
+		to, err := parseLeaseHistoryTime(conditions.To, util.TimeToSuffix)
+		if err != nil {
+			return "", nil, err
+		}
+
+		appendCondition(restdb.CreateTimeField, to.Add(time.Minute), "<")
+	}
+
+	sql := "select * from gr_lease_history"
+	if len(whereSqls) != 0 {
+		sql += " where " + strings.Join(whereSqls, " and ")
+	}
+
+	return sql, params, nil
+}
+
+func parseLeaseHistoryTime(value, suffix string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if len(value) == len(util.TimeFormatYMD) {
+		value += suffix
+	}
+
+	if t, err := time.ParseInLocation(util.TimeFormatYMDHM, value, time.Local); err != nil {
+		return time.Time{}, errorno.ErrInvalidParams(errorno.ErrNameTime, value)
+	} else {
+		return t, nil
+	}
+}
+
+// getLeaseHistoryAtTime returns the lease event which held ip at the time,
+// that is the last event before the time which allocated the ip and had
+// not expired at the time
+func getLeaseHistoryAtTime(ip, atTime string) ([]*resource.LeaseHistory, error) {
+	if ip == "" {
+		return nil, errorno.ErrEmpty(string(errorno.ErrNameIp))
+	}
+
+	at, err := parseLeaseHistoryTime(atTime, ":00")
+	if err != nil {
+		return nil, err
+	}
+
+	var histories []*resource.LeaseHistory
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&histories,
+			"select * from gr_lease_history where address = $1 and create_time <= $2 order by create_time desc limit 1",
+			ip, at)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameLeaseHistory), pg.Error(err).Error())
+	}
+
+	if len(histories) == 0 || !leaseHistoryHoldsAddressAt(histories[0], at) {
+		return nil, nil
+	}
+
+	return histories, nil
+}
+
+func leaseHistoryHoldsAddressAt(history *resource.LeaseHistory, at time.Time) bool {
+	return history.RequestType == LeaseRequestTypeRequest &&
+		history.LeaseState == pbdhcpagent.LeaseState_NORMAL.String() &&
+		!history.GetCreationTimestamp().Add(
+			time.Duration(history.ValidLifetime)*time.Second).Before(at)
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const FilterNameParent = "parent"
+
+type NetworkContainer4Service struct {
+}
+
+func NewNetworkContainer4Service() *NetworkContainer4Service {
+	return &NetworkContainer4Service{}
+}
+
+func (n *NetworkContainer4Service) Create(container *resource.NetworkContainer4) error {
+	if err := container.Validate(); err != nil {
+		return err
+	}
+
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		_, err := tx.Insert(container)
+		return err
+	}); err != nil {
+		return util.FormatDbInsertError(errorno.ErrNameNetworkContainer, container.Name, err)
+	}
+
+	return nil
+}
+
+func (n *NetworkContainer4Service) List(ctx *restresource.Context) ([]*resource.NetworkContainer4, error) {
+	var containers []*resource.NetworkContainer4
+	var subnets []*resource.Subnet4
+	var sharedNetworks []*resource.SharedNetwork4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(map[string]interface{}{resource.SqlOrderBy: resource.SqlColumnIpnet},
+			&containers); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkContainer), pg.Error(err).Error())
+		} else if len(containers) == 0 {
+			return nil
+		}
+
+		if err := tx.Fill(nil, &subnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+		}
+
+		if err := tx.Fill(nil, &sharedNetworks); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := SetSubnet4UsedInfo(subnets, false); err != nil {
+		log.Warnf("set subnet4s leases used info failed: %s", err.Error())
+	}
+
+	rollupNetworkContainer4s(containers, containers, subnets, sharedNetworks)
+	return filterNetworkContainer4s(ctx, containers), nil
+}
+
+func filterNetworkContainer4s(ctx *restresource.Context, containers []*resource.NetworkContainer4) []*resource.NetworkContainer4 {
+	name, hasName := util.GetFilterValueWithEqModifierFromFilters(util.FilterNameName, ctx.GetFilters())
+	parent, hasParent := util.GetFilterValueWithEqModifierFromFilters(FilterNameParent, ctx.GetFilters())
+	if !hasName && !hasParent {
+		return containers
+	}
+
+	var filtered []*resource.NetworkContainer4
+	for _, container := range containers {
+		if (!hasName || container.Name == name) && (!hasParent || container.Parent == parent) {
+			filtered = append(filtered, container)
+		}
+	}
+
+	return filtered
+}
+
+func (n *NetworkContainer4Service) Get(id string) (*resource.NetworkContainer4, error) {
+	var containers []*resource.NetworkContainer4
+	var container *resource.NetworkContainer4
+	var subnets []*resource.Subnet4
+	var sharedNetworks []*resource.SharedNetwork4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(nil, &containers); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		}
+
+		for _, c := range containers {
+			if c.GetID() == id {
+				container = c
+				break
+			}
+		}
+
+		if container == nil {
+			return errorno.ErrNotFound(errorno.ErrNameNetworkContainer, id)
+		}
+
+		if err := tx.FillEx(&subnets,
+			"SELECT * FROM gr_subnet4 WHERE network(ipnet::inet) <<= network($1::inet)",
+			container.Supernet); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+		}
+
+		if err := tx.Fill(nil, &sharedNetworks); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := SetSubnet4UsedInfo(subnets, true); err != nil {
+		log.Warnf("set subnet4s leases used info failed: %s", err.Error())
+	}
+
+	rollupNetworkContainer4s([]*resource.NetworkContainer4{container}, containers, subnets, sharedNetworks)
+	return container, nil
+}
+
+// rollupNetworkContainer4s sets parent of containers to the smallest container
+// contains it, and sums capacity and leases count of all subnets in containers,
+// including the subnets in its child containers
+func rollupNetworkContainer4s(containers, allContainers []*resource.NetworkContainer4, subnets []*resource.Subnet4, sharedNetworks []*resource.SharedNetwork4) {
+	containerIpnets := make(map[string]net.IPNet, len(allContainers))
+	for _, container := range allContainers {
+		containerIpnets[container.GetID()] = container.Ipnet
+	}
+
+	sharedNetworkNames := make(map[uint64]string)
+	for _, sharedNetwork := range sharedNetworks {
+		for _, subnetId := range sharedNetwork.SubnetIds {
+			sharedNetworkNames[subnetId] = sharedNetwork.Name
+		}
+	}
+
+	for _, container := range containers {
+		container.Parent = getNetworkContainerParent(container.GetID(), container.Ipnet, containerIpnets)
+		for _, subnet := range subnets {
+			if !ipnetContains(container.Ipnet, subnet.Ipnet) {
+				continue
+			}
+
+			container.SubnetCount += 1
+			container.Capacity += subnet.Capacity
+			container.UsedCount += subnet.UsedCount
+			if name, ok := sharedNetworkNames[subnet.SubnetId]; ok {
+				container.SharedNetworks = appendIfNotExist(container.SharedNetworks, name)
+			}
+		}
+
+		if container.Capacity != 0 && container.UsedCount != 0 {
+			container.UsedRatio = fmt.Sprintf("%.4f",
+				float64(container.UsedCount)/float64(container.Capacity))
+		}
+	}
+}
+
+func getNetworkContainerParent(id string, ipnet net.IPNet, containerIpnets map[string]net.IPNet) string {
+	var parent string
+	parentOnes := -1
+	for containerId, containerIpnet := range containerIpnets {
+		if containerId == id || !ipnetContains(containerIpnet, ipnet) {
+			continue
+		}
+
+		if ones, _ := containerIpnet.Mask.Size(); ones > parentOnes {
+			parent = containerId
+			parentOnes = ones
+		}
+	}
+
+	return parent
+}
+
+func ipnetContains(parent, child net.IPNet) bool {
+	parentOnes, _ := parent.Mask.Size()
+	childOnes, _ := child.Mask.Size()
+	return parentOnes <= childOnes && parent.Contains(child.IP)
+}
+
+func appendIfNotExist(ss []string, s string) []string {
+	for _, existed := range ss {
+		if existed == s {
+			return ss
+		}
+	}
+
+	return append(ss, s)
+}
+
+func (n *NetworkContainer4Service) Update(container *resource.NetworkContainer4) error {
+	if err := container.ValidateParams(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Update(resource.TableNetworkContainer4, map[string]interface{}{
+			resource.SqlColumnName:    container.Name,
+			resource.SqlColumnTags:    container.Tags,
+			resource.SqlColumnComment: container.Comment,
+		}, map[string]interface{}{restdb.IDField: container.GetID()}); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameNetworkContainer, container.Name, err)
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameNetworkContainer, container.GetID())
+		} else {
+			return nil
+		}
+	})
+}
+
+func (n *NetworkContainer4Service) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Delete(resource.TableNetworkContainer4, map[string]interface{}{
+			restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameNetworkContainer, id)
+		} else {
+			return nil
+		}
+	})
+}
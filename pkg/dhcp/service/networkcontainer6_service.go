@@ -0,0 +1,208 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"net"
+
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type NetworkContainer6Service struct {
+}
+
+func NewNetworkContainer6Service() *NetworkContainer6Service {
+	return &NetworkContainer6Service{}
+}
+
+func (n *NetworkContainer6Service) Create(container *resource.NetworkContainer6) error {
+	if err := container.Validate(); err != nil {
+		return err
+	}
+
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		_, err := tx.Insert(container)
+		return err
+	}); err != nil {
+		return util.FormatDbInsertError(errorno.ErrNameNetworkContainer, container.Name, err)
+	}
+
+	return nil
+}
+
+func (n *NetworkContainer6Service) List(ctx *restresource.Context) ([]*resource.NetworkContainer6, error) {
+	var containers []*resource.NetworkContainer6
+	var subnets []*resource.Subnet6
+	var sharedNetworks []*resource.SharedNetwork6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(map[string]interface{}{resource.SqlOrderBy: resource.SqlColumnIpnet},
+			&containers); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkContainer), pg.Error(err).Error())
+		} else if len(containers) == 0 {
+			return nil
+		}
+
+		if err := tx.Fill(nil, &subnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+		}
+
+		if err := tx.Fill(nil, &sharedNetworks); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := SetSubnet6sLeasesUsedInfo(subnets, false); err != nil {
+		log.Warnf("set subnet6s leases used info failed: %s", err.Error())
+	}
+
+	rollupNetworkContainer6s(containers, containers, subnets, sharedNetworks)
+	return filterNetworkContainer6s(ctx, containers), nil
+}
+
+func filterNetworkContainer6s(ctx *restresource.Context, containers []*resource.NetworkContainer6) []*resource.NetworkContainer6 {
+	name, hasName := util.GetFilterValueWithEqModifierFromFilters(util.FilterNameName, ctx.GetFilters())
+	parent, hasParent := util.GetFilterValueWithEqModifierFromFilters(FilterNameParent, ctx.GetFilters())
+	if !hasName && !hasParent {
+		return containers
+	}
+
+	var filtered []*resource.NetworkContainer6
+	for _, container := range containers {
+		if (!hasName || container.Name == name) && (!hasParent || container.Parent == parent) {
+			filtered = append(filtered, container)
+		}
+	}
+
+	return filtered
+}
+
+func (n *NetworkContainer6Service) Get(id string) (*resource.NetworkContainer6, error) {
+	var containers []*resource.NetworkContainer6
+	var container *resource.NetworkContainer6
+	var subnets []*resource.Subnet6
+	var sharedNetworks []*resource.SharedNetwork6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(nil, &containers); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		}
+
+		for _, c := range containers {
+			if c.GetID() == id {
+				container = c
+				break
+			}
+		}
+
+		if container == nil {
+			return errorno.ErrNotFound(errorno.ErrNameNetworkContainer, id)
+		}
+
+		if err := tx.FillEx(&subnets,
+			"SELECT * FROM gr_subnet6 WHERE network(ipnet::inet) <<= network($1::inet)",
+			container.Supernet); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+		}
+
+		if err := tx.Fill(nil, &sharedNetworks); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := SetSubnet6sLeasesUsedInfo(subnets, true); err != nil {
+		log.Warnf("set subnet6s leases used info failed: %s", err.Error())
+	}
+
+	rollupNetworkContainer6s([]*resource.NetworkContainer6{container}, containers, subnets, sharedNetworks)
+	return container, nil
+}
+
+func rollupNetworkContainer6s(containers, allContainers []*resource.NetworkContainer6, subnets []*resource.Subnet6, sharedNetworks []*resource.SharedNetwork6) {
+	containerIpnets := make(map[string]net.IPNet, len(allContainers))
+	for _, container := range allContainers {
+		containerIpnets[container.GetID()] = container.Ipnet
+	}
+
+	sharedNetworkNames := make(map[uint64]string)
+	for _, sharedNetwork := range sharedNetworks {
+		for _, subnetId := range sharedNetwork.SubnetIds {
+			sharedNetworkNames[subnetId] = sharedNetwork.Name
+		}
+	}
+
+	for _, container := range containers {
+		container.Parent = getNetworkContainerParent(container.GetID(), container.Ipnet, containerIpnets)
+		container.Capacity = "0"
+		for _, subnet := range subnets {
+			if !ipnetContains(container.Ipnet, subnet.Ipnet) {
+				continue
+			}
+
+			container.SubnetCount += 1
+			capacity, _ := new(big.Int).SetString(subnet.Capacity, 10)
+			container.Capacity = resource.AddCapacityWithBigInt(container.Capacity, capacity)
+			container.UsedCount += subnet.UsedCount
+			if name, ok := sharedNetworkNames[subnet.SubnetId]; ok {
+				container.SharedNetworks = appendIfNotExist(container.SharedNetworks, name)
+			}
+		}
+
+		if !resource.IsCapacityZero(container.Capacity) && container.UsedCount != 0 {
+			container.UsedRatio = fmt.Sprintf("%.4f",
+				calculateUsedRatio(container.Capacity, container.UsedCount))
+		}
+	}
+}
+
+func (n *NetworkContainer6Service) Update(container *resource.NetworkContainer6) error {
+	if err := container.ValidateParams(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Update(resource.TableNetworkContainer6, map[string]interface{}{
+			resource.SqlColumnName:    container.Name,
+			resource.SqlColumnTags:    container.Tags,
+			resource.SqlColumnComment: container.Comment,
+		}, map[string]interface{}{restdb.IDField: container.GetID()}); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameNetworkContainer, container.Name, err)
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameNetworkContainer, container.GetID())
+		} else {
+			return nil
+		}
+	})
+}
+
+func (n *NetworkContainer6Service) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Delete(resource.TableNetworkContainer6, map[string]interface{}{
+			restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameNetworkContainer, id)
+		} else {
+			return nil
+		}
+	})
+}
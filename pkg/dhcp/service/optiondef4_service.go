@@ -0,0 +1,176 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type OptionDef4Service struct {
+}
+
+func NewOptionDef4Service() *OptionDef4Service {
+	return &OptionDef4Service{}
+}
+
+func (o *OptionDef4Service) Create(optionDef *resource.OptionDef4) error {
+	optionDef.SetID(optionDef.Name)
+	if err := optionDef.Validate(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Insert(optionDef); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameOptionDef, optionDef.Name, err)
+		}
+
+		return nil
+	})
+}
+
+func (o *OptionDef4Service) List(conditions map[string]interface{}) ([]*resource.OptionDef4, error) {
+	conditions[resource.SqlOrderBy] = resource.SqlColumnCode
+	var optionDefs []*resource.OptionDef4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(conditions, &optionDefs)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameOptionDef), pg.Error(err).Error())
+	}
+
+	return optionDefs, nil
+}
+
+func (o *OptionDef4Service) Get(id string) (*resource.OptionDef4, error) {
+	var optionDefs []*resource.OptionDef4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(map[string]interface{}{restdb.IDField: id}, &optionDefs)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+	} else if len(optionDefs) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameOptionDef, id)
+	}
+
+	return optionDefs[0], nil
+}
+
+func (o *OptionDef4Service) Update(optionDef *resource.OptionDef4) error {
+	if err := optionDef.ValidateComment(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Update(resource.TableOptionDef4,
+			map[string]interface{}{resource.SqlColumnComment: optionDef.Comment},
+			map[string]interface{}{restdb.IDField: optionDef.GetID()}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, optionDef.GetID(),
+				pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameOptionDef, optionDef.GetID())
+		}
+
+		return nil
+	})
+}
+
+func (o *OptionDef4Service) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if exists, err := tx.Exists(resource.TableOptionValue4,
+			map[string]interface{}{resource.SqlColumnName: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameExists,
+				string(errorno.ErrNameOptionDef), pg.Error(err).Error())
+		} else if exists {
+			return errorno.ErrBeenUsed(errorno.ErrNameOptionDef, id)
+		}
+
+		if rows, err := tx.Delete(resource.TableOptionDef4,
+			map[string]interface{}{restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameOptionDef, id)
+		}
+
+		return nil
+	})
+}
+
+func saveOptionValue4s(tx restdb.Transaction, scope resource.OptionScope, scopeId, subnetId string, values []*resource.OptionValue4) error {
+	if err := deleteOptionValue4s(tx, map[string]interface{}{
+		resource.SqlColumnScope: scope, resource.SqlColumnScopeId: scopeId}); err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		value.Scope = scope
+		value.ScopeId = scopeId
+		value.Subnet4 = subnetId
+		if _, err := tx.Insert(value); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert, value.Name, pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func deleteOptionValue4s(tx restdb.Transaction, conditions map[string]interface{}) error {
+	if _, err := tx.Delete(resource.TableOptionValue4, conditions); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameDelete,
+			string(errorno.ErrNameOptionValue), pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+func getOptionValue4s(tx restdb.Transaction, conditions map[string]interface{}) ([]*resource.OptionValue4, error) {
+	var values []*resource.OptionValue4
+	if err := tx.Fill(conditions, &values); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameOptionValue), pg.Error(err).Error())
+	}
+
+	return values, nil
+}
+
+func getOptionValue4sMap(tx restdb.Transaction, conditions map[string]interface{}) (map[string][]*resource.OptionValue4, error) {
+	values, err := getOptionValue4s(tx, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesMap := make(map[string][]*resource.OptionValue4, len(values))
+	for _, value := range values {
+		valuesMap[value.ScopeId] = append(valuesMap[value.ScopeId], value)
+	}
+
+	return valuesMap, nil
+}
+
+func getOptionValue4sMapWithScopeIds(tx restdb.Transaction, scope resource.OptionScope, scopeIds []string) (map[string][]*resource.OptionValue4, error) {
+	if len(scopeIds) == 0 {
+		return nil, nil
+	}
+
+	return getOptionValue4sMap(tx, map[string]interface{}{
+		resource.SqlColumnScope: scope,
+		resource.SqlColumnScopeId: restdb.FillValue{
+			Operator: restdb.OperatorAny, Value: scopeIds}})
+}
+
+func pbSubnetOptionsFromOptionValue4s(values []*resource.OptionValue4) []*pbdhcpagent.SubnetOption {
+	subnetOptions := make([]*pbdhcpagent.SubnetOption, 0, len(values))
+	for _, value := range values {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: value.Name,
+			Code: value.Code,
+			Data: value.Data,
+			Type: resource.OptionDataTypeHex,
+		})
+	}
+
+	return subnetOptions
+}
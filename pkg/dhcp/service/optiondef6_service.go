@@ -0,0 +1,176 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type OptionDef6Service struct {
+}
+
+func NewOptionDef6Service() *OptionDef6Service {
+	return &OptionDef6Service{}
+}
+
+func (o *OptionDef6Service) Create(optionDef *resource.OptionDef6) error {
+	optionDef.SetID(optionDef.Name)
+	if err := optionDef.Validate(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Insert(optionDef); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameOptionDef, optionDef.Name, err)
+		}
+
+		return nil
+	})
+}
+
+func (o *OptionDef6Service) List(conditions map[string]interface{}) ([]*resource.OptionDef6, error) {
+	conditions[resource.SqlOrderBy] = resource.SqlColumnCode
+	var optionDefs []*resource.OptionDef6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(conditions, &optionDefs)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameOptionDef), pg.Error(err).Error())
+	}
+
+	return optionDefs, nil
+}
+
+func (o *OptionDef6Service) Get(id string) (*resource.OptionDef6, error) {
+	var optionDefs []*resource.OptionDef6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(map[string]interface{}{restdb.IDField: id}, &optionDefs)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+	} else if len(optionDefs) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameOptionDef, id)
+	}
+
+	return optionDefs[0], nil
+}
+
+func (o *OptionDef6Service) Update(optionDef *resource.OptionDef6) error {
+	if err := optionDef.ValidateComment(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Update(resource.TableOptionDef6,
+			map[string]interface{}{resource.SqlColumnComment: optionDef.Comment},
+			map[string]interface{}{restdb.IDField: optionDef.GetID()}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, optionDef.GetID(),
+				pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameOptionDef, optionDef.GetID())
+		}
+
+		return nil
+	})
+}
+
+func (o *OptionDef6Service) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if exists, err := tx.Exists(resource.TableOptionValue6,
+			map[string]interface{}{resource.SqlColumnName: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameExists,
+				string(errorno.ErrNameOptionDef), pg.Error(err).Error())
+		} else if exists {
+			return errorno.ErrBeenUsed(errorno.ErrNameOptionDef, id)
+		}
+
+		if rows, err := tx.Delete(resource.TableOptionDef6,
+			map[string]interface{}{restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameOptionDef, id)
+		}
+
+		return nil
+	})
+}
+
+func saveOptionValue6s(tx restdb.Transaction, scope resource.OptionScope, scopeId, subnetId string, values []*resource.OptionValue6) error {
+	if err := deleteOptionValue6s(tx, map[string]interface{}{
+		resource.SqlColumnScope: scope, resource.SqlColumnScopeId: scopeId}); err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		value.Scope = scope
+		value.ScopeId = scopeId
+		value.Subnet6 = subnetId
+		if _, err := tx.Insert(value); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert, value.Name, pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func deleteOptionValue6s(tx restdb.Transaction, conditions map[string]interface{}) error {
+	if _, err := tx.Delete(resource.TableOptionValue6, conditions); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameDelete,
+			string(errorno.ErrNameOptionValue), pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+func getOptionValue6s(tx restdb.Transaction, conditions map[string]interface{}) ([]*resource.OptionValue6, error) {
+	var values []*resource.OptionValue6
+	if err := tx.Fill(conditions, &values); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameOptionValue), pg.Error(err).Error())
+	}
+
+	return values, nil
+}
+
+func getOptionValue6sMap(tx restdb.Transaction, conditions map[string]interface{}) (map[string][]*resource.OptionValue6, error) {
+	values, err := getOptionValue6s(tx, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	valuesMap := make(map[string][]*resource.OptionValue6, len(values))
+	for _, value := range values {
+		valuesMap[value.ScopeId] = append(valuesMap[value.ScopeId], value)
+	}
+
+	return valuesMap, nil
+}
+
+func getOptionValue6sMapWithScopeIds(tx restdb.Transaction, scope resource.OptionScope, scopeIds []string) (map[string][]*resource.OptionValue6, error) {
+	if len(scopeIds) == 0 {
+		return nil, nil
+	}
+
+	return getOptionValue6sMap(tx, map[string]interface{}{
+		resource.SqlColumnScope: scope,
+		resource.SqlColumnScopeId: restdb.FillValue{
+			Operator: restdb.OperatorAny, Value: scopeIds}})
+}
+
+func pbSubnetOptionsFromOptionValue6s(values []*resource.OptionValue6) []*pbdhcpagent.SubnetOption {
+	subnetOptions := make([]*pbdhcpagent.SubnetOption, 0, len(values))
+	for _, value := range values {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: value.Name,
+			Code: value.Code,
+			Data: value.Data,
+			Type: resource.OptionDataTypeHex,
+		})
+	}
+
+	return subnetOptions
+}
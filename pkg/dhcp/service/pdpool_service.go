@@ -46,6 +46,11 @@ func (p *PdPoolService) Create(subnet *resource.Subnet6, pdpool *resource.PdPool
 				string(errorno.ErrNamePdPool), pg.Error(err).Error())
 		}
 
+		if err := saveOptionValue6s(tx, resource.OptionScopePdPool, pdpool.GetID(),
+			subnet.GetID(), pdpool.Options); err != nil {
+			return err
+		}
+
 		if !resource.IsCapacityZero(pdpool.Capacity) {
 			if err := updateResourceCapacity(tx, resource.TableSubnet6, subnet.GetID(),
 				subnet.AddCapacityWithString(pdpool.Capacity),
@@ -193,10 +198,15 @@ func sendCreatePdPoolCmdToDHCPAgent(subnetID uint64, nodes []string, pdpool *res
 
 func pdpoolToCreatePdPoolRequest(subnetID uint64, pdpool *resource.PdPool) *pbdhcpagent.CreatePdPoolRequest {
 	return &pbdhcpagent.CreatePdPoolRequest{
-		SubnetId:     subnetID,
-		Prefix:       pdpool.Prefix,
-		PrefixLen:    pdpool.PrefixLen,
-		DelegatedLen: pdpool.DelegatedLen,
+		SubnetId:                 subnetID,
+		Prefix:                   pdpool.Prefix,
+		PrefixLen:                pdpool.PrefixLen,
+		DelegatedLen:             pdpool.DelegatedLen,
+		PoolOptions:              pbSubnetOptionsFromOptionValue6s(pdpool.Options),
+		WhiteClientClassStrategy: pdpool.WhiteClientClassStrategy,
+		WhiteClientClasses:       pdpool.WhiteClientClasses,
+		BlackClientClassStrategy: pdpool.BlackClientClassStrategy,
+		BlackClientClasses:       pdpool.BlackClientClasses,
 	}
 }
 
@@ -229,6 +239,12 @@ func listPdPools(subnet *resource.Subnet6, mode ListResourceMode) ([]*resource.P
 			return
 		}
 
+		if mode == ListResourceModeAPI {
+			if err = setPdPoolsOptionValues(tx, subnet.GetID(), pdpools); err != nil {
+				return
+			}
+		}
+
 		if len(subnet.Nodes) != 0 {
 			reservations, err = getReservation6sWithPrefixesExists(tx, subnet.GetID())
 		}
@@ -248,6 +264,21 @@ func listPdPools(subnet *resource.Subnet6, mode ListResourceMode) ([]*resource.P
 	return pdpools, nil
 }
 
+func setPdPoolsOptionValues(tx restdb.Transaction, subnetId string, pdpools []*resource.PdPool) error {
+	valuesMap, err := getOptionValue6sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnetId,
+		resource.SqlColumnScope:   resource.OptionScopePdPool})
+	if err != nil {
+		return err
+	}
+
+	for _, pdpool := range pdpools {
+		pdpool.Options = valuesMap[pdpool.GetID()]
+	}
+
+	return nil
+}
+
 func getPdPoolsWithCondition(tx restdb.Transaction, condition map[string]interface{}) ([]*resource.PdPool, error) {
 	var pdpools []*resource.PdPool
 	if err := tx.Fill(condition, &pdpools); err != nil {
@@ -317,6 +348,11 @@ func (p *PdPoolService) Get(subnet *resource.Subnet6, pdpoolId string) (*resourc
 			return errorno.ErrNotFound(errorno.ErrNamePdPool, pdpoolId)
 		}
 
+		if pdpools[0].Options, err = getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScopeId: pdpoolId}); err != nil {
+			return
+		}
+
 		if len(subnet.Nodes) != 0 {
 			reservations, err = getReservation6sWithPrefixesExists(tx, subnet.GetID())
 		}
@@ -392,6 +428,11 @@ func (p *PdPoolService) Delete(subnet *resource.Subnet6, pdpool *resource.PdPool
 				pg.Error(err).Error())
 		}
 
+		if err := deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScopeId: pdpool.GetID()}); err != nil {
+			return err
+		}
+
 		if !resource.IsCapacityZero(pdpool.Capacity) {
 			if err := updateResourceCapacity(tx, resource.TableSubnet6, subnet.GetID(),
 				subnet.SubCapacityWithString(pdpool.Capacity),
@@ -460,19 +501,63 @@ func (p *PdPoolService) Update(subnetId string, pdpool *resource.PdPool) error {
 		return err
 	}
 
+	if err := resource.CheckOptionValue6s(pdpool.Options, nil); err != nil {
+		return err
+	}
+
+	if err := pdpool.ValidateClientClasses(); err != nil {
+		return err
+	}
+
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if rows, err := tx.Update(resource.TablePdPool,
-			map[string]interface{}{resource.SqlColumnComment: pdpool.Comment},
-			map[string]interface{}{restdb.IDField: pdpool.GetID()}); err != nil {
+		subnet, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := setPdPoolFromDB(tx, pdpool); err != nil {
+			return err
+		}
+
+		if _, err := tx.Update(resource.TablePdPool, map[string]interface{}{
+			resource.SqlColumnComment:                  pdpool.Comment,
+			resource.SqlColumnWhiteClientClassStrategy: pdpool.WhiteClientClassStrategy,
+			resource.SqlColumnWhiteClientClasses:       pdpool.WhiteClientClasses,
+			resource.SqlColumnBlackClientClassStrategy: pdpool.BlackClientClassStrategy,
+			resource.SqlColumnBlackClientClasses:       pdpool.BlackClientClasses,
+		}, map[string]interface{}{restdb.IDField: pdpool.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, pdpool.GetID(),
 				pg.Error(err).Error())
-		} else if rows == 0 {
-			return errorno.ErrNotFound(errorno.ErrNamePdPool, pdpool.GetID())
 		}
-		return nil
+
+		if err := saveOptionValue6s(tx, resource.OptionScopePdPool, pdpool.GetID(),
+			subnetId, pdpool.Options); err != nil {
+			return err
+		}
+
+		return sendUpdatePdPoolCmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pdpool)
 	})
 }
 
+func sendUpdatePdPoolCmdToDHCPAgent(subnetID uint64, nodes []string, pdpool *resource.PdPool) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+
+	return kafka.SendDHCPCmdWithNodes(false, nodes, kafka.UpdatePdPool,
+		&pbdhcpagent.UpdatePdPoolRequest{
+			SubnetId:                 subnetID,
+			Prefix:                   pdpool.Prefix,
+			PrefixLen:                pdpool.PrefixLen,
+			DelegatedLen:             pdpool.DelegatedLen,
+			PoolOptions:              pbSubnetOptionsFromOptionValue6s(pdpool.Options),
+			WhiteClientClassStrategy: pdpool.WhiteClientClassStrategy,
+			WhiteClientClasses:       pdpool.WhiteClientClasses,
+			BlackClientClassStrategy: pdpool.BlackClientClassStrategy,
+			BlackClientClasses:       pdpool.BlackClientClasses,
+		}, nil)
+}
+
 func GetPdPool6sByPrefix(prefix string) ([]*resource.PdPool, error) {
 	if subnet6, err := GetSubnet6ByPrefix(prefix); err != nil {
 		return nil, err
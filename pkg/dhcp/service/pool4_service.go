@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 
 	"github.com/linkingthing/cement/log"
 	pg "github.com/linkingthing/clxone-utils/postgresql"
@@ -35,6 +36,10 @@ func (p *Pool4Service) Create(subnet *resource.Subnet4, pool *resource.Pool4) er
 			return err
 		}
 
+		if err := pool.CheckLifetimes(subnet); err != nil {
+			return err
+		}
+
 		if err := recalculatePool4Capacity(tx, subnet.GetID(), pool); err != nil {
 			return err
 		}
@@ -44,6 +49,11 @@ func (p *Pool4Service) Create(subnet *resource.Subnet4, pool *resource.Pool4) er
 			return util.FormatDbInsertError(errorno.ErrNameDhcpPool, pool.GetID(), err)
 		}
 
+		if err := saveOptionValue4s(tx, resource.OptionScopePool4, pool.GetID(),
+			subnet.GetID(), pool.Options); err != nil {
+			return err
+		}
+
 		if pool.Capacity != 0 {
 			if err := updateResourceCapacity(tx, resource.TableSubnet4, subnet.GetID(),
 				subnet.Capacity+pool.Capacity, errorno.ErrNameNetworkV4); err != nil {
@@ -51,7 +61,7 @@ func (p *Pool4Service) Create(subnet *resource.Subnet4, pool *resource.Pool4) er
 			}
 		}
 
-		return sendCreatePool4CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pool)
+		return sendCreatePool4CmdToDHCPAgent(subnet, pool)
 	})
 }
 
@@ -131,30 +141,78 @@ func recalculatePool4Capacity(tx restdb.Transaction, subnetID string, pool *reso
 	return nil
 }
 
-func sendCreatePool4CmdToDHCPAgent(subnetID uint64, nodes []string, pool *resource.Pool4) error {
-	if len(nodes) == 0 {
+func sendCreatePool4CmdToDHCPAgent(subnet *resource.Subnet4, pool *resource.Pool4) error {
+	if len(subnet.Nodes) == 0 {
 		return nil
 	}
 
-	return kafka.SendDHCPCmdWithNodes(true, nodes, kafka.CreatePool4,
-		pool4ToCreatePool4Request(subnetID, pool), func(nodesForSucceed []string) {
+	return kafka.SendDHCPCmdWithNodes(true, subnet.Nodes, kafka.CreatePool4,
+		pool4ToCreatePool4Request(subnet, pool), func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeletePool4,
-				pool4ToDeletePool4Request(subnetID, pool)); err != nil {
+				pool4ToDeletePool4Request(subnet.SubnetId, pool)); err != nil {
 				log.Errorf("create subnet4 %d pool4 %s failed, rollback nodes %v failed: %s",
-					subnetID, pool.String(), nodesForSucceed, err.Error())
+					subnet.SubnetId, pool.String(), nodesForSucceed, err.Error())
 			}
 		})
 }
 
-func pool4ToCreatePool4Request(subnetID uint64, pool *resource.Pool4) *pbdhcpagent.CreatePool4Request {
+func pool4ToCreatePool4Request(subnet *resource.Subnet4, pool *resource.Pool4) *pbdhcpagent.CreatePool4Request {
+	validLifetime, minValidLifetime, maxValidLifetime := pool.MergedLifetimes(subnet)
 	return &pbdhcpagent.CreatePool4Request{
-		SubnetId:     subnetID,
-		BeginAddress: pool.BeginAddress,
-		EndAddress:   pool.EndAddress,
+		SubnetId:         subnet.SubnetId,
+		BeginAddress:     pool.BeginAddress,
+		EndAddress:       pool.EndAddress,
+		ValidLifetime:    validLifetime,
+		MaxValidLifetime: maxValidLifetime,
+		MinValidLifetime: minValidLifetime,
+		NextServer:       pool.NextServer,
+		PoolOptions: append(pbSubnetOptionsFromOverrides4(pool.Routers, pool.DomainServers,
+			pool.DomainSearchList, pool.Bootfile), pbSubnetOptionsFromOptionValue4s(pool.Options)...),
+		WhiteClientClassStrategy: pool.WhiteClientClassStrategy,
+		WhiteClientClasses:       pool.WhiteClientClasses,
+		BlackClientClassStrategy: pool.BlackClientClassStrategy,
+		BlackClientClasses:       pool.BlackClientClasses,
 	}
 }
 
+func pbSubnetOptionsFromOverrides4(routers, domainServers, domainSearchList []string, bootfile string) []*pbdhcpagent.SubnetOption {
+	var subnetOptions []*pbdhcpagent.SubnetOption
+	if len(routers) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "routers",
+			Code: 3,
+			Data: strings.Join(routers, ","),
+		})
+	}
+
+	if len(domainServers) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "name-servers",
+			Code: 6,
+			Data: strings.Join(domainServers, ","),
+		})
+	}
+
+	if len(bootfile) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "bootfile",
+			Code: 67,
+			Data: bootfile,
+		})
+	}
+
+	if len(domainSearchList) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "domain-search-list",
+			Code: 119,
+			Data: strings.Join(domainSearchList, ","),
+		})
+	}
+
+	return subnetOptions
+}
+
 func pool4ToDeletePool4Request(subnetID uint64, pool *resource.Pool4) *pbdhcpagent.DeletePool4Request {
 	return &pbdhcpagent.DeletePool4Request{
 		SubnetId:     subnetID,
@@ -183,6 +241,12 @@ func listPool4s(subnet *resource.Subnet4, mode ListResourceMode) ([]*resource.Po
 			return
 		}
 
+		if mode == ListResourceModeAPI {
+			if err = setPool4sOptionValues(tx, subnet.GetID(), pools); err != nil {
+				return
+			}
+		}
+
 		if len(subnet.Nodes) == 0 {
 			return
 		}
@@ -214,6 +278,21 @@ func listPool4s(subnet *resource.Subnet4, mode ListResourceMode) ([]*resource.Po
 	return pools, nil
 }
 
+func setPool4sOptionValues(tx restdb.Transaction, subnetId string, pools []*resource.Pool4) error {
+	valuesMap, err := getOptionValue4sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet4: subnetId,
+		resource.SqlColumnScope:   resource.OptionScopePool4})
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		pool.Options = valuesMap[pool.GetID()]
+	}
+
+	return nil
+}
+
 func getPool4sWithCondition(tx restdb.Transaction, condition map[string]interface{}) ([]*resource.Pool4, error) {
 	var pools []*resource.Pool4
 	if err := tx.Fill(condition, &pools); err != nil {
@@ -285,6 +364,12 @@ func (p *Pool4Service) Get(subnet *resource.Subnet4, poolID string) (*resource.P
 			return errorno.ErrNotFound(errorno.ErrNameDhcpPool, poolID)
 		}
 
+		if pools[0].Options, err = getOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopePool4,
+			resource.SqlColumnScopeId: poolID}); err != nil {
+			return
+		}
+
 		if len(subnet.Nodes) != 0 {
 			reservations, err = getReservation4sWithBeginAndEndIp(tx, subnet.GetID(),
 				pools[0].BeginIp, pools[0].EndIp)
@@ -367,6 +452,12 @@ func (p *Pool4Service) Delete(subnet *resource.Subnet4, pool *resource.Pool4) er
 				pg.Error(err).Error())
 		}
 
+		if err := deleteOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopePool4,
+			resource.SqlColumnScopeId: pool.GetID()}); err != nil {
+			return err
+		}
+
 		if pool.Capacity != 0 {
 			if err := updateResourceCapacity(tx, resource.TableSubnet4, subnet.GetID(),
 				subnet.Capacity-pool.Capacity, errorno.ErrNameNetworkV4); err != nil {
@@ -434,20 +525,81 @@ func (p *Pool4Service) Update(subnetId string, pool *resource.Pool4) error {
 		return err
 	}
 
+	if err := pool.ValidateOptions(); err != nil {
+		return err
+	}
+
+	if err := pool.ValidateClientClasses(); err != nil {
+		return err
+	}
+
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if rows, err := tx.Update(resource.TablePool4, map[string]interface{}{
-			resource.SqlColumnComment: pool.Comment,
+		subnet, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := setPool4FromDB(tx, pool); err != nil {
+			return err
+		}
+
+		if err := pool.CheckLifetimes(subnet); err != nil {
+			return err
+		}
+
+		if _, err := tx.Update(resource.TablePool4, map[string]interface{}{
+			resource.SqlColumnComment:                  pool.Comment,
+			resource.SqlColumnValidLifetime:            pool.ValidLifetime,
+			resource.SqlColumnMaxValidLifetime:         pool.MaxValidLifetime,
+			resource.SqlColumnMinValidLifetime:         pool.MinValidLifetime,
+			resource.SqlColumnRouters:                  pool.Routers,
+			resource.SqlColumnDomainServers:            pool.DomainServers,
+			resource.SqlColumnNextServer:               pool.NextServer,
+			resource.SqlColumnBootfile:                 pool.Bootfile,
+			resource.SqlColumnDomainSearchList:         pool.DomainSearchList,
+			resource.SqlColumnWhiteClientClassStrategy: pool.WhiteClientClassStrategy,
+			resource.SqlColumnWhiteClientClasses:       pool.WhiteClientClasses,
+			resource.SqlColumnBlackClientClassStrategy: pool.BlackClientClassStrategy,
+			resource.SqlColumnBlackClientClasses:       pool.BlackClientClasses,
 		}, map[string]interface{}{restdb.IDField: pool.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, pool.GetID(),
 				pg.Error(err).Error())
-		} else if rows == 0 {
-			return errorno.ErrNotFound(errorno.ErrNameDhcpPool, pool.GetID())
 		}
 
-		return nil
+		if err := saveOptionValue4s(tx, resource.OptionScopePool4, pool.GetID(),
+			subnetId, pool.Options); err != nil {
+			return err
+		}
+
+		return sendUpdatePool4CmdToDHCPAgent(subnet, pool)
 	})
 }
 
+func sendUpdatePool4CmdToDHCPAgent(subnet *resource.Subnet4, pool *resource.Pool4) error {
+	if len(subnet.Nodes) == 0 {
+		return nil
+	}
+
+	validLifetime, minValidLifetime, maxValidLifetime := pool.MergedLifetimes(subnet)
+	return kafka.SendDHCPCmdWithNodes(true, subnet.Nodes, kafka.UpdatePool4,
+		&pbdhcpagent.UpdatePool4Request{
+			SubnetId:         subnet.SubnetId,
+			BeginAddress:     pool.BeginAddress,
+			EndAddress:       pool.EndAddress,
+			ValidLifetime:    validLifetime,
+			MaxValidLifetime: maxValidLifetime,
+			MinValidLifetime: minValidLifetime,
+			NextServer:       pool.NextServer,
+			PoolOptions: append(pbSubnetOptionsFromOverrides4(pool.Routers, pool.DomainServers,
+				pool.DomainSearchList, pool.Bootfile), pbSubnetOptionsFromOptionValue4s(pool.Options)...),
+
+			WhiteClientClassStrategy: pool.WhiteClientClassStrategy,
+			WhiteClientClasses:       pool.WhiteClientClasses,
+			BlackClientClassStrategy: pool.BlackClientClassStrategy,
+			BlackClientClasses:       pool.BlackClientClasses,
+		}, nil)
+}
+
 func (p *Pool4Service) ActionValidTemplate(subnet *resource.Subnet4, pool *resource.Pool4, templateInfo *resource.TemplateInfo) (*resource.TemplatePool, error) {
 	pool.Template = templateInfo.Template
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
@@ -468,3 +620,7 @@ func GetPool4sByPrefix(prefix string) ([]*resource.Pool4, error) {
 		return listPool4s(subnet4, ListResourceModeGRPC)
 	}
 }
+
+func (p *Pool4Service) EffectiveConfig(subnetId, poolId string) (*resource.EffectiveConfig, error) {
+	return getEffectiveConfig4(subnetId, poolId, "")
+}
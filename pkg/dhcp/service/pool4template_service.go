@@ -79,6 +79,11 @@ func (p *Pool4TemplateService) Update(template *resource.Pool4Template) error {
 
 func (p *Pool4TemplateService) Delete(id string) error {
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkPoolTemplateUsedBySubnetTemplate(tx, resource.TableSubnet4Template,
+			id); err != nil {
+			return err
+		}
+
 		if rows, err := tx.Delete(resource.TablePool4Template, map[string]interface{}{
 			restdb.IDField: id}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
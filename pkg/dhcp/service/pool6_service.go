@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"strings"
 
 	"github.com/linkingthing/cement/log"
 	pg "github.com/linkingthing/clxone-utils/postgresql"
@@ -36,6 +37,10 @@ func (p *Pool6Service) Create(subnet *resource.Subnet6, pool *resource.Pool6) er
 			return err
 		}
 
+		if err := pool.CheckLifetimes(subnet); err != nil {
+			return err
+		}
+
 		if err := recalculatePool6Capacity(tx, subnet.GetID(), pool); err != nil {
 			return err
 		}
@@ -46,6 +51,11 @@ func (p *Pool6Service) Create(subnet *resource.Subnet6, pool *resource.Pool6) er
 				string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
 		}
 
+		if err := saveOptionValue6s(tx, resource.OptionScopePool6, pool.GetID(),
+			subnet.GetID(), pool.Options); err != nil {
+			return err
+		}
+
 		if !resource.IsCapacityZero(pool.Capacity) {
 			if err := updateResourceCapacity(tx, resource.TableSubnet6,
 				subnet.GetID(), subnet.AddCapacityWithString(pool.Capacity),
@@ -54,7 +64,7 @@ func (p *Pool6Service) Create(subnet *resource.Subnet6, pool *resource.Pool6) er
 			}
 		}
 
-		return sendCreatePool6CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pool)
+		return sendCreatePool6CmdToDHCPAgent(subnet, pool)
 	})
 }
 
@@ -171,30 +181,63 @@ func recalculatePool6CapacityWithReservedPools(pool *resource.Pool6, reservedPoo
 	}
 }
 
-func sendCreatePool6CmdToDHCPAgent(subnetID uint64, nodes []string, pool *resource.Pool6) error {
-	if len(nodes) == 0 {
+func sendCreatePool6CmdToDHCPAgent(subnet *resource.Subnet6, pool *resource.Pool6) error {
+	if len(subnet.Nodes) == 0 {
 		return nil
 	}
 
-	return kafka.SendDHCPCmdWithNodes(false, nodes, kafka.CreatePool6,
-		pool6ToCreatePool6Request(subnetID, pool), func(nodesForSucceed []string) {
+	return kafka.SendDHCPCmdWithNodes(false, subnet.Nodes, kafka.CreatePool6,
+		pool6ToCreatePool6Request(subnet, pool), func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeletePool6,
-				pool6ToDeletePool6Request(subnetID, pool)); err != nil {
+				pool6ToDeletePool6Request(subnet.SubnetId, pool)); err != nil {
 				log.Errorf("create subnet6 %d pool6 %s failed, rollback %v failed: %s",
-					subnetID, pool.String(), nodesForSucceed, err.Error())
+					subnet.SubnetId, pool.String(), nodesForSucceed, err.Error())
 			}
 		})
 }
 
-func pool6ToCreatePool6Request(subnetID uint64, pool *resource.Pool6) *pbdhcpagent.CreatePool6Request {
+func pool6ToCreatePool6Request(subnet *resource.Subnet6, pool *resource.Pool6) *pbdhcpagent.CreatePool6Request {
+	validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime :=
+		pool.MergedLifetimes(subnet)
 	return &pbdhcpagent.CreatePool6Request{
-		SubnetId:     subnetID,
-		BeginAddress: pool.BeginAddress,
-		EndAddress:   pool.EndAddress,
+		SubnetId:          subnet.SubnetId,
+		BeginAddress:      pool.BeginAddress,
+		EndAddress:        pool.EndAddress,
+		ValidLifetime:     validLifetime,
+		MaxValidLifetime:  maxValidLifetime,
+		MinValidLifetime:  minValidLifetime,
+		PreferredLifetime: preferredLifetime,
+		PoolOptions: append(pbSubnetOptionsFromOverrides6(pool.DomainServers,
+			pool.DomainSearchList), pbSubnetOptionsFromOptionValue6s(pool.Options)...),
+		WhiteClientClassStrategy: pool.WhiteClientClassStrategy,
+		WhiteClientClasses:       pool.WhiteClientClasses,
+		BlackClientClassStrategy: pool.BlackClientClassStrategy,
+		BlackClientClasses:       pool.BlackClientClasses,
 	}
 }
 
+func pbSubnetOptionsFromOverrides6(domainServers, domainSearchList []string) []*pbdhcpagent.SubnetOption {
+	var subnetOptions []*pbdhcpagent.SubnetOption
+	if len(domainServers) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "name-servers",
+			Code: 23,
+			Data: strings.Join(domainServers, ","),
+		})
+	}
+
+	if len(domainSearchList) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "domain-search-list",
+			Code: 24,
+			Data: strings.Join(domainSearchList, ","),
+		})
+	}
+
+	return subnetOptions
+}
+
 func pool6ToDeletePool6Request(subnetID uint64, pool *resource.Pool6) *pbdhcpagent.DeletePool6Request {
 	return &pbdhcpagent.DeletePool6Request{
 		SubnetId:     subnetID,
@@ -223,6 +266,12 @@ func listPool6s(subnet *resource.Subnet6, mode ListResourceMode) ([]*resource.Po
 			return
 		}
 
+		if mode == ListResourceModeAPI {
+			if err = setPool6sOptionValues(tx, subnet.GetID(), pools); err != nil {
+				return
+			}
+		}
+
 		if len(subnet.Nodes) != 0 {
 			reservations, err = getReservation6sWithIpsExists(tx, subnet.GetID())
 		}
@@ -242,6 +291,21 @@ func listPool6s(subnet *resource.Subnet6, mode ListResourceMode) ([]*resource.Po
 	return pools, nil
 }
 
+func setPool6sOptionValues(tx restdb.Transaction, subnetId string, pools []*resource.Pool6) error {
+	valuesMap, err := getOptionValue6sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnetId,
+		resource.SqlColumnScope:   resource.OptionScopePool6})
+	if err != nil {
+		return err
+	}
+
+	for _, pool := range pools {
+		pool.Options = valuesMap[pool.GetID()]
+	}
+
+	return nil
+}
+
 func getPool6sWithCondition(tx restdb.Transaction, condition map[string]interface{}) ([]*resource.Pool6, error) {
 	var pools []*resource.Pool6
 	if err := tx.Fill(condition, &pools); err != nil {
@@ -314,6 +378,12 @@ func (p *Pool6Service) Get(subnet *resource.Subnet6, poolID string) (*resource.P
 			return errorno.ErrNotFound(errorno.ErrNameDhcpPool, poolID)
 		}
 
+		if pools[0].Options, err = getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopePool6,
+			resource.SqlColumnScopeId: poolID}); err != nil {
+			return err
+		}
+
 		if len(subnet.Nodes) != 0 {
 			reservations, err = getReservation6sWithIpsExists(tx, subnet.GetID())
 		}
@@ -386,6 +456,12 @@ func (p *Pool6Service) Delete(subnet *resource.Subnet6, pool *resource.Pool6) er
 				pg.Error(err).Error())
 		}
 
+		if err := deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopePool6,
+			resource.SqlColumnScopeId: pool.GetID()}); err != nil {
+			return err
+		}
+
 		if !resource.IsCapacityZero(pool.Capacity) {
 			if err := updateResourceCapacity(tx, resource.TableSubnet6,
 				subnet.GetID(), subnet.SubCapacityWithString(pool.Capacity),
@@ -453,19 +529,79 @@ func (p *Pool6Service) Update(subnetId string, pool *resource.Pool6) error {
 		return err
 	}
 
+	if err := pool.ValidateOptions(); err != nil {
+		return err
+	}
+
+	if err := pool.ValidateClientClasses(); err != nil {
+		return err
+	}
+
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if rows, err := tx.Update(resource.TablePool6, map[string]interface{}{
-			resource.SqlColumnComment: pool.Comment,
+		subnet, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := setPool6FromDB(tx, pool); err != nil {
+			return err
+		}
+
+		if err := pool.CheckLifetimes(subnet); err != nil {
+			return err
+		}
+
+		if _, err := tx.Update(resource.TablePool6, map[string]interface{}{
+			resource.SqlColumnComment:                  pool.Comment,
+			resource.SqlColumnValidLifetime:            pool.ValidLifetime,
+			resource.SqlColumnMaxValidLifetime:         pool.MaxValidLifetime,
+			resource.SqlColumnMinValidLifetime:         pool.MinValidLifetime,
+			resource.SqlColumnPreferredLifetime:        pool.PreferredLifetime,
+			resource.SqlColumnDomainServers:            pool.DomainServers,
+			resource.SqlColumnDomainSearchList:         pool.DomainSearchList,
+			resource.SqlColumnWhiteClientClassStrategy: pool.WhiteClientClassStrategy,
+			resource.SqlColumnWhiteClientClasses:       pool.WhiteClientClasses,
+			resource.SqlColumnBlackClientClassStrategy: pool.BlackClientClassStrategy,
+			resource.SqlColumnBlackClientClasses:       pool.BlackClientClasses,
 		}, map[string]interface{}{restdb.IDField: pool.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, pool.GetID(),
 				pg.Error(err).Error())
-		} else if rows == 0 {
-			return errorno.ErrNotFound(errorno.ErrNameDhcpPool, pool.GetID())
 		}
-		return nil
+
+		if err := saveOptionValue6s(tx, resource.OptionScopePool6, pool.GetID(),
+			subnetId, pool.Options); err != nil {
+			return err
+		}
+
+		return sendUpdatePool6CmdToDHCPAgent(subnet, pool)
 	})
 }
 
+func sendUpdatePool6CmdToDHCPAgent(subnet *resource.Subnet6, pool *resource.Pool6) error {
+	if len(subnet.Nodes) == 0 {
+		return nil
+	}
+
+	validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime :=
+		pool.MergedLifetimes(subnet)
+	return kafka.SendDHCPCmdWithNodes(false, subnet.Nodes, kafka.UpdatePool6,
+		&pbdhcpagent.UpdatePool6Request{
+			SubnetId:          subnet.SubnetId,
+			BeginAddress:      pool.BeginAddress,
+			EndAddress:        pool.EndAddress,
+			ValidLifetime:     validLifetime,
+			MaxValidLifetime:  maxValidLifetime,
+			MinValidLifetime:  minValidLifetime,
+			PreferredLifetime: preferredLifetime,
+			PoolOptions: append(pbSubnetOptionsFromOverrides6(pool.DomainServers,
+				pool.DomainSearchList), pbSubnetOptionsFromOptionValue6s(pool.Options)...),
+			WhiteClientClassStrategy: pool.WhiteClientClassStrategy,
+			WhiteClientClasses:       pool.WhiteClientClasses,
+			BlackClientClassStrategy: pool.BlackClientClassStrategy,
+			BlackClientClasses:       pool.BlackClientClasses,
+		}, nil)
+}
+
 func (p *Pool6Service) ActionValidTemplate(subnet *resource.Subnet6, pool *resource.Pool6, templateInfo *resource.TemplateInfo) (*resource.TemplatePool, error) {
 	pool.Template = templateInfo.Template
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
@@ -486,3 +622,7 @@ func GetPool6sByPrefix(prefix string) ([]*resource.Pool6, error) {
 		return listPool6s(subnet6, ListResourceModeGRPC)
 	}
 }
+
+func (p *Pool6Service) EffectiveConfig(subnetId, poolId string) (*resource.EffectiveConfig, error) {
+	return getEffectiveConfig6(subnetId, poolId, "")
+}
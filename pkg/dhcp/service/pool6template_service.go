@@ -79,6 +79,11 @@ func (p *Pool6TemplateService) Update(template *resource.Pool6Template) error {
 
 func (p *Pool6TemplateService) Delete(id string) error {
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkPoolTemplateUsedBySubnetTemplate(tx, resource.TableSubnet6Template,
+			id); err != nil {
+			return err
+		}
+
 		if rows, err := tx.Delete(resource.TablePool6Template,
 			map[string]interface{}{restdb.IDField: id}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
@@ -56,6 +56,10 @@ func createReservation4(tx restdb.Transaction, subnet *resource.Subnet4, reserva
 		return err
 	}
 
+	if err := reservation.CheckLifetimes(subnet); err != nil {
+		return err
+	}
+
 	if err := updateSubnet4OrPool4CapacityWithReservation4(tx, subnet,
 		reservation, true); err != nil {
 		return err
@@ -67,7 +71,12 @@ func createReservation4(tx restdb.Transaction, subnet *resource.Subnet4, reserva
 			string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 	}
 
-	return sendCreateReservation4CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, reservation)
+	if err := saveOptionValue4s(tx, resource.OptionScopeReservation4, reservation.GetID(),
+		subnet.GetID(), reservation.Options); err != nil {
+		return err
+	}
+
+	return sendCreateReservation4CmdToDHCPAgent(subnet, reservation)
 }
 
 func checkReservation4CouldBeCreated(tx restdb.Transaction, subnet *resource.Subnet4, reservation *resource.Reservation4) error {
@@ -160,29 +169,37 @@ func updateResourceCapacity(tx restdb.Transaction, resourceType restdb.ResourceT
 	return nil
 }
 
-func sendCreateReservation4CmdToDHCPAgent(subnetID uint64, nodes []string, reservation *resource.Reservation4) error {
-	if len(nodes) == 0 {
+func sendCreateReservation4CmdToDHCPAgent(subnet *resource.Subnet4, reservation *resource.Reservation4) error {
+	if len(subnet.Nodes) == 0 {
 		return nil
 	}
 
-	return kafka.SendDHCPCmdWithNodes(true, nodes, kafka.CreateReservation4,
-		reservation4ToCreateReservation4Request(subnetID, reservation),
+	return kafka.SendDHCPCmdWithNodes(true, subnet.Nodes, kafka.CreateReservation4,
+		reservation4ToCreateReservation4Request(subnet, reservation),
 		func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeleteReservation4,
-				reservation4ToDeleteReservation4Request(subnetID, reservation)); err != nil {
+				reservation4ToDeleteReservation4Request(subnet.SubnetId, reservation)); err != nil {
 				log.Errorf("create subnet4 %d reservation4 %s failed, rollback %v failed: %s",
-					subnetID, reservation.String(), nodesForSucceed, err.Error())
+					subnet.SubnetId, reservation.String(), nodesForSucceed, err.Error())
 			}
 		})
 }
 
-func reservation4ToCreateReservation4Request(subnetID uint64, reservation *resource.Reservation4) *pbdhcpagent.CreateReservation4Request {
+func reservation4ToCreateReservation4Request(subnet *resource.Subnet4, reservation *resource.Reservation4) *pbdhcpagent.CreateReservation4Request {
+	validLifetime, minValidLifetime, maxValidLifetime := reservation.MergedLifetimes(subnet)
 	return &pbdhcpagent.CreateReservation4Request{
-		SubnetId:  subnetID,
-		HwAddress: reservation.HwAddress,
-		Hostname:  reservation.Hostname,
-		IpAddress: reservation.IpAddress,
+		SubnetId:         subnet.SubnetId,
+		HwAddress:        reservation.HwAddress,
+		Hostname:         reservation.Hostname,
+		IpAddress:        reservation.IpAddress,
+		ValidLifetime:    validLifetime,
+		MaxValidLifetime: maxValidLifetime,
+		MinValidLifetime: minValidLifetime,
+		NextServer:       reservation.NextServer,
+		ReservationOptions: append(pbSubnetOptionsFromOverrides4(reservation.Routers,
+			reservation.DomainServers, reservation.DomainSearchList, reservation.Bootfile),
+			pbSubnetOptionsFromOptionValue4s(reservation.Options)...),
 	}
 }
 
@@ -208,11 +225,14 @@ func listReservation4s(subnet *resource.Subnet4, mode ListResourceMode) ([]*reso
 			}
 		}
 
-		reservations, err = getReservation4sWithCondition(tx, map[string]interface{}{
+		if reservations, err = getReservation4sWithCondition(tx, map[string]interface{}{
 			resource.SqlColumnSubnet4: subnet.GetID(),
 			resource.SqlOrderBy:       resource.SqlColumnIp,
-		})
-		return
+		}); err != nil || mode != ListResourceModeAPI {
+			return
+		}
+
+		return setReservation4sOptionValues(tx, subnet.GetID(), reservations)
 	}); err != nil {
 		return nil, err
 	}
@@ -227,6 +247,21 @@ func listReservation4s(subnet *resource.Subnet4, mode ListResourceMode) ([]*reso
 	return reservations, nil
 }
 
+func setReservation4sOptionValues(tx restdb.Transaction, subnetId string, reservations []*resource.Reservation4) error {
+	valuesMap, err := getOptionValue4sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet4: subnetId,
+		resource.SqlColumnScope:   resource.OptionScopeReservation4})
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		reservation.Options = valuesMap[reservation.GetID()]
+	}
+
+	return nil
+}
+
 func getReservation4sWithCondition(tx restdb.Transaction, condition map[string]interface{}) ([]*resource.Reservation4, error) {
 	var reservations []*resource.Reservation4
 	if err := tx.Fill(condition, &reservations); err != nil {
@@ -291,9 +326,15 @@ func (r *Reservation4Service) Get(subnet *resource.Subnet4, reservationID string
 			return
 		}
 
-		reservations, err = getReservation4sWithCondition(tx, map[string]interface{}{
+		if reservations, err = getReservation4sWithCondition(tx, map[string]interface{}{
 			restdb.IDField: reservationID,
-		})
+		}); err != nil || len(reservations) == 0 {
+			return
+		}
+
+		reservations[0].Options, err = getOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeReservation4,
+			resource.SqlColumnScopeId: reservationID})
 		return
 	}); err != nil {
 		return nil, err
@@ -398,6 +439,12 @@ func deleteReservation4(tx restdb.Transaction, subnet *resource.Subnet4, reserva
 			pg.Error(err).Error())
 	}
 
+	if err := deleteOptionValue4s(tx, map[string]interface{}{
+		resource.SqlColumnScope:   resource.OptionScopeReservation4,
+		resource.SqlColumnScopeId: reservation.GetID()}); err != nil {
+		return err
+	}
+
 	return sendDeleteReservation4CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, reservation)
 }
 
@@ -415,20 +462,70 @@ func (r *Reservation4Service) Update(subnetId string, reservation *resource.Rese
 		return err
 	}
 
+	if err := reservation.ValidateOptions(); err != nil {
+		return err
+	}
+
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if rows, err := tx.Update(resource.TableReservation4, map[string]interface{}{
-			resource.SqlColumnComment: reservation.Comment,
+		subnet, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := setReservation4FromDB(tx, reservation); err != nil {
+			return err
+		}
+
+		if err := reservation.CheckLifetimes(subnet); err != nil {
+			return err
+		}
+
+		if _, err := tx.Update(resource.TableReservation4, map[string]interface{}{
+			resource.SqlColumnComment:          reservation.Comment,
+			resource.SqlColumnValidLifetime:    reservation.ValidLifetime,
+			resource.SqlColumnMaxValidLifetime: reservation.MaxValidLifetime,
+			resource.SqlColumnMinValidLifetime: reservation.MinValidLifetime,
+			resource.SqlColumnRouters:          reservation.Routers,
+			resource.SqlColumnDomainServers:    reservation.DomainServers,
+			resource.SqlColumnNextServer:       reservation.NextServer,
+			resource.SqlColumnBootfile:         reservation.Bootfile,
+			resource.SqlColumnDomainSearchList: reservation.DomainSearchList,
 		}, map[string]interface{}{restdb.IDField: reservation.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, reservation.GetID(),
 				pg.Error(err).Error())
-		} else if rows == 0 {
-			return errorno.ErrNotFound(errorno.ErrNameDhcpReservation, reservation.GetID())
 		}
 
-		return nil
+		if err := saveOptionValue4s(tx, resource.OptionScopeReservation4, reservation.GetID(),
+			subnetId, reservation.Options); err != nil {
+			return err
+		}
+
+		return sendUpdateReservation4CmdToDHCPAgent(subnet, reservation)
 	})
 }
 
+func sendUpdateReservation4CmdToDHCPAgent(subnet *resource.Subnet4, reservation *resource.Reservation4) error {
+	if len(subnet.Nodes) == 0 {
+		return nil
+	}
+
+	validLifetime, minValidLifetime, maxValidLifetime := reservation.MergedLifetimes(subnet)
+	return kafka.SendDHCPCmdWithNodes(true, subnet.Nodes, kafka.UpdateReservation4,
+		&pbdhcpagent.UpdateReservation4Request{
+			SubnetId:         subnet.SubnetId,
+			HwAddress:        reservation.HwAddress,
+			Hostname:         reservation.Hostname,
+			IpAddress:        reservation.IpAddress,
+			ValidLifetime:    validLifetime,
+			MaxValidLifetime: maxValidLifetime,
+			MinValidLifetime: minValidLifetime,
+			NextServer:       reservation.NextServer,
+			ReservationOptions: append(pbSubnetOptionsFromOverrides4(reservation.Routers,
+				reservation.DomainServers, reservation.DomainSearchList, reservation.Bootfile),
+				pbSubnetOptionsFromOptionValue4s(reservation.Options)...),
+		}, nil)
+}
+
 func GetReservation4sByPrefix(prefix string) ([]*resource.Reservation4, error) {
 	if subnet4, err := GetSubnet4ByPrefix(prefix); err != nil {
 		return nil, err
@@ -478,6 +575,10 @@ func batchCreateReservation4s(tx restdb.Transaction, subnet *resource.Subnet4, r
 				return errorno.ErrNotBelongTo(errorno.ErrNameDhcpReservation,
 					errorno.ErrNameNetworkV4, reservation.IpAddress, subnet.Subnet)
 			}
+
+			if err := reservation.CheckLifetimes(subnet); err != nil {
+				return err
+			}
 		}
 
 		if err := checkReservation4IpConflictWithReservedPool4s(reservation,
@@ -520,7 +621,7 @@ func batchCreateReservation4s(tx restdb.Transaction, subnet *resource.Subnet4, r
 			string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 	}
 
-	return sendCreateReservation4sCmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, validReservations)
+	return sendCreateReservation4sCmdToDHCPAgent(subnet, validReservations)
 }
 
 func getReservedPool4sWithSubnetId(tx restdb.Transaction, subnetId string) ([]*resource.ReservedPool4, error) {
@@ -587,31 +688,31 @@ func updateSubnet4AndPool4sCapacity(tx restdb.Transaction, subnet *resource.Subn
 	return batchUpdatePool4sCapacity(tx, poolsCapacity)
 }
 
-func sendCreateReservation4sCmdToDHCPAgent(subnetID uint64, nodes []string, reservations []*resource.Reservation4) error {
-	if len(nodes) == 0 || len(reservations) == 0 {
+func sendCreateReservation4sCmdToDHCPAgent(subnet *resource.Subnet4, reservations []*resource.Reservation4) error {
+	if len(subnet.Nodes) == 0 || len(reservations) == 0 {
 		return nil
 	}
 
-	return kafka.SendDHCPCmdWithNodes(true, nodes, kafka.CreateReservation4s,
-		reservation4sToCreateReservations4Request(subnetID, reservations),
+	return kafka.SendDHCPCmdWithNodes(true, subnet.Nodes, kafka.CreateReservation4s,
+		reservation4sToCreateReservations4Request(subnet, reservations),
 		func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeleteReservation4s,
-				reservation4sToDeleteReservations4Request(subnetID, reservations)); err != nil {
+				reservation4sToDeleteReservations4Request(subnet.SubnetId, reservations)); err != nil {
 				log.Errorf("create subnet4 %d reservation4 %s failed, rollback %v failed: %s",
-					subnetID, reservations[0].String(), nodesForSucceed, err.Error())
+					subnet.SubnetId, reservations[0].String(), nodesForSucceed, err.Error())
 			}
 		})
 }
 
-func reservation4sToCreateReservations4Request(subnetID uint64, reservations []*resource.Reservation4) *pbdhcpagent.CreateReservations4Request {
+func reservation4sToCreateReservations4Request(subnet *resource.Subnet4, reservations []*resource.Reservation4) *pbdhcpagent.CreateReservations4Request {
 	pbReservations := make([]*pbdhcpagent.CreateReservation4Request, len(reservations))
 	for i, reservation := range reservations {
-		pbReservations[i] = reservation4ToCreateReservation4Request(subnetID, reservation)
+		pbReservations[i] = reservation4ToCreateReservation4Request(subnet, reservation)
 	}
 
 	return &pbdhcpagent.CreateReservations4Request{
-		SubnetId:     subnetID,
+		SubnetId:     subnet.SubnetId,
 		Reservations: pbReservations,
 	}
 }
@@ -680,6 +781,13 @@ func (s *Reservation4Service) BatchDeleteReservation4s(subnetId string, ids []st
 				string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 		}
 
+		if err = deleteOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeReservation4,
+			resource.SqlColumnScopeId: restdb.FillValue{
+				Operator: restdb.OperatorAny, Value: ids}}); err != nil {
+			return err
+		}
+
 		return sendDeleteReservation4sCmdToDHCPAgent(subnet.SubnetId, subnet.Nodes,
 			reservations)
 	})
@@ -899,3 +1007,7 @@ func createReservationsFromDynamicLeases(v4Map map[string][]*resource.Reservatio
 		return nil
 	})
 }
+
+func (r *Reservation4Service) EffectiveConfig(subnetId, reservationId string) (*resource.EffectiveConfig, error) {
+	return getEffectiveConfig4(subnetId, "", reservationId)
+}
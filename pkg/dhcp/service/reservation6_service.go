@@ -43,6 +43,10 @@ func createReservation6(tx restdb.Transaction, subnet *resource.Subnet6, reserva
 		return err
 	}
 
+	if err := reservation.CheckLifetimes(subnet); err != nil {
+		return err
+	}
+
 	if err := updateSubnet6AndPoolsCapacityWithReservation6(tx, subnet,
 		reservation, true); err != nil {
 		return err
@@ -54,7 +58,12 @@ func createReservation6(tx restdb.Transaction, subnet *resource.Subnet6, reserva
 			string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 	}
 
-	return sendCreateReservation6CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, reservation)
+	if err := saveOptionValue6s(tx, resource.OptionScopeReservation6, reservation.GetID(),
+		subnet.GetID(), reservation.Options); err != nil {
+		return err
+	}
+
+	return sendCreateReservation6CmdToDHCPAgent(subnet, reservation)
 }
 
 func checkReservation6CouldBeCreated(tx restdb.Transaction, subnet *resource.Subnet6, reservation *resource.Reservation6) error {
@@ -365,31 +374,39 @@ func updateSubnet6AndPoolsCapacity(tx restdb.Transaction, subnet *resource.Subne
 	return batchUpdateResource6sCapacity(tx, resource.TablePdPool, pdpoolsCapacity)
 }
 
-func sendCreateReservation6CmdToDHCPAgent(subnetID uint64, nodes []string, reservation *resource.Reservation6) error {
-	if len(nodes) == 0 {
+func sendCreateReservation6CmdToDHCPAgent(subnet *resource.Subnet6, reservation *resource.Reservation6) error {
+	if len(subnet.Nodes) == 0 {
 		return nil
 	}
 
-	return kafka.SendDHCPCmdWithNodes(false, nodes, kafka.CreateReservation6,
-		reservation6ToCreateReservation6Request(subnetID, reservation),
+	return kafka.SendDHCPCmdWithNodes(false, subnet.Nodes, kafka.CreateReservation6,
+		reservation6ToCreateReservation6Request(subnet, reservation),
 		func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeleteReservation6,
-				reservation6ToDeleteReservation6Request(subnetID, reservation)); err != nil {
+				reservation6ToDeleteReservation6Request(subnet.SubnetId, reservation)); err != nil {
 				log.Errorf("create subnet6 %d reservation6 %s failed, rollback %v failed: %s",
-					subnetID, reservation.String(), nodesForSucceed, err.Error())
+					subnet.SubnetId, reservation.String(), nodesForSucceed, err.Error())
 			}
 		})
 }
 
-func reservation6ToCreateReservation6Request(subnetID uint64, reservation *resource.Reservation6) *pbdhcpagent.CreateReservation6Request {
+func reservation6ToCreateReservation6Request(subnet *resource.Subnet6, reservation *resource.Reservation6) *pbdhcpagent.CreateReservation6Request {
+	validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime :=
+		reservation.MergedLifetimes(subnet)
 	return &pbdhcpagent.CreateReservation6Request{
-		SubnetId:    subnetID,
-		HwAddress:   reservation.HwAddress,
-		Duid:        reservation.Duid,
-		Hostname:    reservation.Hostname,
-		IpAddresses: reservation.IpAddresses,
-		Prefixes:    reservation.Prefixes,
+		SubnetId:          subnet.SubnetId,
+		HwAddress:         reservation.HwAddress,
+		Duid:              reservation.Duid,
+		Hostname:          reservation.Hostname,
+		IpAddresses:       reservation.IpAddresses,
+		Prefixes:          reservation.Prefixes,
+		ValidLifetime:     validLifetime,
+		MaxValidLifetime:  maxValidLifetime,
+		MinValidLifetime:  minValidLifetime,
+		PreferredLifetime: preferredLifetime,
+		ReservationOptions: append(pbSubnetOptionsFromOverrides6(reservation.DomainServers,
+			reservation.DomainSearchList), pbSubnetOptionsFromOptionValue6s(reservation.Options)...),
 	}
 }
 
@@ -417,12 +434,15 @@ func listReservation6s(subnet *resource.Subnet6, mode ListResourceMode) ([]*reso
 			}
 		}
 
-		reservations, err = getReservation6sWithCondition(tx,
+		if reservations, err = getReservation6sWithCondition(tx,
 			map[string]interface{}{
 				resource.SqlColumnSubnet6: subnet.GetID(),
 				resource.SqlOrderBy:       "ips, ipnets",
-			})
-		return
+			}); err != nil || mode != ListResourceModeAPI {
+			return
+		}
+
+		return setReservation6sOptionValues(tx, subnet.GetID(), reservations)
 	}); err != nil {
 		return nil, err
 	}
@@ -437,6 +457,21 @@ func listReservation6s(subnet *resource.Subnet6, mode ListResourceMode) ([]*reso
 	return reservations, nil
 }
 
+func setReservation6sOptionValues(tx restdb.Transaction, subnetId string, reservations []*resource.Reservation6) error {
+	valuesMap, err := getOptionValue6sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnetId,
+		resource.SqlColumnScope:   resource.OptionScopeReservation6})
+	if err != nil {
+		return err
+	}
+
+	for _, reservation := range reservations {
+		reservation.Options = valuesMap[reservation.GetID()]
+	}
+
+	return nil
+}
+
 func getReservation6sLeasesCount(subnetId uint64, reservations []*resource.Reservation6) map[string]uint64 {
 	resp, err := getSubnet6Leases(subnetId)
 	if err != nil {
@@ -498,8 +533,14 @@ func (r *Reservation6Service) Get(subnet *resource.Subnet6, reservationID string
 			return
 		}
 
-		reservations, err = getReservation6sWithCondition(tx, map[string]interface{}{
-			restdb.IDField: reservationID})
+		if reservations, err = getReservation6sWithCondition(tx, map[string]interface{}{
+			restdb.IDField: reservationID}); err != nil || len(reservations) == 0 {
+			return
+		}
+
+		reservations[0].Options, err = getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeReservation6,
+			resource.SqlColumnScopeId: reservationID})
 		return
 	}); err != nil {
 		return nil, err
@@ -609,6 +650,12 @@ func deleteReservation6(tx restdb.Transaction, subnet *resource.Subnet6, reserva
 			pg.Error(err).Error())
 	}
 
+	if err := deleteOptionValue6s(tx, map[string]interface{}{
+		resource.SqlColumnScope:   resource.OptionScopeReservation6,
+		resource.SqlColumnScopeId: reservation.GetID()}); err != nil {
+		return err
+	}
+
 	return sendDeleteReservation6CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes,
 		reservation)
 }
@@ -627,20 +674,70 @@ func (r *Reservation6Service) Update(subnetId string, reservation *resource.Rese
 		return err
 	}
 
+	if err := reservation.ValidateOptions(); err != nil {
+		return err
+	}
+
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if rows, err := tx.Update(resource.TableReservation6,
-			map[string]interface{}{resource.SqlColumnComment: reservation.Comment},
-			map[string]interface{}{restdb.IDField: reservation.GetID()}); err != nil {
+		subnet, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := setReservation6FromDB(tx, reservation); err != nil {
+			return err
+		}
+
+		if err := reservation.CheckLifetimes(subnet); err != nil {
+			return err
+		}
+
+		if _, err := tx.Update(resource.TableReservation6, map[string]interface{}{
+			resource.SqlColumnComment:           reservation.Comment,
+			resource.SqlColumnValidLifetime:     reservation.ValidLifetime,
+			resource.SqlColumnMaxValidLifetime:  reservation.MaxValidLifetime,
+			resource.SqlColumnMinValidLifetime:  reservation.MinValidLifetime,
+			resource.SqlColumnPreferredLifetime: reservation.PreferredLifetime,
+			resource.SqlColumnDomainServers:     reservation.DomainServers,
+			resource.SqlColumnDomainSearchList:  reservation.DomainSearchList,
+		}, map[string]interface{}{restdb.IDField: reservation.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, reservation.GetID(),
 				pg.Error(err).Error())
-		} else if rows == 0 {
-			return errorno.ErrNotFound(errorno.ErrNameDhcpReservation, reservation.GetID())
 		}
 
-		return nil
+		if err := saveOptionValue6s(tx, resource.OptionScopeReservation6, reservation.GetID(),
+			subnetId, reservation.Options); err != nil {
+			return err
+		}
+
+		return sendUpdateReservation6CmdToDHCPAgent(subnet, reservation)
 	})
 }
 
+func sendUpdateReservation6CmdToDHCPAgent(subnet *resource.Subnet6, reservation *resource.Reservation6) error {
+	if len(subnet.Nodes) == 0 {
+		return nil
+	}
+
+	validLifetime, minValidLifetime, maxValidLifetime, preferredLifetime :=
+		reservation.MergedLifetimes(subnet)
+	return kafka.SendDHCPCmdWithNodes(false, subnet.Nodes, kafka.UpdateReservation6,
+		&pbdhcpagent.UpdateReservation6Request{
+			SubnetId:          subnet.SubnetId,
+			HwAddress:         reservation.HwAddress,
+			Duid:              reservation.Duid,
+			Hostname:          reservation.Hostname,
+			IpAddresses:       reservation.IpAddresses,
+			Prefixes:          reservation.Prefixes,
+			ValidLifetime:     validLifetime,
+			MaxValidLifetime:  maxValidLifetime,
+			MinValidLifetime:  minValidLifetime,
+			PreferredLifetime: preferredLifetime,
+			ReservationOptions: append(pbSubnetOptionsFromOverrides6(reservation.DomainServers,
+				reservation.DomainSearchList), pbSubnetOptionsFromOptionValue6s(reservation.Options)...),
+		}, nil)
+}
+
 func GetReservation6sByPrefix(prefix string) ([]*resource.Reservation6, error) {
 	if subnet6, err := GetSubnet6ByPrefix(prefix); err != nil {
 		return nil, err
@@ -707,6 +804,10 @@ func batchCreateReservation6s(tx restdb.Transaction, subnet *resource.Subnet6, r
 				reservation); err != nil {
 				return err
 			}
+
+			if err := reservation.CheckLifetimes(subnet); err != nil {
+				return err
+			}
 		}
 
 		if err := reservation6Identifier.Add(reservation); err != nil {
@@ -752,34 +853,34 @@ func batchCreateReservation6s(tx restdb.Transaction, subnet *resource.Subnet6, r
 			string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 	}
 
-	return sendCreateReservation6sCmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, validReservations)
+	return sendCreateReservation6sCmdToDHCPAgent(subnet, validReservations)
 }
 
-func sendCreateReservation6sCmdToDHCPAgent(subnetID uint64, nodes []string, reservations []*resource.Reservation6) error {
-	if len(nodes) == 0 || len(reservations) == 0 {
+func sendCreateReservation6sCmdToDHCPAgent(subnet *resource.Subnet6, reservations []*resource.Reservation6) error {
+	if len(subnet.Nodes) == 0 || len(reservations) == 0 {
 		return nil
 	}
 
-	return kafka.SendDHCPCmdWithNodes(false, nodes, kafka.CreateReservation6s,
-		reservation6sToCreateReservations6Request(subnetID, reservations),
+	return kafka.SendDHCPCmdWithNodes(false, subnet.Nodes, kafka.CreateReservation6s,
+		reservation6sToCreateReservations6Request(subnet, reservations),
 		func(nodesForSucceed []string) {
 			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
 				nodesForSucceed, kafka.DeleteReservation6s,
-				reservation6sToDeleteReservations6Request(subnetID, reservations)); err != nil {
+				reservation6sToDeleteReservations6Request(subnet.SubnetId, reservations)); err != nil {
 				log.Errorf("create subnet6 %d reservation6 %s failed, rollback %v failed: %s",
-					subnetID, reservations[0].String(), nodesForSucceed, err.Error())
+					subnet.SubnetId, reservations[0].String(), nodesForSucceed, err.Error())
 			}
 		})
 }
 
-func reservation6sToCreateReservations6Request(subnetID uint64, reservations []*resource.Reservation6) *pbdhcpagent.CreateReservations6Request {
+func reservation6sToCreateReservations6Request(subnet *resource.Subnet6, reservations []*resource.Reservation6) *pbdhcpagent.CreateReservations6Request {
 	pbReservations := make([]*pbdhcpagent.CreateReservation6Request, len(reservations))
 	for i, reservation := range reservations {
-		pbReservations[i] = reservation6ToCreateReservation6Request(subnetID, reservation)
+		pbReservations[i] = reservation6ToCreateReservation6Request(subnet, reservation)
 	}
 
 	return &pbdhcpagent.CreateReservations6Request{
-		SubnetId:     subnetID,
+		SubnetId:     subnet.SubnetId,
 		Reservations: pbReservations,
 	}
 }
@@ -850,6 +951,13 @@ func (s *Reservation6Service) BatchDeleteReservation6s(subnetId string, ids []st
 				string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 		}
 
+		if err = deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeReservation6,
+			resource.SqlColumnScopeId: restdb.FillValue{
+				Operator: restdb.OperatorAny, Value: ids}}); err != nil {
+			return err
+		}
+
 		if err := updateSubnet6AndPoolsCapacity(tx, subnet,
 			poolsCapacity, pdpoolsCapacity); err != nil {
 			return err
@@ -1094,3 +1202,7 @@ func reservationPrefixMapFromReservation6s(reservations []*resource.Reservation6
 
 	return reservationMap
 }
+
+func (r *Reservation6Service) EffectiveConfig(subnetId, reservationId string) (*resource.EffectiveConfig, error) {
+	return getEffectiveConfig6(subnetId, "", reservationId)
+}
@@ -30,23 +30,27 @@ func (p *ReservedPool4Service) Create(subnet *resource.Subnet4, pool *resource.R
 	}
 
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if err := checkReservedPool4CouldBeCreated(tx, subnet, pool); err != nil {
-			return err
-		}
+		return createReservedPool4(tx, subnet, pool)
+	})
+}
 
-		if err := updateSubnet4AndPoolsCapacityWithReservedPool4(tx, subnet,
-			pool, true); err != nil {
-			return err
-		}
+func createReservedPool4(tx restdb.Transaction, subnet *resource.Subnet4, pool *resource.ReservedPool4) error {
+	if err := checkReservedPool4CouldBeCreated(tx, subnet, pool); err != nil {
+		return err
+	}
 
-		pool.Subnet4 = subnet.GetID()
-		if _, err := tx.Insert(pool); err != nil {
-			return errorno.ErrDBError(errorno.ErrDBNameQuery,
-				string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
-		}
+	if err := updateSubnet4AndPoolsCapacityWithReservedPool4(tx, subnet,
+		pool, true); err != nil {
+		return err
+	}
 
-		return sendCreateReservedPool4CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pool)
-	})
+	pool.Subnet4 = subnet.GetID()
+	if _, err := tx.Insert(pool); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
+	}
+
+	return sendCreateReservedPool4CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pool)
 }
 
 func checkReservedPool4CouldBeCreated(tx restdb.Transaction, subnet *resource.Subnet4, pool *resource.ReservedPool4) error {
@@ -30,23 +30,27 @@ func (p *ReservedPool6Service) Create(subnet *resource.Subnet6, pool *resource.R
 	}
 
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if err := checkReservedPool6CouldBeCreated(tx, subnet, pool); err != nil {
-			return err
-		}
+		return createReservedPool6(tx, subnet, pool)
+	})
+}
 
-		if err := updateSubnet6AndPool6sCapacityWithReservedPool6(tx, subnet,
-			pool, true); err != nil {
-			return err
-		}
+func createReservedPool6(tx restdb.Transaction, subnet *resource.Subnet6, pool *resource.ReservedPool6) error {
+	if err := checkReservedPool6CouldBeCreated(tx, subnet, pool); err != nil {
+		return err
+	}
 
-		pool.Subnet6 = subnet.GetID()
-		if _, err := tx.Insert(pool); err != nil {
-			return errorno.ErrDBError(errorno.ErrDBNameInsert,
-				string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
-		}
+	if err := updateSubnet6AndPool6sCapacityWithReservedPool6(tx, subnet,
+		pool, true); err != nil {
+		return err
+	}
 
-		return sendCreateReservedPool6CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pool)
-	})
+	pool.Subnet6 = subnet.GetID()
+	if _, err := tx.Insert(pool); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameInsert,
+			string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
+	}
+
+	return sendCreateReservedPool6CmdToDHCPAgent(subnet.SubnetId, subnet.Nodes, pool)
 }
 
 func checkReservedPool6CouldBeCreated(tx restdb.Transaction, subnet *resource.Subnet6, pool *resource.ReservedPool6) error {
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"net"
+
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	FilterNameKeyword    = "keyword"
+	FilterNameSearchType = "search_type"
+
+	LeaseTypeIAPD = "IA_PD"
+)
+
+type SearchService struct{}
+
+func NewSearchService() *SearchService {
+	return &SearchService{}
+}
+
+func (s *SearchService) List(ctx *restresource.Context) ([]*resource.Search, error) {
+	keyword, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameKeyword, ctx.GetFilters())
+	searchType, _ := util.GetFilterValueWithEqModifierFromFilters(FilterNameSearchType, ctx.GetFilters())
+	typ, keyword, err := resource.ParseSearchType(keyword, searchType)
+	if err != nil {
+		return nil, err
+	}
+
+	return Search(typ, keyword)
+}
+
+type searchResults struct {
+	searchType resource.SearchType
+	keyword    string
+	results    []*resource.Search
+	resultMap  map[string]*resource.Search
+}
+
+func Search(searchType resource.SearchType, keyword string) ([]*resource.Search, error) {
+	r := &searchResults{
+		searchType: searchType,
+		keyword:    keyword,
+		resultMap:  make(map[string]*resource.Search),
+	}
+
+	var err error
+	switch searchType {
+	case resource.SearchTypeIp:
+		err = r.searchIp()
+	case resource.SearchTypeMac:
+		err = r.searchMac()
+	case resource.SearchTypeDuid:
+		err = r.searchDuid()
+	case resource.SearchTypeHostname:
+		err = r.searchHostname()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := restdb.WithTx(db.GetDB(), r.fillDetails); err != nil {
+		return nil, err
+	}
+
+	return r.results, nil
+}
+
+func (r *searchResults) getResult(version, address string) *resource.Search {
+	key := version + "-" + address
+	if result, ok := r.resultMap[key]; ok {
+		return result
+	}
+
+	result := &resource.Search{SearchType: r.searchType, Version: version, Address: address}
+	result.SetID(key)
+	r.resultMap[key] = result
+	r.results = append(r.results, result)
+	return result
+}
+
+func (r *searchResults) searchIp() error {
+	if net.ParseIP(r.keyword).To4() != nil {
+		var subnets []*resource.Subnet4
+		if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+			return tx.FillEx(&subnets, "SELECT * FROM gr_subnet4 WHERE ipnet >>= $1", r.keyword)
+		}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+		}
+
+		if len(subnets) != 0 {
+			result := r.getResult(resource.SearchVersion4, r.keyword)
+			result.Subnet4 = subnets[0]
+			if leases, err := ListSubnetLease4(subnets[0], r.keyword); err != nil {
+				log.Warnf("search lease4 with ip %s failed: %s", r.keyword, err.Error())
+			} else if len(leases) != 0 {
+				result.Lease4 = leases[0]
+			}
+		}
+	} else {
+		var subnets []*resource.Subnet6
+		if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+			return tx.FillEx(&subnets, "SELECT * FROM gr_subnet6 WHERE ipnet >>= $1", r.keyword)
+		}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+		}
+
+		if len(subnets) != 0 {
+			result := r.getResult(resource.SearchVersion6, r.keyword)
+			result.Subnet6 = subnets[0]
+			if leases, err := ListSubnetLease6(subnets[0], r.keyword); err != nil {
+				log.Warnf("search lease6 with ip %s failed: %s", r.keyword, err.Error())
+			} else if len(leases) != 0 {
+				result.Lease6 = leases[0]
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *searchResults) searchMac() error {
+	lease4s, err := GetSubnets4LeasesWithMacs([]string{r.keyword})
+	r.addLease4s(lease4s, err)
+	lease6s, err := GetSubnets6LeasesWithMacs([]string{r.keyword})
+	r.addLease6s(lease6s, err)
+	return r.addReservations(resource.SqlColumnHwAddress, r.keyword)
+}
+
+func (r *searchResults) searchDuid() error {
+	lease6s, err := GetSubnets6LeasesWithDuids([]string{r.keyword})
+	r.addLease6s(lease6s, err)
+	return r.addReservations(resource.SqlColumnDuid, r.keyword)
+}
+
+func (r *searchResults) searchHostname() error {
+	lease4s, err := GetSubnets4LeasesWithHostnames([]string{r.keyword})
+	r.addLease4s(lease4s, err)
+	lease6s, err := GetSubnets6LeasesWithHostnames([]string{r.keyword})
+	r.addLease6s(lease6s, err)
+	return r.addReservations(resource.SqlColumnHostname, r.keyword)
+}
+
+func (r *searchResults) addLease4s(leases []*resource.SubnetLease4, err error) {
+	if err == nil {
+		leases, err = FilterReclaimedSubnetLease4s(leases)
+	}
+
+	if err != nil {
+		log.Warnf("search lease4s with %s %s failed: %s", r.searchType, r.keyword, err.Error())
+		return
+	}
+
+	for _, lease := range leases {
+		r.getResult(resource.SearchVersion4, lease.Address).Lease4 = lease
+	}
+}
+
+func (r *searchResults) addLease6s(leases []*resource.SubnetLease6, err error) {
+	if err == nil {
+		leases, err = FilterReclaimedSubnetLease6s(leases)
+	}
+
+	if err != nil {
+		log.Warnf("search lease6s with %s %s failed: %s", r.searchType, r.keyword, err.Error())
+		return
+	}
+
+	for _, lease := range leases {
+		r.getResult(resource.SearchVersion6, lease.Address).Lease6 = lease
+	}
+}
+
+func (r *searchResults) addReservations(column, value string) error {
+	var reservation4s []*resource.Reservation4
+	var reservation6s []*resource.Reservation6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if column != resource.SqlColumnDuid {
+			if err := tx.Fill(map[string]interface{}{column: value}, &reservation4s); err != nil {
+				return err
+			}
+		}
+
+		return tx.Fill(map[string]interface{}{column: value}, &reservation6s)
+	}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
+	}
+
+	for _, reservation := range reservation4s {
+		result := r.getResult(resource.SearchVersion4, reservation.IpAddress)
+		result.Reservation4s = append(result.Reservation4s, reservation)
+	}
+
+	for _, reservation := range reservation6s {
+		for _, address := range append(reservation.IpAddresses, reservation.Prefixes...) {
+			result := r.getResult(resource.SearchVersion6, address)
+			result.Reservation6s = append(result.Reservation6s, reservation)
+		}
+	}
+
+	return nil
+}
+
+func (r *searchResults) fillDetails(tx restdb.Transaction) error {
+	for _, result := range r.results {
+		var err error
+		if result.Version == resource.SearchVersion4 {
+			err = fillSearchResult4(tx, result)
+		} else {
+			err = fillSearchResult6(tx, result)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.searchType == resource.SearchTypeMac && len(r.results) == 0 {
+		result := &resource.Search{SearchType: r.searchType, HwAddress: r.keyword}
+		result.SetID(r.keyword)
+		r.results = append(r.results, result)
+	}
+
+	for _, result := range r.results {
+		if result.HwAddress == "" {
+			continue
+		}
+
+		if err := fillSearchResultHwAddress(tx, result); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func fillSearchResult4(tx restdb.Transaction, result *resource.Search) error {
+	subnetId := ""
+	if result.Lease4 != nil {
+		subnetId = result.Lease4.Subnet4
+		result.HwAddress = result.Lease4.HwAddress
+		result.HwAddressOrganization = result.Lease4.HwAddressOrganization
+		result.Fingerprint = result.Lease4.Fingerprint
+		result.VendorId = result.Lease4.VendorId
+		result.OperatingSystem = result.Lease4.OperatingSystem
+		result.ClientType = result.Lease4.ClientType
+	} else if len(result.Reservation4s) != 0 {
+		subnetId = result.Reservation4s[0].Subnet4
+		result.HwAddress = result.Reservation4s[0].HwAddress
+	}
+
+	if result.Subnet4 == nil && subnetId != "" {
+		subnet, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		result.Subnet4 = subnet
+	}
+
+	if result.Subnet4 == nil {
+		return nil
+	}
+
+	addressType, err := GetIPv4AddressType(tx, result.Subnet4.GetID(), result.Address)
+	if err != nil {
+		return err
+	}
+
+	result.AddressType = addressType
+	if len(result.Reservation4s) == 0 {
+		if err := tx.Fill(map[string]interface{}{
+			resource.SqlColumnSubnet4:   result.Subnet4.GetID(),
+			resource.SqlColumnIpAddress: result.Address,
+		}, &result.Reservation4s); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
+		}
+
+		if len(result.Reservation4s) != 0 && result.HwAddress == "" {
+			result.HwAddress = result.Reservation4s[0].HwAddress
+		}
+	}
+
+	return nil
+}
+
+func fillSearchResult6(tx restdb.Transaction, result *resource.Search) error {
+	subnetId := ""
+	if result.Lease6 != nil {
+		subnetId = result.Lease6.Subnet6
+		result.HwAddress = result.Lease6.HwAddress
+		result.HwAddressOrganization = result.Lease6.HwAddressOrganization
+		result.Fingerprint = result.Lease6.Fingerprint
+		result.VendorId = result.Lease6.VendorId
+		result.OperatingSystem = result.Lease6.OperatingSystem
+		result.ClientType = result.Lease6.ClientType
+	} else if len(result.Reservation6s) != 0 {
+		subnetId = result.Reservation6s[0].Subnet6
+		result.HwAddress = result.Reservation6s[0].HwAddress
+	}
+
+	if result.Subnet6 == nil && subnetId != "" {
+		subnet, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		result.Subnet6 = subnet
+	}
+
+	if result.Subnet6 == nil {
+		return nil
+	}
+
+	if result.Lease6 != nil && result.Lease6.LeaseType == LeaseTypeIAPD {
+		result.AddressType = resource.AddressTypeDelegation
+	} else if addressType, err := GetIPv6AddressType(tx, result.Subnet6.GetID(),
+		result.Address); err != nil {
+		return err
+	} else {
+		result.AddressType = addressType
+	}
+
+	if len(result.Reservation6s) == 0 {
+		if err := tx.FillEx(&result.Reservation6s,
+			"select * from gr_reservation6 where subnet6 = $1 and $2::text = any(ip_addresses)",
+			result.Subnet6.GetID(), result.Address); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
+		}
+
+		if len(result.Reservation6s) != 0 && result.HwAddress == "" {
+			result.HwAddress = result.Reservation6s[0].HwAddress
+		}
+	}
+
+	return nil
+}
+
+func fillSearchResultHwAddress(tx restdb.Transaction, result *resource.Search) error {
+	var assets []*resource.Asset
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnHwAddress: result.HwAddress},
+		&assets); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameAsset),
+			pg.Error(err).Error())
+	} else if len(assets) != 0 {
+		result.Asset = assets[0]
+	}
+
+	if result.HwAddressOrganization != "" || len(result.HwAddress) < 8 {
+		return nil
+	}
+
+	var ouis []*resource.DhcpOui
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnOui: result.HwAddress[:8]},
+		&ouis); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameOui),
+			pg.Error(err).Error())
+	} else if len(ouis) != 0 {
+		result.HwAddressOrganization = ouis[0].Organization
+	}
+
+	return nil
+}
@@ -0,0 +1,359 @@
+package service
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"github.com/linkingthing/cement/log"
+	"github.com/linkingthing/clxone-utils/excel"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/kafka"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type SharedNetwork6Service struct{}
+
+func NewSharedNetwork6Service() *SharedNetwork6Service {
+	return &SharedNetwork6Service{}
+}
+
+func (s *SharedNetwork6Service) Create(sharedNetwork6 *resource.SharedNetwork6) error {
+	if err := sharedNetwork6.Validate(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Insert(sharedNetwork6); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameSharedNetwork, sharedNetwork6.Name, err)
+		}
+
+		return sendCreateSharedNetwork6CmdToDHCPAgent(sharedNetwork6)
+	})
+}
+
+func sendCreateSharedNetwork6CmdToDHCPAgent(sharedNetwork6 *resource.SharedNetwork6) error {
+	return kafka.SendDHCP6Cmd(kafka.CreateSharedNetwork6,
+		sharedNetwork6ToCreateSharedNetwork6Request(sharedNetwork6),
+		func(nodesForSucceed []string) {
+			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+				nodesForSucceed, kafka.DeleteSharedNetwork6,
+				sharedNetworkNameToDeleteSharedNetwork6Request(sharedNetwork6.Name)); err != nil {
+				log.Errorf("create shared network6 %s failed, and rollback with nodes %v failed: %s",
+					sharedNetwork6.Name, nodesForSucceed, err.Error())
+			}
+		})
+}
+
+func sharedNetwork6ToCreateSharedNetwork6Request(sharedNetwork6 *resource.SharedNetwork6) *pbdhcpagent.CreateSharedNetwork6Request {
+	return &pbdhcpagent.CreateSharedNetwork6Request{
+		Name:      sharedNetwork6.Name,
+		SubnetIds: sharedNetwork6.SubnetIds,
+	}
+}
+
+func (s *SharedNetwork6Service) List(condition map[string]interface{}) ([]*resource.SharedNetwork6, error) {
+	var sharedNetwork6s []*resource.SharedNetwork6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.Fill(condition, &sharedNetwork6s)
+	}); err != nil {
+		return nil, errorno.ErrOperateResource(errorno.ErrMethodList, string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	}
+
+	return sharedNetwork6s, nil
+}
+
+func (s *SharedNetwork6Service) Get(id string) (sharedNetwork6 *resource.SharedNetwork6, err error) {
+	err = restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		sharedNetwork6, err = getOldSharedNetwork6(tx, id)
+		return err
+	})
+	return
+}
+
+func (s *SharedNetwork6Service) Update(sharedNetwork6 *resource.SharedNetwork6) error {
+	if err := sharedNetwork6.Validate(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		oldSharedNetwork6, err := getOldSharedNetwork6(tx, sharedNetwork6.GetID())
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Update(resource.TableSharedNetwork6, map[string]interface{}{
+			resource.SqlColumnName:      sharedNetwork6.Name,
+			resource.SqlColumnSubnetIds: sharedNetwork6.SubnetIds,
+			resource.SqlColumnSubnets:   sharedNetwork6.Subnets,
+			resource.SqlColumnComment:   sharedNetwork6.Comment,
+		}, map[string]interface{}{
+			restdb.IDField: sharedNetwork6.GetID()}); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameSharedNetwork, sharedNetwork6.Name, err)
+		}
+
+		return sendUpdateSharedNetwork6CmdToDHCPAgent(oldSharedNetwork6.Name, sharedNetwork6)
+	})
+}
+
+func sendUpdateSharedNetwork6CmdToDHCPAgent(name string, sharedNetwork6 *resource.SharedNetwork6) error {
+	return kafka.SendDHCP6Cmd(kafka.UpdateSharedNetwork6,
+		&pbdhcpagent.UpdateSharedNetwork6Request{
+			Old: sharedNetworkNameToDeleteSharedNetwork6Request(name),
+			New: sharedNetwork6ToCreateSharedNetwork6Request(sharedNetwork6),
+		}, nil)
+}
+
+func (s *SharedNetwork6Service) Delete(sharedNetwork6Id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		oldSharedNetwork6, err := getOldSharedNetwork6(tx, sharedNetwork6Id)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Delete(resource.TableSharedNetwork6, map[string]interface{}{
+			restdb.IDField: sharedNetwork6Id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, sharedNetwork6Id, pg.Error(err).Error())
+		}
+
+		return sendDeleteSharedNetwork6CmdToDHCPAgent(oldSharedNetwork6.Name)
+	})
+}
+
+func getOldSharedNetwork6(tx restdb.Transaction, id string) (*resource.SharedNetwork6, error) {
+	var sharedNetworks []*resource.SharedNetwork6
+	if err := tx.Fill(map[string]interface{}{restdb.IDField: id},
+		&sharedNetworks); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+	} else if len(sharedNetworks) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameSharedNetwork, id)
+	}
+
+	return sharedNetworks[0], nil
+}
+
+func sendDeleteSharedNetwork6CmdToDHCPAgent(name string) error {
+	return kafka.SendDHCP6Cmd(kafka.DeleteSharedNetwork6,
+		sharedNetworkNameToDeleteSharedNetwork6Request(name), nil)
+}
+
+func sharedNetworkNameToDeleteSharedNetwork6Request(name string) *pbdhcpagent.DeleteSharedNetwork6Request {
+	return &pbdhcpagent.DeleteSharedNetwork6Request{Name: name}
+}
+
+func checkUsedBySharedNetwork6(tx restdb.Transaction, subnet6 *resource.Subnet6) error {
+	var sharedNetwork6s []*resource.SharedNetwork6
+	if err := tx.FillEx(&sharedNetwork6s,
+		"select * from gr_shared_network6 where $1::numeric = any(subnet_ids)",
+		subnet6.SubnetId); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	} else if len(sharedNetwork6s) != 0 {
+		return errorno.ErrUsed(errorno.ErrNameNetworkV6, errorno.ErrNameSharedNetwork, subnet6.Subnet, sharedNetwork6s[0].Name)
+	} else {
+		return nil
+	}
+}
+
+func (s *SharedNetwork6Service) ImportExcel(file *excel.ImportFile) (interface{}, error) {
+	if len(file.Name) == 0 {
+		return nil, nil
+	}
+
+	response := &excel.ImportResult{}
+	defer sendImportFieldResponse(SharedNetwork6ImportFileNamePrefix, TableHeaderSharedNetwork6Fail, response)
+	validSql, createSharedNetworksRequest, deleteSharedNetworksRequest, err := parseSharedNetwork6sFromFile(
+		file.Name, response)
+	if err != nil {
+		return response, err
+	}
+
+	if len(validSql) == 0 {
+		return response, nil
+	}
+
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := tx.Exec(validSql); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert,
+				string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+		}
+
+		return sendCreateSharedNetwork6sCmdToDHCPAgent(createSharedNetworksRequest, deleteSharedNetworksRequest)
+	}); err != nil {
+		return response, err
+	}
+
+	return response, nil
+}
+
+func parseSharedNetwork6sFromFile(fileName string, response *excel.ImportResult) (string, *pbdhcpagent.CreateSharedNetworks6Request, *pbdhcpagent.DeleteSharedNetworks6Request, error) {
+	contents, err := excel.ReadExcelFile(fileName)
+	if err != nil {
+		return "", nil, nil, errorno.ErrReadFile(fileName, err.Error())
+	}
+
+	if len(contents) < 2 {
+		return "", nil, nil, nil
+	}
+
+	tableHeaderFields, err := excel.ParseTableHeader(contents[0], TableHeaderSharedNetwork6,
+		SharedNetwork6MandatoryFields)
+	if err != nil {
+		return "", nil, nil, errorno.ErrInvalidTableHeader()
+	}
+
+	var oldSharedNetwork6s []*resource.SharedNetwork6
+	if err := db.GetResources(nil, &oldSharedNetwork6s); err != nil {
+		return "", nil, nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSharedNetwork), err.Error())
+	}
+
+	var subnet6s []*resource.Subnet6
+	if err := db.GetResources(nil, &subnet6s); err != nil {
+		return "", nil, nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameNetworkV6), err.Error())
+	}
+
+	subnetMap := make(map[string]*resource.Subnet6, len(subnet6s))
+	for _, subnet6 := range subnet6s {
+		subnetMap[subnet6.Subnet] = subnet6
+	}
+
+	response.InitData(len(contents) - 1)
+	sharedNetworks := make([]*resource.SharedNetwork6, 0, len(contents)-1)
+	fieldcontents := contents[1:]
+	for j, fields := range fieldcontents {
+		fields, missingMandatory, emptyLine := excel.ParseTableFields(fields,
+			tableHeaderFields, SharedNetwork6MandatoryFields)
+		if emptyLine {
+			continue
+		} else if missingMandatory {
+			addFailDataToResponse(response, TableHeaderSharedNetwork6FailLen,
+				localizationSharedNetwork6ToStrSlice(&resource.SharedNetwork6{}),
+				errorno.ErrMissingMandatory(j+2, SharedNetwork6MandatoryFields).ErrorCN())
+			continue
+		}
+
+		sharedNetwork, subnets, err := parseSharedNetwork6(tableHeaderFields, fields, subnetMap)
+		if err != nil {
+			addFailDataToResponse(response, TableHeaderSharedNetwork6FailLen,
+				localizationSharedNetwork6ToStrSlice(sharedNetwork), errorno.TryGetErrorCNMsg(err))
+		} else if err := sharedNetwork.ValidateParams(); err != nil {
+			addFailDataToResponse(response, TableHeaderSharedNetwork6FailLen,
+				localizationSharedNetwork6ToStrSlice(sharedNetwork), errorno.TryGetErrorCNMsg(err))
+		} else if err := sharedNetwork.SetSharedNetworkSubnets(subnets); err != nil {
+			addFailDataToResponse(response, TableHeaderSharedNetwork6FailLen,
+				localizationSharedNetwork6ToStrSlice(sharedNetwork), errorno.TryGetErrorCNMsg(err))
+		} else if err := sharedNetwork.CheckConflictWithOthers(
+			append(oldSharedNetwork6s, sharedNetworks...)); err != nil {
+			addFailDataToResponse(response, TableHeaderSharedNetwork6FailLen,
+				localizationSharedNetwork6ToStrSlice(sharedNetwork), errorno.TryGetErrorCNMsg(err))
+		} else {
+			sharedNetworks = append(sharedNetworks, sharedNetwork)
+		}
+	}
+
+	if len(sharedNetworks) == 0 {
+		return "", nil, nil, nil
+	}
+
+	sql, createSharedNetworksRequest, deleteSharedNetworksRequest := sharedNetwork6sToInsertSqlAndPbRequest(sharedNetworks)
+	return sql, createSharedNetworksRequest, deleteSharedNetworksRequest, nil
+}
+
+func parseSharedNetwork6(tableHeaderFields, fields []string, subnetMap map[string]*resource.Subnet6) (*resource.SharedNetwork6, []*resource.Subnet6, error) {
+	sharedNetwork := &resource.SharedNetwork6{}
+	for i, field := range fields {
+		if excel.IsSpaceField(field) {
+			continue
+		}
+
+		switch tableHeaderFields[i] {
+		case FieldNameSharedNetworkName:
+			sharedNetwork.Name = strings.TrimSpace(field)
+		case FieldNameSharedNetworkSubnets:
+			sharedNetwork.Subnets = splitFieldWithoutSpace(field)
+		case FieldNameComment:
+			sharedNetwork.Comment = field
+		}
+	}
+
+	subnets := make([]*resource.Subnet6, 0, len(sharedNetwork.Subnets))
+	for _, subnet := range sharedNetwork.Subnets {
+		subnet6, ok := subnetMap[subnet]
+		if !ok {
+			return sharedNetwork, nil, errorno.ErrNotFound(errorno.ErrNameNetworkV6, subnet)
+		}
+
+		subnets = append(subnets, subnet6)
+		sharedNetwork.SubnetIds = append(sharedNetwork.SubnetIds, subnet6.SubnetId)
+	}
+
+	return sharedNetwork, subnets, nil
+}
+
+func sharedNetwork6sToInsertSqlAndPbRequest(sharedNetworks []*resource.SharedNetwork6) (string, *pbdhcpagent.CreateSharedNetworks6Request, *pbdhcpagent.DeleteSharedNetworks6Request) {
+	var buf bytes.Buffer
+	buf.WriteString("INSERT INTO gr_shared_network6 VALUES ")
+	createSharedNetworkRequests := make([]*pbdhcpagent.CreateSharedNetwork6Request, 0, len(sharedNetworks))
+	deleteSharedNetworks := make([]string, 0, len(sharedNetworks))
+	for _, sharedNetwork := range sharedNetworks {
+		buf.WriteString(sharedNetwork6ToInsertDBSqlString(sharedNetwork))
+		createSharedNetworkRequests = append(createSharedNetworkRequests,
+			sharedNetwork6ToCreateSharedNetwork6Request(sharedNetwork))
+		deleteSharedNetworks = append(deleteSharedNetworks, sharedNetwork.Name)
+	}
+
+	return strings.TrimSuffix(buf.String(), ",") + ";",
+		&pbdhcpagent.CreateSharedNetworks6Request{SharedNetworks: createSharedNetworkRequests},
+		&pbdhcpagent.DeleteSharedNetworks6Request{Names: deleteSharedNetworks}
+}
+
+func sendCreateSharedNetwork6sCmdToDHCPAgent(createSharedNetworksRequest *pbdhcpagent.CreateSharedNetworks6Request, deleteSharedNetworksRequest *pbdhcpagent.DeleteSharedNetworks6Request) error {
+	return kafka.SendDHCP6Cmd(kafka.CreateSharedNetwork6s,
+		createSharedNetworksRequest, func(nodesForSucceed []string) {
+			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(nodesForSucceed,
+				kafka.DeleteSharedNetwork6s, deleteSharedNetworksRequest); err != nil {
+				log.Warnf("batch create shared network6s failed and rollback failed: %s", err.Error())
+			}
+		})
+}
+
+func (s *SharedNetwork6Service) ExportExcel() (interface{}, error) {
+	var sharedNetworks []*resource.SharedNetwork6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&sharedNetworks,
+			"select * from gr_shared_network6 order by name")
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	}
+
+	strMatrix := make([][]string, 0, len(sharedNetworks))
+	for _, sharedNetwork := range sharedNetworks {
+		strMatrix = append(strMatrix, localizationSharedNetwork6ToStrSlice(sharedNetwork))
+	}
+
+	if filepath, err := excel.WriteExcelFile(SharedNetwork6FileNamePrefix+
+		time.Now().Format(excel.TimeFormat), TableHeaderSharedNetwork6, strMatrix); err != nil {
+		return nil, errorno.ErrOperateResource(errorno.ErrNameExport,
+			string(errorno.ErrNameSharedNetwork), err.Error())
+	} else {
+		return &excel.ExportFile{Path: filepath}, nil
+	}
+}
+
+func (s *SharedNetwork6Service) ExportExcelTemplate() (*excel.ExportFile, error) {
+	if filepath, err := excel.WriteExcelFile(SharedNetwork6TemplateFileName,
+		TableHeaderSharedNetwork6, TemplateSharedNetwork6); err != nil {
+		return nil, errorno.ErrOperateResource(errorno.ErrNameExport,
+			string(errorno.ErrNameTemplate), err.Error())
+	} else {
+		return &excel.ExportFile{Path: filepath}, nil
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/linkingthing/cement/uuid"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+)
+
+const (
+	SharedNetwork6TemplateFileName     = "shared-network6-template"
+	SharedNetwork6FileNamePrefix       = "shared-network6-"
+	SharedNetwork6ImportFileNamePrefix = "shared-network6-import"
+
+	FieldNameSharedNetworkName    = "共享网络名称*"
+	FieldNameSharedNetworkSubnets = "子网地址列表*"
+)
+
+var (
+	TableHeaderSharedNetwork6        = []string{FieldNameSharedNetworkName, FieldNameSharedNetworkSubnets, FieldNameComment}
+	TableHeaderSharedNetwork6Fail    = append(TableHeaderSharedNetwork6, FailReasonLocalization)
+	TableHeaderSharedNetwork6FailLen = len(TableHeaderSharedNetwork6Fail)
+	SharedNetwork6MandatoryFields    = []string{FieldNameSharedNetworkName, FieldNameSharedNetworkSubnets}
+
+	TemplateSharedNetwork6 = [][]string{[]string{"campus-link1", "2001:db8:1::/64\n2001:db8:2::/64", "备注1"}}
+)
+
+func localizationSharedNetwork6ToStrSlice(sharedNetwork6 *resource.SharedNetwork6) []string {
+	return []string{sharedNetwork6.Name,
+		strings.Join(sharedNetwork6.Subnets, resource.CommonDelimiter), sharedNetwork6.Comment}
+}
+
+func sharedNetwork6ToInsertDBSqlString(sharedNetwork6 *resource.SharedNetwork6) string {
+	id, _ := uuid.Gen()
+	subnetIds := make([]string, 0, len(sharedNetwork6.SubnetIds))
+	for _, subnetId := range sharedNetwork6.SubnetIds {
+		subnetIds = append(subnetIds, strconv.FormatUint(subnetId, 10))
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("('")
+	buf.WriteString(id)
+	buf.WriteString("','")
+	buf.WriteString(time.Now().Format(time.RFC3339))
+	buf.WriteString("','")
+	buf.WriteString(sharedNetwork6.Name)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(subnetIds, ","))
+	buf.WriteString("}','{")
+	buf.WriteString(strings.Join(sharedNetwork6.Subnets, ","))
+	buf.WriteString("}','")
+	buf.WriteString(sharedNetwork6.Comment)
+	buf.WriteString("'),")
+	return buf.String()
+}
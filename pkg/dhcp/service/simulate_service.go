@@ -0,0 +1,573 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+// classes are evaluated in creation order, so member() sees classes created before
+func clientClassBefore(createTime time.Time, name string, anotherCreateTime time.Time, anotherName string) bool {
+	if createTime.Equal(anotherCreateTime) {
+		return name < anotherName
+	}
+
+	return createTime.Before(anotherCreateTime)
+}
+
+func simulate4(input *resource.Simulate4Input) (*resource.SimulateResult, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &resource.SimulateResult{}
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return simulate4WithTx(tx, input, result)
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func simulate4WithTx(tx restdb.Transaction, input *resource.Simulate4Input, result *resource.SimulateResult) error {
+	clientType, err := getSimulateClientType(tx, input.ParameterRequestList, input.VendorClass)
+	if err != nil {
+		return err
+	}
+
+	result.ClientType = clientType
+	if rule, target, err := checkSimulateAdmit(tx, true, input.HwAddress, clientType); err != nil || rule != "" {
+		result.Reject(rule, target)
+		return err
+	}
+
+	if result.RateLimit, err = getSimulateRateLimit(tx, true, input.HwAddress); err != nil {
+		return err
+	}
+
+	var clientClasses []*resource.ClientClass4
+	if err := tx.Fill(nil, &clientClasses); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameClientClass), pg.Error(err).Error())
+	}
+
+	var profiles []*resource.VendorOption43Profile
+	if err := tx.Fill(nil, &profiles); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameVendorOption43Profile), pg.Error(err).Error())
+	}
+
+	for _, profile := range profiles {
+		clientClass := profile.ToClientClass4()
+		clientClass.SetCreationTimestamp(profile.GetCreationTimestamp())
+		clientClasses = append(clientClasses, clientClass)
+	}
+
+	sort.Slice(clientClasses, func(i, j int) bool {
+		return clientClassBefore(clientClasses[i].GetCreationTimestamp(), clientClasses[i].Name,
+			clientClasses[j].GetCreationTimestamp(), clientClasses[j].Name)
+	})
+
+	clientClasses = resource.MatchClientClass4s(input, clientClasses)
+	members := make(map[string]struct{}, len(clientClasses))
+	for _, clientClass := range clientClasses {
+		members[clientClass.Name] = struct{}{}
+		result.ClientClasses = append(result.ClientClasses, clientClass.Name)
+		if clientClass.GetID() == "" {
+			continue
+		}
+
+		if clientClass.Options, err = getOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeClientClass4,
+			resource.SqlColumnScopeId: clientClass.GetID()}); err != nil {
+			return err
+		}
+	}
+
+	candidates, sharedNetwork, err := getSimulateSubnet4Candidates(tx, input)
+	if err != nil {
+		return err
+	} else if len(candidates) == 0 {
+		result.Reject(resource.SimulateRuleNoSubnet, input.RelayAgentAddress+input.IfaceName)
+		return nil
+	}
+
+	result.SharedNetwork = sharedNetwork
+	for _, subnet := range candidates {
+		result.Rejection = nil
+		result.Subnet = subnet.Subnet
+		if len(subnet.Nodes) == 0 {
+			result.Reject(resource.SimulateRuleNoNode, subnet.Subnet)
+			continue
+		}
+
+		if rule, ok := resource.CheckClientClassesAdmitted(subnet.WhiteClientClassStrategy,
+			subnet.WhiteClientClasses, subnet.BlackClientClassStrategy,
+			subnet.BlackClientClasses, members); !ok {
+			result.Reject(rule, subnet.Subnet)
+			continue
+		}
+
+		pool, reservation, err := selectSimulatePool4OrReservation4(tx, subnet, input, members, result)
+		if err != nil {
+			return err
+		} else if result.Rejection != nil {
+			continue
+		}
+
+		if subnet.Options, err = getOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeSubnet4, resource.SqlColumnScopeId: subnet.GetID(),
+		}); err != nil {
+			return err
+		}
+
+		routesMap, err := getStaticRoute4sMapWithSubnetIds(tx, []string{subnet.GetID()})
+		if err != nil {
+			return err
+		}
+
+		subnet.StaticRoutes = routesMap[subnet.GetID()]
+		config := resource.NewEffectiveConfig4(sharedNetwork, subnet, pool, reservation)
+		for _, clientClass := range clientClasses {
+			config.SetClientClassOption4s(clientClass.Options)
+		}
+
+		result.Options = config.Values
+		return nil
+	}
+
+	return nil
+}
+
+func getSimulateClientType(tx restdb.Transaction, parameterRequestList, vendorClass string) (string, error) {
+	if parameterRequestList == "" {
+		return "", nil
+	}
+
+	var fingerprints []*resource.DhcpFingerprint
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnFingerprint: parameterRequestList},
+		&fingerprints); err != nil {
+		return "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameFingerprint), pg.Error(err).Error())
+	}
+
+	if fingerprint := resource.MatchFingerprint(fingerprints, parameterRequestList,
+		vendorClass); fingerprint != nil {
+		return fingerprint.ClientType, nil
+	}
+
+	return "", nil
+}
+
+// checkSimulateAdmit checks client identifier first and then client type when admit
+// enabled, client not matched any of them will be rejected
+func checkSimulateAdmit(tx restdb.Transaction, isv4 bool, identifier, clientType string) (resource.SimulateRule, string, error) {
+	var admits []*resource.Admit
+	if err := tx.Fill(nil, &admits); err != nil {
+		return "", "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameAdmit), pg.Error(err).Error())
+	} else if len(admits) == 0 || !admits[0].Enabled {
+		return "", "", nil
+	}
+
+	if identifier != "" {
+		rule, found, isAdmitted, err := getSimulateIdentifierAdmitted(tx, isv4, identifier)
+		if err != nil {
+			return "", "", err
+		} else if found {
+			if isAdmitted {
+				return "", "", nil
+			}
+
+			return rule, identifier, nil
+		}
+	}
+
+	if clientType != "" {
+		var admitFingerprints []*resource.AdmitFingerprint
+		if err := tx.Fill(map[string]interface{}{resource.SqlColumnClientType: clientType},
+			&admitFingerprints); err != nil {
+			return "", "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameAdmit), pg.Error(err).Error())
+		} else if len(admitFingerprints) != 0 {
+			if admitFingerprints[0].IsAdmitted {
+				return "", "", nil
+			}
+
+			return resource.SimulateRuleAdmitFingerprint, clientType, nil
+		}
+	}
+
+	return resource.SimulateRuleAdmitNotMatched, identifier, nil
+}
+
+func getSimulateIdentifierAdmitted(tx restdb.Transaction, isv4 bool, identifier string) (resource.SimulateRule, bool, bool, error) {
+	if isv4 {
+		var admitMacs []*resource.AdmitMac
+		if err := tx.Fill(map[string]interface{}{resource.SqlColumnHwAddress: identifier},
+			&admitMacs); err != nil {
+			return "", false, false, errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameAdmit), pg.Error(err).Error())
+		} else if len(admitMacs) != 0 {
+			return resource.SimulateRuleAdmitMac, true, admitMacs[0].IsAdmitted, nil
+		}
+	} else {
+		var admitDuids []*resource.AdmitDuid
+		if err := tx.Fill(map[string]interface{}{resource.SqlColumnDuid: identifier},
+			&admitDuids); err != nil {
+			return "", false, false, errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameAdmit), pg.Error(err).Error())
+		} else if len(admitDuids) != 0 {
+			return resource.SimulateRuleAdmitDuid, true, admitDuids[0].IsAdmitted, nil
+		}
+	}
+
+	return "", false, false, nil
+}
+
+func getSimulateRateLimit(tx restdb.Transaction, isv4 bool, identifier string) (uint32, error) {
+	var rateLimits []*resource.RateLimit
+	if err := tx.Fill(nil, &rateLimits); err != nil {
+		return 0, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameRateLimit), pg.Error(err).Error())
+	} else if len(rateLimits) == 0 || !rateLimits[0].Enabled {
+		return 0, nil
+	}
+
+	if identifier != "" {
+		if isv4 {
+			var rateLimitMacs []*resource.RateLimitMac
+			if err := tx.Fill(map[string]interface{}{resource.SqlColumnHwAddress: identifier},
+				&rateLimitMacs); err != nil {
+				return 0, errorno.ErrDBError(errorno.ErrDBNameQuery,
+					string(errorno.ErrNameRateLimit), pg.Error(err).Error())
+			} else if len(rateLimitMacs) != 0 {
+				return rateLimitMacs[0].RateLimit, nil
+			}
+		} else {
+			var rateLimitDuids []*resource.RateLimitDuid
+			if err := tx.Fill(map[string]interface{}{resource.SqlColumnDuid: identifier},
+				&rateLimitDuids); err != nil {
+				return 0, errorno.ErrDBError(errorno.ErrDBNameQuery,
+					string(errorno.ErrNameRateLimit), pg.Error(err).Error())
+			} else if len(rateLimitDuids) != 0 {
+				return rateLimitDuids[0].RateLimit, nil
+			}
+		}
+	}
+
+	return rateLimits[0].GlobalRateLimit, nil
+}
+
+func getSimulateSubnet4Candidates(tx restdb.Transaction, input *resource.Simulate4Input) ([]*resource.Subnet4, string, error) {
+	var subnets []*resource.Subnet4
+	if err := tx.Fill(map[string]interface{}{resource.SqlOrderBy: resource.SqlColumnSubnetId},
+		&subnets); err != nil {
+		return nil, "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+	}
+
+	subnet := resource.SelectSubnet4(subnets, input)
+	if subnet == nil {
+		return nil, "", nil
+	}
+
+	var sharedNetworks []*resource.SharedNetwork4
+	if err := tx.FillEx(&sharedNetworks,
+		"select * from gr_shared_network4 where $1::numeric = any(subnet_ids)",
+		subnet.SubnetId); err != nil {
+		return nil, "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	} else if len(sharedNetworks) == 0 {
+		return []*resource.Subnet4{subnet}, "", nil
+	}
+
+	candidates := []*resource.Subnet4{subnet}
+	for _, subnetId := range sharedNetworks[0].SubnetIds {
+		for _, s := range subnets {
+			if s.SubnetId == subnetId && s != subnet {
+				candidates = append(candidates, s)
+			}
+		}
+	}
+
+	return candidates, sharedNetworks[0].Name, nil
+}
+
+func selectSimulatePool4OrReservation4(tx restdb.Transaction, subnet *resource.Subnet4, input *resource.Simulate4Input, members map[string]struct{}, result *resource.SimulateResult) (*resource.Pool4, *resource.Reservation4, error) {
+	reservations, err := getReservation4sWithCondition(tx, map[string]interface{}{
+		resource.SqlColumnSubnet4: subnet.GetID(), resource.SqlColumnHwAddress: input.HwAddress})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(reservations) == 0 && input.Hostname != "" {
+		if reservations, err = getReservation4sWithCondition(tx, map[string]interface{}{
+			resource.SqlColumnSubnet4: subnet.GetID(), resource.SqlColumnHostname: input.Hostname}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(reservations) != 0 {
+		reservation, err := getReservation4WithOptions(tx, subnet.GetID(), reservations[0].GetID())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pool, err := getPool4WithOptionsContainsIp(tx, subnet.GetID(), reservation)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result.Reservation = reservation.String()
+		result.Address = reservation.IpAddress
+		result.AddressType = resource.AddressTypeReservation
+		return pool, reservation, nil
+	}
+
+	pools, err := getPool4sWithCondition(tx, map[string]interface{}{
+		resource.SqlColumnSubnet4: subnet.GetID(), resource.SqlOrderBy: resource.SqlColumnBeginIp})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservedPools, err := getReservedPool4sWithCondition(tx, map[string]interface{}{
+		resource.SqlColumnSubnet4: subnet.GetID()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, address := resource.SelectPool4(pools, reservedPools, input.RequestedIp, members)
+	if pool == nil {
+		result.Reject(resource.SimulateRuleNoPool, subnet.Subnet)
+		return nil, nil, nil
+	}
+
+	if pool, err = getPool4WithOptions(tx, subnet.GetID(), pool.GetID()); err != nil {
+		return nil, nil, err
+	}
+
+	result.Pool = pool.String()
+	result.Address = address
+	result.AddressType = resource.AddressTypeDynamic
+	return pool, nil, nil
+}
+
+func simulate6(input *resource.Simulate6Input) (*resource.SimulateResult, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	result := &resource.SimulateResult{}
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return simulate6WithTx(tx, input, result)
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func simulate6WithTx(tx restdb.Transaction, input *resource.Simulate6Input, result *resource.SimulateResult) error {
+	clientType, err := getSimulateClientType(tx, input.ParameterRequestList, input.VendorClass)
+	if err != nil {
+		return err
+	}
+
+	result.ClientType = clientType
+	if rule, target, err := checkSimulateAdmit(tx, false, input.Duid, clientType); err != nil || rule != "" {
+		result.Reject(rule, target)
+		return err
+	}
+
+	if result.RateLimit, err = getSimulateRateLimit(tx, false, input.Duid); err != nil {
+		return err
+	}
+
+	var clientClasses []*resource.ClientClass6
+	if err := tx.Fill(nil, &clientClasses); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameClientClass), pg.Error(err).Error())
+	}
+
+	sort.Slice(clientClasses, func(i, j int) bool {
+		return clientClassBefore(clientClasses[i].GetCreationTimestamp(), clientClasses[i].Name,
+			clientClasses[j].GetCreationTimestamp(), clientClasses[j].Name)
+	})
+
+	clientClasses = resource.MatchClientClass6s(input, clientClasses)
+	members := make(map[string]struct{}, len(clientClasses))
+	for _, clientClass := range clientClasses {
+		members[clientClass.Name] = struct{}{}
+		result.ClientClasses = append(result.ClientClasses, clientClass.Name)
+		if clientClass.Options, err = getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeClientClass6,
+			resource.SqlColumnScopeId: clientClass.GetID()}); err != nil {
+			return err
+		}
+	}
+
+	candidates, sharedNetwork, err := getSimulateSubnet6Candidates(tx, input)
+	if err != nil {
+		return err
+	} else if len(candidates) == 0 {
+		result.Reject(resource.SimulateRuleNoSubnet, input.RelayAgentAddress+input.IfaceName)
+		return nil
+	}
+
+	result.SharedNetwork = sharedNetwork
+	for _, subnet := range candidates {
+		result.Rejection = nil
+		result.Subnet = subnet.Subnet
+		if len(subnet.Nodes) == 0 {
+			result.Reject(resource.SimulateRuleNoNode, subnet.Subnet)
+			continue
+		}
+
+		if rule, ok := resource.CheckClientClassesAdmitted(subnet.WhiteClientClassStrategy,
+			subnet.WhiteClientClasses, subnet.BlackClientClassStrategy,
+			subnet.BlackClientClasses, members); !ok {
+			result.Reject(rule, subnet.Subnet)
+			continue
+		}
+
+		if err := setSubnet6AddressCodeName(tx, subnet); err != nil {
+			return err
+		}
+
+		result.AddressCode = subnet.AddressCodeName
+		pool, reservation, err := selectSimulatePool6OrReservation6(tx, subnet, input, members, result)
+		if err != nil {
+			return err
+		} else if result.Rejection != nil {
+			continue
+		}
+
+		if subnet.Options, err = getOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope: resource.OptionScopeSubnet6, resource.SqlColumnScopeId: subnet.GetID(),
+		}); err != nil {
+			return err
+		}
+
+		config := resource.NewEffectiveConfig6(sharedNetwork, subnet, pool, reservation)
+		for _, clientClass := range clientClasses {
+			config.SetClientClassOption6s(clientClass.Options)
+		}
+
+		result.Options = config.Values
+		return nil
+	}
+
+	return nil
+}
+
+func getSimulateSubnet6Candidates(tx restdb.Transaction, input *resource.Simulate6Input) ([]*resource.Subnet6, string, error) {
+	var subnets []*resource.Subnet6
+	if err := tx.Fill(map[string]interface{}{resource.SqlOrderBy: resource.SqlColumnSubnetId},
+		&subnets); err != nil {
+		return nil, "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+	}
+
+	subnet := resource.SelectSubnet6(subnets, input)
+	if subnet == nil {
+		return nil, "", nil
+	}
+
+	var sharedNetworks []*resource.SharedNetwork6
+	if err := tx.FillEx(&sharedNetworks,
+		"select * from gr_shared_network6 where $1::numeric = any(subnet_ids)",
+		subnet.SubnetId); err != nil {
+		return nil, "", errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSharedNetwork), pg.Error(err).Error())
+	} else if len(sharedNetworks) == 0 {
+		return []*resource.Subnet6{subnet}, "", nil
+	}
+
+	candidates := []*resource.Subnet6{subnet}
+	for _, subnetId := range sharedNetworks[0].SubnetIds {
+		for _, s := range subnets {
+			if s.SubnetId == subnetId && s != subnet {
+				candidates = append(candidates, s)
+			}
+		}
+	}
+
+	return candidates, sharedNetworks[0].Name, nil
+}
+
+func selectSimulatePool6OrReservation6(tx restdb.Transaction, subnet *resource.Subnet6, input *resource.Simulate6Input, members map[string]struct{}, result *resource.SimulateResult) (*resource.Pool6, *resource.Reservation6, error) {
+	reservations, err := getReservation6sWithCondition(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnet.GetID(), resource.SqlColumnDuid: input.Duid})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(reservations) == 0 && input.HwAddress != "" {
+		if reservations, err = getReservation6sWithCondition(tx, map[string]interface{}{
+			resource.SqlColumnSubnet6: subnet.GetID(), resource.SqlColumnHwAddress: input.HwAddress}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(reservations) == 0 && input.Hostname != "" {
+		if reservations, err = getReservation6sWithCondition(tx, map[string]interface{}{
+			resource.SqlColumnSubnet6: subnet.GetID(), resource.SqlColumnHostname: input.Hostname}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(reservations) != 0 {
+		reservation, err := getReservation6WithOptions(tx, subnet.GetID(), reservations[0].GetID())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pool, err := getPool6WithOptionsContainsIp(tx, subnet.GetID(), reservation)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		result.Reservation = reservation.String()
+		if len(reservation.IpAddresses) != 0 {
+			result.Address = reservation.IpAddresses[0]
+		}
+
+		result.AddressType = resource.AddressTypeReservation
+		return pool, reservation, nil
+	}
+
+	pools, err := getPool6sWithCondition(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnet.GetID(), resource.SqlOrderBy: resource.SqlColumnBeginIp})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservedPools, err := getReservedPool6sWithCondition(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnet.GetID()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, address := resource.SelectPool6(pools, reservedPools, input.RequestedIp, members)
+	if pool == nil {
+		result.Reject(resource.SimulateRuleNoPool, subnet.Subnet)
+		return nil, nil, nil
+	}
+
+	if pool, err = getPool6WithOptions(tx, subnet.GetID(), pool.GetID()); err != nil {
+		return nil, nil, err
+	}
+
+	result.Pool = pool.String()
+	result.Address = address
+	result.AddressType = resource.AddressTypeDynamic
+	return pool, nil, nil
+}
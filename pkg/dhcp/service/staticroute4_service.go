@@ -0,0 +1,56 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+func saveStaticRoute4s(tx restdb.Transaction, subnetId string, routes []*resource.StaticRoute4) error {
+	if err := deleteStaticRoute4s(tx, subnetId); err != nil {
+		return err
+	}
+
+	for _, route := range routes {
+		route.Subnet4 = subnetId
+		if _, err := tx.Insert(route); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert, route.Destination,
+				pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func deleteStaticRoute4s(tx restdb.Transaction, subnetId string) error {
+	if _, err := tx.Delete(resource.TableStaticRoute4,
+		map[string]interface{}{resource.SqlColumnSubnet4: subnetId}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameDelete,
+			string(errorno.ErrNameStaticRoute), pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+func getStaticRoute4sMapWithSubnetIds(tx restdb.Transaction, subnetIds []string) (map[string][]*resource.StaticRoute4, error) {
+	if len(subnetIds) == 0 {
+		return nil, nil
+	}
+
+	var routes []*resource.StaticRoute4
+	if err := tx.Fill(map[string]interface{}{
+		resource.SqlColumnSubnet4: restdb.FillValue{
+			Operator: restdb.OperatorAny, Value: subnetIds}}, &routes); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameStaticRoute), pg.Error(err).Error())
+	}
+
+	routesMap := make(map[string][]*resource.StaticRoute4, len(subnetIds))
+	for _, route := range routes {
+		routesMap[route.Subnet4] = append(routesMap[route.Subnet4], route)
+	}
+
+	return routesMap, nil
+}
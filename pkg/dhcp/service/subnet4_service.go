@@ -3,6 +3,7 @@ package service
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math"
 	"strconv"
@@ -35,9 +36,14 @@ const (
 
 	FilterNameExcludeShared  = "exclude_shared"
 	FilterNameSharedNetwork4 = "shared_network4"
+	FilterNameSharedNetwork6 = "shared_network6"
 
-	ExcludeSharedState = "subnet_id not in (select subnet_id from gr_shared_network4 where subnet_id=any(subnet_ids))"
-	SharedNetworkState = "subnet_id = any((select subnet_ids from gr_shared_network4 where name = $"
+	FilterNameNetworkContainer4 = "network_container4"
+	FilterNameNetworkContainer6 = "network_container6"
+
+	ExcludeSharedState    = "subnet_id not in (select subnet_id from gr_%s where subnet_id=any(subnet_ids))"
+	SharedNetworkState    = "subnet_id = any((select subnet_ids from gr_%s where name = $"
+	NetworkContainerState = "network(ipnet::inet) <<= (select network(ipnet::inet) from gr_%s where name = $"
 )
 
 type Subnet4Service struct {
@@ -65,6 +71,15 @@ func (s *Subnet4Service) Create(subnet *resource.Subnet4) error {
 			return util.FormatDbInsertError(errorno.ErrNameNetwork, subnet.Subnet, err)
 		}
 
+		if err := saveOptionValue4s(tx, resource.OptionScopeSubnet4, subnet.GetID(),
+			subnet.GetID(), subnet.Options); err != nil {
+			return err
+		}
+
+		if err := saveStaticRoute4s(tx, subnet.GetID(), subnet.StaticRoutes); err != nil {
+			return err
+		}
+
 		return sendCreateSubnet4CmdToDHCPAgent(subnet)
 	})
 }
@@ -217,7 +232,25 @@ func pbSubnetOptionsFromSubnet4(subnet *resource.Subnet4) []*pbdhcpagent.SubnetO
 		})
 	}
 
-	return subnetOptions
+	if data := resource.EncodeStaticRoute4s(subnet.StaticRoutes, subnet.Routers); len(data) != 0 {
+		subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+			Name: "classless-static-route",
+			Code: resource.Option4CodeClasslessStaticRoute,
+			Data: hex.EncodeToString(data),
+			Type: resource.OptionDataTypeHex,
+		})
+
+		if subnet.UseOption249 {
+			subnetOptions = append(subnetOptions, &pbdhcpagent.SubnetOption{
+				Name: "ms-classless-static-route",
+				Code: resource.Option4CodeMsClasslessStaticRoute,
+				Data: hex.EncodeToString(data),
+				Type: resource.OptionDataTypeHex,
+			})
+		}
+	}
+
+	return append(subnetOptions, pbSubnetOptionsFromOptionValue4s(subnet.Options)...)
 }
 
 func (s *Subnet4Service) List(ctx *restresource.Context) ([]*resource.Subnet4, error) {
@@ -239,7 +272,8 @@ func (s *Subnet4Service) List(ctx *restresource.Context) ([]*resource.Subnet4, e
 			return errorno.ErrDBError(errorno.ErrDBNameQuery,
 				string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
 		}
-		return nil
+
+		return setSubnet4sOptionValues(tx, subnets)
 	}); err != nil {
 		return nil, err
 	}
@@ -260,6 +294,30 @@ func (s *Subnet4Service) List(ctx *restresource.Context) ([]*resource.Subnet4, e
 	return subnets, nil
 }
 
+func setSubnet4sOptionValues(tx restdb.Transaction, subnets []*resource.Subnet4) error {
+	subnetIds := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetIds = append(subnetIds, subnet.GetID())
+	}
+
+	valuesMap, err := getOptionValue4sMapWithScopeIds(tx, resource.OptionScopeSubnet4, subnetIds)
+	if err != nil {
+		return err
+	}
+
+	routesMap, err := getStaticRoute4sMapWithSubnetIds(tx, subnetIds)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range subnets {
+		subnet.Options = valuesMap[subnet.GetID()]
+		subnet.StaticRoutes = routesMap[subnet.GetID()]
+	}
+
+	return nil
+}
+
 type listSubnetContext struct {
 	countSql        string
 	sql             string
@@ -268,6 +326,7 @@ type listSubnetContext struct {
 	hasPagination   bool
 	hasExclude      bool
 	hasShared       bool
+	hasContainer    bool
 }
 
 func (l listSubnetContext) isUseIds() bool {
@@ -285,6 +344,14 @@ func genGetSubnetsContext(ctx *restresource.Context, table restdb.ResourceType)
 	var sharedNetworkState string
 	var excludeSharedState string
 	var excludeNodesState string
+	var containerState string
+	sharedNetworkTable, filterNameSharedNetwork := resource.TableSharedNetwork4, FilterNameSharedNetwork4
+	containerTable, filterNameContainer := resource.TableNetworkContainer4, FilterNameNetworkContainer4
+	if table == resource.TableSubnet6 {
+		sharedNetworkTable, filterNameSharedNetwork = resource.TableSharedNetwork6, FilterNameSharedNetwork6
+		containerTable, filterNameContainer = resource.TableNetworkContainer6, FilterNameNetworkContainer6
+	}
+
 	for _, filter := range ctx.GetFilters() {
 		switch filter.Name {
 		case util.FilterNameSubnet:
@@ -299,12 +366,21 @@ func genGetSubnetsContext(ctx *restresource.Context, table restdb.ResourceType)
 				value == "true" {
 				listCtx.hasExclude = true
 				excludeNodesState = "nodes != '{}'"
-				excludeSharedState = ExcludeSharedState
+				excludeSharedState = fmt.Sprintf(ExcludeSharedState, sharedNetworkTable)
 			}
-		case FilterNameSharedNetwork4:
+		case filterNameSharedNetwork:
 			if value, ok := util.GetFilterValueWithEqModifierFromFilter(filter); ok {
 				listCtx.hasShared = true
-				sharedNetworkState = SharedNetworkState + strconv.Itoa(seq) + ")::numeric[])"
+				sharedNetworkState = fmt.Sprintf(SharedNetworkState, sharedNetworkTable) +
+					strconv.Itoa(seq) + ")::numeric[])"
+				listCtx.params = append(listCtx.params, value)
+				seq += 1
+			}
+		case filterNameContainer:
+			if value, ok := util.GetFilterValueWithEqModifierFromFilter(filter); ok {
+				listCtx.hasContainer = true
+				containerState = fmt.Sprintf(NetworkContainerState, containerTable) +
+					strconv.Itoa(seq) + ")"
 				listCtx.params = append(listCtx.params, value)
 				seq += 1
 			}
@@ -330,6 +406,10 @@ func genGetSubnetsContext(ctx *restresource.Context, table restdb.ResourceType)
 		whereStates = append(whereStates, sharedNetworkState)
 	}
 
+	if listCtx.hasContainer {
+		whereStates = append(whereStates, containerState)
+	}
+
 	if len(whereStates) != 0 {
 		sqls = append(sqls, "where")
 		sqls = append(sqls, strings.Join(whereStates, " and "))
@@ -444,9 +524,13 @@ func getSubnetNodeNamesAndIds(nodes []string, nodeNames map[string]Agent) ([]str
 func (s *Subnet4Service) Get(id string) (*resource.Subnet4, error) {
 	var subnets []*resource.Subnet4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		return tx.Fill(map[string]interface{}{restdb.IDField: id}, &subnets)
+		if err := tx.Fill(map[string]interface{}{restdb.IDField: id}, &subnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		}
+
+		return setSubnet4sOptionValues(tx, subnets)
 	}); err != nil {
-		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		return nil, err
 	} else if len(subnets) == 0 {
 		return nil, errorno.ErrNotFound(errorno.ErrNameNetworkV4, id)
 	}
@@ -489,12 +573,20 @@ func getSubnet4LeasesCount(subnet *resource.Subnet4) (uint64, error) {
 }
 
 func (s *Subnet4Service) Update(subnet *resource.Subnet4) error {
-	if err := subnet.ValidateParams(nil); err != nil {
-		return err
-	}
-
 	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if err := setSubnet4FromDB(tx, subnet); err != nil {
+		oldSubnet, err := getSubnet4FromDB(tx, subnet.GetID())
+		if err != nil {
+			return err
+		}
+
+		setSubnet4FromOldSubnet(subnet, oldSubnet)
+		subnet.ResetInheritedFields(oldSubnet)
+
+		if err := subnet.ValidateParams(nil); err != nil {
+			return err
+		}
+
+		if err := subnet.ValidateStaticRoutes(); err != nil {
 			return err
 		}
 
@@ -521,12 +613,23 @@ func (s *Subnet4Service) Update(subnet *resource.Subnet4) error {
 			resource.SqlColumnDomainSearchList:         subnet.DomainSearchList,
 			resource.SqlColumnCapWapACAddresses:        subnet.CapWapACAddresses,
 			resource.SqlColumnAutoReservationType:      subnet.AutoReservationType,
+			resource.SqlColumnUseOption249:             subnet.UseOption249,
 			resource.SqlColumnTags:                     subnet.Tags,
+			resource.SqlColumnInheritedFields:          subnet.InheritedFields,
 		}, map[string]interface{}{restdb.IDField: subnet.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameUpdate, subnet.GetID(),
 				pg.Error(err).Error())
 		}
 
+		if err := saveOptionValue4s(tx, resource.OptionScopeSubnet4, subnet.GetID(),
+			subnet.GetID(), subnet.Options); err != nil {
+			return err
+		}
+
+		if err := saveStaticRoute4s(tx, subnet.GetID(), subnet.StaticRoutes); err != nil {
+			return err
+		}
+
 		return sendUpdateSubnet4CmdToDHCPAgent(subnet)
 	})
 }
@@ -537,12 +640,16 @@ func setSubnet4FromDB(tx restdb.Transaction, subnet *resource.Subnet4) error {
 		return err
 	}
 
+	setSubnet4FromOldSubnet(subnet, oldSubnet)
+	return nil
+}
+
+func setSubnet4FromOldSubnet(subnet, oldSubnet *resource.Subnet4) {
 	subnet.SubnetId = oldSubnet.SubnetId
 	subnet.Capacity = oldSubnet.Capacity
 	subnet.Subnet = oldSubnet.Subnet
 	subnet.Ipnet = oldSubnet.Ipnet
 	subnet.Nodes = oldSubnet.Nodes
-	return nil
 }
 
 func getSubnet4FromDB(tx restdb.Transaction, subnetId string) (*resource.Subnet4, error) {
@@ -597,6 +704,15 @@ func (s *Subnet4Service) Delete(subnet *resource.Subnet4) error {
 				pg.Error(err).Error())
 		}
 
+		if err := deleteOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnSubnet4: subnet.GetID()}); err != nil {
+			return err
+		}
+
+		if err := deleteStaticRoute4s(tx, subnet.GetID()); err != nil {
+			return err
+		}
+
 		return sendDeleteSubnet4CmdToDHCPAgent(subnet, subnet.Nodes)
 	})
 }
@@ -795,31 +911,43 @@ func parseSubnet4sFromFile(fileName string, oldSubnets []*resource.Subnet4, sent
 		return nil, nil, nil, nil, nil, nil
 	}
 
-	sqls := make([]string, 0, 4)
+	sqls, reqsForSentryCreate, reqsForSentryDelete, reqForServerCreate,
+		reqForServerDelete := subnet4sAndPoolsToInsertSqlsAndRequests(subnets, subnetPools,
+		subnetReservedPools, subnetReservations)
+	return sqls, reqsForSentryCreate, reqsForSentryDelete, reqForServerCreate,
+		reqForServerDelete, nil
+}
+
+func subnet4sAndPoolsToInsertSqlsAndRequests(subnets []*resource.Subnet4, subnetPools map[uint64][]*resource.Pool4, subnetReservedPools map[uint64][]*resource.ReservedPool4, subnetReservations map[uint64][]*resource.Reservation4) ([]string, map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, map[string]*pbdhcpagent.DeleteSubnets4Request, *pbdhcpagent.CreateSubnets4AndPoolsRequest, *pbdhcpagent.DeleteSubnets4Request) {
+	sqls := make([]string, 0, 5)
 	reqsForSentryCreate := make(map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest)
 	reqForServerCreate := &pbdhcpagent.CreateSubnets4AndPoolsRequest{}
 	reqsForSentryDelete := make(map[string]*pbdhcpagent.DeleteSubnets4Request)
 	reqForServerDelete := &pbdhcpagent.DeleteSubnets4Request{}
-	subnetAndNodes := make(map[uint64][]string, len(subnets))
+	subnets4Map := make(map[uint64]*resource.Subnet4, len(subnets))
 	sqls = append(sqls, subnet4sToInsertSqlAndRequest(subnets, reqsForSentryCreate,
-		reqForServerCreate, reqsForSentryDelete, reqForServerDelete, subnetAndNodes))
+		reqForServerCreate, reqsForSentryDelete, reqForServerDelete, subnets4Map))
+	if sql := staticRoute4sToInsertSql(subnets); sql != "" {
+		sqls = append(sqls, sql)
+	}
+
 	if len(subnetPools) != 0 {
 		sqls = append(sqls, pool4sToInsertSqlAndRequest(subnetPools,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets4Map))
 	}
 
 	if len(subnetReservedPools) != 0 {
 		sqls = append(sqls, reservedPool4sToInsertSqlAndRequest(subnetReservedPools,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets4Map))
 	}
 
 	if len(subnetReservations) != 0 {
 		sqls = append(sqls, reservation4sToInsertSqlAndRequest(subnetReservations,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets4Map))
 	}
 
 	return sqls, reqsForSentryCreate, reqsForSentryDelete, reqForServerCreate,
-		reqForServerDelete, nil
+		reqForServerDelete
 }
 
 func addFailDataToResponse(response *excel.ImportResult, headerLen int, resourceSlices []string, errStr string) {
@@ -927,6 +1055,13 @@ func parseSubnet4sAndPools(tableHeaderFields, fields []string) (*resource.Subnet
 			subnet.Nodes = splitFieldWithoutSpace(field)
 		case FieldNameNextServer:
 			subnet.NextServer = strings.TrimSpace(field)
+		case FieldNameStaticRoutes:
+			if subnet.StaticRoutes, err = parseStaticRoute4sFromString(
+				strings.TrimSpace(field)); err != nil {
+				return subnet, pools, reservedPools, reservations, err
+			}
+		case FieldNameOption249:
+			subnet.UseOption249 = internationalizationBoolSwitch(strings.TrimSpace(field))
 		case FieldNamePools:
 			if pools, err = parsePool4sFromString(strings.TrimSpace(field)); err != nil {
 				return subnet, pools, reservedPools, reservations, err
@@ -947,6 +1082,25 @@ func parseSubnet4sAndPools(tableHeaderFields, fields []string) (*resource.Subnet
 	return subnet, pools, reservedPools, reservations, nil
 }
 
+func parseStaticRoute4sFromString(field string) ([]*resource.StaticRoute4, error) {
+	var routes []*resource.StaticRoute4
+	for _, routeStr := range strings.Split(field, resource.CommonDelimiter) {
+		routeStr = strings.TrimSpace(routeStr)
+		if routeSlices := strings.SplitN(routeStr, resource.PoolDelimiter,
+			2); len(routeSlices) != 2 {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameStaticRoute, routeStr)
+		} else {
+			routes = append(routes, &resource.StaticRoute4{
+				Destination: strings.TrimSpace(routeSlices[0]),
+				NextHops: strings.Split(strings.Replace(routeSlices[1], " ", "", -1),
+					resource.ReservationAddrDelimiter),
+			})
+		}
+	}
+
+	return routes, nil
+}
+
 func parsePool4sFromString(field string) ([]*resource.Pool4, error) {
 	var pools []*resource.Pool4
 	for _, poolStr := range strings.Split(field, resource.CommonDelimiter) {
@@ -1138,7 +1292,7 @@ func checkPool4sValid(subnet4 *resource.Subnet4, pools []*resource.Pool4, reserv
 	return nil
 }
 
-func subnet4sToInsertSqlAndRequest(subnets []*resource.Subnet4, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryDelete map[string]*pbdhcpagent.DeleteSubnets4Request, reqForServerDelete *pbdhcpagent.DeleteSubnets4Request, subnetAndNodes map[uint64][]string) string {
+func subnet4sToInsertSqlAndRequest(subnets []*resource.Subnet4, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryDelete map[string]*pbdhcpagent.DeleteSubnets4Request, reqForServerDelete *pbdhcpagent.DeleteSubnets4Request, subnets4Map map[uint64]*resource.Subnet4) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_subnet4 VALUES ")
 	for _, subnet := range subnets {
@@ -1147,7 +1301,7 @@ func subnet4sToInsertSqlAndRequest(subnets []*resource.Subnet4, reqsForSentryCre
 			continue
 		}
 
-		subnetAndNodes[subnet.SubnetId] = subnet.Nodes
+		subnets4Map[subnet.SubnetId] = subnet
 		pbSubnet := subnet4ToCreateSubnet4Request(subnet)
 		reqForServerCreate.Subnets = append(reqForServerCreate.Subnets, pbSubnet)
 		reqForServerDelete.Ids = append(reqForServerDelete.Ids, subnet.SubnetId)
@@ -1168,15 +1322,30 @@ func subnet4sToInsertSqlAndRequest(subnets []*resource.Subnet4, reqsForSentryCre
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func pool4sToInsertSqlAndRequest(subnetPools map[uint64][]*resource.Pool4, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func staticRoute4sToInsertSql(subnets []*resource.Subnet4) string {
+	var buf bytes.Buffer
+	for _, subnet := range subnets {
+		for _, route := range subnet.StaticRoutes {
+			buf.WriteString(staticRoute4ToInsertDBSqlString(subnet.SubnetId, route))
+		}
+	}
+
+	if buf.Len() == 0 {
+		return ""
+	}
+
+	return "INSERT INTO gr_static_route4 VALUES " + strings.TrimSuffix(buf.String(), ",") + ";"
+}
+
+func pool4sToInsertSqlAndRequest(subnetPools map[uint64][]*resource.Pool4, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, subnets4Map map[uint64]*resource.Subnet4) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_pool4 VALUES ")
 	for subnetId, pools := range subnetPools {
 		for _, pool := range pools {
 			buf.WriteString(pool4ToInsertDBSqlString(subnetId, pool))
-			pbPool := pool4ToCreatePool4Request(subnetId, pool)
+			pbPool := pool4ToCreatePool4Request(subnets4Map[subnetId], pool)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets4Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.Pools = append(req.Pools, pbPool)
@@ -1192,7 +1361,7 @@ func pool4sToInsertSqlAndRequest(subnetPools map[uint64][]*resource.Pool4, reqFo
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func reservedPool4sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resource.ReservedPool4, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func reservedPool4sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resource.ReservedPool4, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, subnets4Map map[uint64]*resource.Subnet4) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_reserved_pool4 VALUES ")
 	for subnetId, pools := range subnetReservedPools {
@@ -1200,7 +1369,7 @@ func reservedPool4sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resou
 			buf.WriteString(reservedPool4ToInsertDBSqlString(subnetId, pool))
 			pbReservedPool := reservedPool4ToCreateReservedPool4Request(subnetId, pool)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets4Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.ReservedPools = append(req.ReservedPools, pbReservedPool)
@@ -1217,15 +1386,15 @@ func reservedPool4sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resou
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func reservation4sToInsertSqlAndRequest(subnetReservations map[uint64][]*resource.Reservation4, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func reservation4sToInsertSqlAndRequest(subnetReservations map[uint64][]*resource.Reservation4, reqForServerCreate *pbdhcpagent.CreateSubnets4AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets4AndPoolsRequest, subnets4Map map[uint64]*resource.Subnet4) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_reservation4 VALUES ")
 	for subnetId, reservations := range subnetReservations {
 		for _, reservation := range reservations {
 			buf.WriteString(reservation4ToInsertDBSqlString(subnetId, reservation))
-			pbReservation := reservation4ToCreateReservation4Request(subnetId, reservation)
+			pbReservation := reservation4ToCreateReservation4Request(subnets4Map[subnetId], reservation)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets4Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.Reservations = append(req.Reservations, pbReservation)
@@ -1311,6 +1480,7 @@ func (s *Subnet4Service) ExportExcel() (interface{}, error) {
 	var pools []*resource.Pool4
 	var reservedPools []*resource.ReservedPool4
 	var reservations []*resource.Reservation4
+	var staticRoutes []*resource.StaticRoute4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
 		if err := tx.Fill(map[string]interface{}{
 			resource.SqlOrderBy: resource.SqlColumnSubnetId}, &subnet4s); err != nil {
@@ -1318,6 +1488,11 @@ func (s *Subnet4Service) ExportExcel() (interface{}, error) {
 				string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
 		}
 
+		if err := tx.Fill(nil, &staticRoutes); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameStaticRoute), pg.Error(err).Error())
+		}
+
 		if err := tx.Fill(nil, &pools); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameQuery,
 				string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
@@ -1361,8 +1536,14 @@ func (s *Subnet4Service) ExportExcel() (interface{}, error) {
 		subnetReservations[reservation.Subnet4] = reservationSlices
 	}
 
+	subnetStaticRoutes := make(map[string][]*resource.StaticRoute4, len(subnet4s))
+	for _, route := range staticRoutes {
+		subnetStaticRoutes[route.Subnet4] = append(subnetStaticRoutes[route.Subnet4], route)
+	}
+
 	strMatrix := make([][]string, 0, len(subnet4s))
 	for _, subnet4 := range subnet4s {
+		subnet4.StaticRoutes = subnetStaticRoutes[subnet4.GetID()]
 		subnetSlices := localizationSubnet4ToStrSlice(subnet4)
 		slices := make([]string, TableHeaderSubnet4Len)
 		copy(slices, subnetSlices)
@@ -1563,6 +1744,27 @@ func genCreateSubnets4AndPoolsRequestWithSubnet4(tx restdb.Transaction, subnet4
 			string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
 	}
 
+	valuesMap, err := getOptionValue4sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet4: subnet4.GetID()})
+	if err != nil {
+		return nil, "", err
+	}
+
+	subnet4.Options = valuesMap[subnet4.GetID()]
+	routesMap, err := getStaticRoute4sMapWithSubnetIds(tx, []string{subnet4.GetID()})
+	if err != nil {
+		return nil, "", err
+	}
+
+	subnet4.StaticRoutes = routesMap[subnet4.GetID()]
+	for _, pool := range pools {
+		pool.Options = valuesMap[pool.GetID()]
+	}
+
+	for _, reservation := range reservations {
+		reservation.Options = valuesMap[reservation.GetID()]
+	}
+
 	if len(pools) == 0 && len(reservedPools) == 0 && len(reservations) == 0 {
 		return subnet4ToCreateSubnet4Request(subnet4), kafka.CreateSubnet4, nil
 	}
@@ -1571,7 +1773,7 @@ func genCreateSubnets4AndPoolsRequestWithSubnet4(tx restdb.Transaction, subnet4
 		Subnets: []*pbdhcpagent.CreateSubnet4Request{subnet4ToCreateSubnet4Request(subnet4)},
 	}
 	for _, pool := range pools {
-		req.Pools = append(req.Pools, pool4ToCreatePool4Request(subnet4.SubnetId, pool))
+		req.Pools = append(req.Pools, pool4ToCreatePool4Request(subnet4, pool))
 	}
 
 	for _, pool := range reservedPools {
@@ -1581,7 +1783,7 @@ func genCreateSubnets4AndPoolsRequestWithSubnet4(tx restdb.Transaction, subnet4
 
 	for _, reservation := range reservations {
 		req.Reservations = append(req.Reservations,
-			reservation4ToCreateReservation4Request(subnet4.SubnetId, reservation))
+			reservation4ToCreateReservation4Request(subnet4, reservation))
 	}
 
 	return req, kafka.CreateSubnet4sAndPools, nil
@@ -1664,3 +1866,154 @@ func getSubnet4WithPrefix(tx restdb.Transaction, prefix string) (*resource.Subne
 		return subnets[0], nil
 	}
 }
+
+func (s *Subnet4Service) EffectiveConfig(subnetId string) (*resource.EffectiveConfig, error) {
+	return getEffectiveConfig4(subnetId, "", "")
+}
+
+func (s *Subnet4Service) Simulate(input *resource.Simulate4Input) (*resource.SimulateResult, error) {
+	return simulate4(input)
+}
+
+func (s *Subnet4Service) CreateFromTemplate(input *resource.SubnetsFromTemplateInput) (*resource.Subnet4ListOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	dhcpConfig, err := resource.GetDhcpConfig(true)
+	if err != nil {
+		return nil, err
+	}
+
+	clientClass4s, err := resource.GetClientClass4s()
+	if err != nil {
+		return nil, err
+	}
+
+	sentryNodes, serverNodes, sentryVip, err := kafka.GetDHCPNodes(kafka.AgentStack4)
+	if err != nil {
+		return nil, err
+	}
+
+	sentryNodesForCheck := sentryNodes
+	if sentryVip != "" {
+		sentryNodesForCheck = []string{sentryVip}
+	}
+
+	subnets := make([]*resource.Subnet4, 0, len(input.Subnets))
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		template, err := getSubnet4TemplateWithOptions(tx, input.Template)
+		if err != nil {
+			return err
+		}
+
+		var oldSubnets []*resource.Subnet4
+		if err := tx.Fill(map[string]interface{}{resource.SqlOrderBy: "subnet_id desc"},
+			&oldSubnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+		}
+
+		if len(oldSubnets)+len(input.Subnets) > config.GetMaxSubnetsCount() {
+			return errorno.ErrExceedMaxCount(errorno.ErrNameNetworkV4,
+				config.GetMaxSubnetsCount())
+		}
+
+		var maxOldSubnetId uint64
+		if len(oldSubnets) != 0 {
+			maxOldSubnetId = oldSubnets[0].SubnetId
+		}
+
+		subnetPools := make(map[uint64][]*resource.Pool4, len(input.Subnets))
+		subnetReservedPools := make(map[uint64][]*resource.ReservedPool4, len(input.Subnets))
+		for _, subnetFromTemplate := range input.Subnets {
+			subnet := template.NewSubnet4(subnetFromTemplate)
+			if err := subnet.Validate(dhcpConfig, clientClass4s); err != nil {
+				return err
+			}
+
+			if err := checkSubnetNodesValid(subnet.Nodes, sentryNodesForCheck); err != nil {
+				return err
+			}
+
+			if err := checkSubnet4ConflictWithSubnet4s(subnet,
+				append(oldSubnets, subnets...)); err != nil {
+				return err
+			}
+
+			pools, reservedPools, err := parsePool4sFromSubnet4Template(tx, template, subnet)
+			if err != nil {
+				return err
+			}
+
+			subnet.SubnetId = maxOldSubnetId + uint64(len(subnets)) + 1
+			subnet.SetID(strconv.FormatUint(subnet.SubnetId, 10))
+			subnets = append(subnets, subnet)
+			if len(pools) != 0 {
+				subnetPools[subnet.SubnetId] = pools
+			}
+
+			if len(reservedPools) != 0 {
+				subnetReservedPools[subnet.SubnetId] = reservedPools
+			}
+		}
+
+		sqls, reqsForSentryCreate, reqsForSentryDelete, reqForServerCreate,
+			reqForServerDelete := subnet4sAndPoolsToInsertSqlsAndRequests(subnets,
+			subnetPools, subnetReservedPools, nil)
+		for _, sql := range sqls {
+			if _, err := tx.Exec(sql); err != nil {
+				return errorno.ErrDBError(errorno.ErrDBNameInsert,
+					string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+			}
+		}
+
+		for _, subnet := range subnets {
+			if err := saveOptionValue4s(tx, resource.OptionScopeSubnet4, subnet.GetID(),
+				subnet.GetID(), subnet.Options); err != nil {
+				return err
+			}
+		}
+
+		if len(reqForServerCreate.Subnets) == 0 {
+			return nil
+		}
+
+		if sentryVip != "" {
+			return sendCreateSubnet4sAndPoolsCmdToDHCPAgentWithHA(sentryNodes,
+				reqForServerCreate)
+		} else {
+			return sendCreateSubnet4sAndPoolsCmdToDHCPAgent(serverNodes, reqsForSentryCreate,
+				reqsForSentryDelete, reqForServerCreate, reqForServerDelete)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return &resource.Subnet4ListOutput{Subnet4s: subnets}, nil
+}
+
+func parsePool4sFromSubnet4Template(tx restdb.Transaction, template *resource.Subnet4Template, subnet *resource.Subnet4) ([]*resource.Pool4, []*resource.ReservedPool4, error) {
+	pools, reservedPools := template.NewPool4s()
+	for _, pool := range pools {
+		if err := pool.ParseAddressWithTemplate(tx, subnet); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, reservedPool := range reservedPools {
+		if err := reservedPool.ParseAddressWithTemplate(tx, subnet); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := checkReservedPool4sValid(subnet, reservedPools, nil); err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkPool4sValid(subnet, pools, reservedPools, nil); err != nil {
+		return nil, nil, err
+	}
+
+	return pools, reservedPools, nil
+}
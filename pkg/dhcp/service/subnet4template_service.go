@@ -0,0 +1,187 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type Subnet4TemplateService struct {
+}
+
+func NewSubnet4TemplateService() *Subnet4TemplateService {
+	return &Subnet4TemplateService{}
+}
+
+func (s *Subnet4TemplateService) Create(template *resource.Subnet4Template) error {
+	if err := template.Validate(nil); err != nil {
+		return err
+	}
+
+	template.SetID(template.Name)
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkPoolTemplatesExist(tx, resource.TablePool4Template,
+			append(template.PoolTemplates, template.ReservedPoolTemplates...)); err != nil {
+			return err
+		}
+
+		if _, err := tx.Insert(template); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameSubnetTemplate, template.Name, err)
+		}
+
+		return saveOptionValue4s(tx, resource.OptionScopeSubnet4Template, template.GetID(),
+			"", template.Options)
+	})
+}
+
+func checkPoolTemplatesExist(tx restdb.Transaction, table restdb.ResourceType, names []string) error {
+	for _, name := range names {
+		if exists, err := tx.Exists(table, map[string]interface{}{
+			resource.SqlColumnName: name}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, name, pg.Error(err).Error())
+		} else if !exists {
+			return errorno.ErrNotFound(errorno.ErrNameTemplate, name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Subnet4TemplateService) List(conditions map[string]interface{}) ([]*resource.Subnet4Template, error) {
+	var templates []*resource.Subnet4Template
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(conditions, &templates); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameSubnetTemplate), pg.Error(err).Error())
+		}
+
+		templateIds := make([]string, 0, len(templates))
+		for _, template := range templates {
+			templateIds = append(templateIds, template.GetID())
+		}
+
+		optionsMap, err := getOptionValue4sMapWithScopeIds(tx,
+			resource.OptionScopeSubnet4Template, templateIds)
+		if err != nil {
+			return err
+		}
+
+		for _, template := range templates {
+			template.Options = optionsMap[template.GetID()]
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (s *Subnet4TemplateService) Get(id string) (*resource.Subnet4Template, error) {
+	var template *resource.Subnet4Template
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		template, err = getSubnet4TemplateWithOptions(tx, id)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func getSubnet4TemplateWithOptions(tx restdb.Transaction, id string) (*resource.Subnet4Template, error) {
+	var templates []*resource.Subnet4Template
+	if err := tx.Fill(map[string]interface{}{restdb.IDField: id}, &templates); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+	} else if len(templates) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameSubnetTemplate, id)
+	}
+
+	options, err := getOptionValue4s(tx, map[string]interface{}{
+		resource.SqlColumnScope: resource.OptionScopeSubnet4Template, resource.SqlColumnScopeId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	templates[0].Options = options
+	return templates[0], nil
+}
+
+func (s *Subnet4TemplateService) Update(template *resource.Subnet4Template) error {
+	if err := template.Validate(nil); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkPoolTemplatesExist(tx, resource.TablePool4Template,
+			append(template.PoolTemplates, template.ReservedPoolTemplates...)); err != nil {
+			return err
+		}
+
+		if rows, err := tx.Update(resource.TableSubnet4Template, map[string]interface{}{
+			resource.SqlColumnWhiteClientClassStrategy: template.WhiteClientClassStrategy,
+			resource.SqlColumnWhiteClientClasses:       template.WhiteClientClasses,
+			resource.SqlColumnBlackClientClassStrategy: template.BlackClientClassStrategy,
+			resource.SqlColumnBlackClientClasses:       template.BlackClientClasses,
+			resource.SqlColumnValidLifetime:            template.ValidLifetime,
+			resource.SqlColumnMaxValidLifetime:         template.MaxValidLifetime,
+			resource.SqlColumnMinValidLifetime:         template.MinValidLifetime,
+			resource.SqlColumnNextServer:               template.NextServer,
+			resource.SqlColumnSubnetMask:               template.SubnetMask,
+			resource.SqlColumnDomainServers:            template.DomainServers,
+			resource.SqlColumnTftpServer:               template.TftpServer,
+			resource.SqlColumnBootfile:                 template.Bootfile,
+			resource.SqlColumnIpv6OnlyPreferred:        template.Ipv6OnlyPreferred,
+			resource.SqlColumnCaptivePortalUrl:         template.CaptivePortalUrl,
+			resource.SqlColumnCapWapACAddresses:        template.CapWapACAddresses,
+			resource.SqlColumnDomainSearchList:         template.DomainSearchList,
+			resource.SqlColumnAutoReservationType:      template.AutoReservationType,
+			resource.SqlColumnUseOption249:             template.UseOption249,
+			resource.SqlColumnNodes:                    template.Nodes,
+			resource.SqlColumnPoolTemplates:            template.PoolTemplates,
+			resource.SqlColumnReservedPoolTemplates:    template.ReservedPoolTemplates,
+			resource.SqlColumnComment:                  template.Comment,
+		}, map[string]interface{}{restdb.IDField: template.GetID()}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, template.GetID(),
+				pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameSubnetTemplate, template.GetID())
+		}
+
+		return saveOptionValue4s(tx, resource.OptionScopeSubnet4Template, template.GetID(),
+			"", template.Options)
+	})
+}
+
+func (s *Subnet4TemplateService) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Delete(resource.TableSubnet4Template, map[string]interface{}{
+			restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameSubnetTemplate, id)
+		}
+
+		return deleteOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeSubnet4Template,
+			resource.SqlColumnScopeId: id})
+	})
+}
+
+func checkPoolTemplateUsedBySubnetTemplate(tx restdb.Transaction, table restdb.ResourceType, name string) error {
+	if count, err := tx.CountEx(table,
+		"select count(*) from gr_"+string(table)+" where $1::text = any(pool_templates) or $1::text = any(reserved_pool_templates)",
+		name); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameCount, string(errorno.ErrNameSubnetTemplate),
+			pg.Error(err).Error())
+	} else if count != 0 {
+		return errorno.ErrBeenUsed(errorno.ErrNameTemplate, name)
+	} else {
+		return nil
+	}
+}
@@ -57,6 +57,11 @@ func (s *Subnet6Service) Create(subnet *resource.Subnet6) error {
 			return util.FormatDbInsertError(errorno.ErrNameNetwork, subnet.Subnet, err)
 		}
 
+		if err := saveOptionValue6s(tx, resource.OptionScopeSubnet6, subnet.GetID(),
+			subnet.GetID(), subnet.Options); err != nil {
+			return err
+		}
+
 		return sendCreateSubnet6CmdToDHCPAgent(subnet)
 	})
 }
@@ -192,7 +197,7 @@ func pbSubnetOptionsFromSubnet6(subnet *resource.Subnet6) []*pbdhcpagent.SubnetO
 		})
 	}
 
-	return subnetOptions
+	return append(subnetOptions, pbSubnetOptionsFromOptionValue6s(subnet.Options)...)
 }
 
 func (s *Subnet6Service) List(ctx *restresource.Context) ([]*resource.Subnet6, error) {
@@ -221,7 +226,7 @@ func (s *Subnet6Service) List(ctx *restresource.Context) ([]*resource.Subnet6, e
 				string(errorno.ErrNameAddressCode), pg.Error(err).Error())
 		}
 
-		return nil
+		return setSubnet6sOptionValues(tx, subnets)
 	}); err != nil {
 		return nil, err
 	}
@@ -241,6 +246,24 @@ func (s *Subnet6Service) List(ctx *restresource.Context) ([]*resource.Subnet6, e
 	return subnets, nil
 }
 
+func setSubnet6sOptionValues(tx restdb.Transaction, subnets []*resource.Subnet6) error {
+	subnetIds := make([]string, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnetIds = append(subnetIds, subnet.GetID())
+	}
+
+	valuesMap, err := getOptionValue6sMapWithScopeIds(tx, resource.OptionScopeSubnet6, subnetIds)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range subnets {
+		subnet.Options = valuesMap[subnet.GetID()]
+	}
+
+	return nil
+}
+
 func SetSubnet6sLeasesUsedInfo(subnets []*resource.Subnet6, useIds bool) (err error) {
 	if len(subnets) == 0 {
 		return nil
@@ -332,6 +355,10 @@ func (s *Subnet6Service) Get(id string) (*resource.Subnet6, error) {
 			return
 		}
 
+		if err = setSubnet6sOptionValues(tx, []*resource.Subnet6{subnet6}); err != nil {
+			return
+		}
+
 		return setSubnet6AddressCodeName(tx, subnet6)
 	}); err != nil {
 		return nil, err
@@ -393,22 +420,27 @@ func getSubnet6LeasesCount(subnet *resource.Subnet6) (uint64, error) {
 }
 
 func (s *Subnet6Service) Update(subnet *resource.Subnet6) error {
-	if err := subnet.ValidateParams(nil, nil); err != nil {
-		return err
-	}
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		oldSubnet, err := getSubnet6FromDB(tx, subnet.GetID())
+		if err != nil {
+			return err
+		}
 
-	newSubnet := &resource.Subnet6{
-		EmbedIpv4:           subnet.EmbedIpv4,
-		UseEui64:            subnet.UseEui64,
-		AddressCode:         subnet.AddressCode,
-		AutoReservationType: subnet.AutoReservationType,
-	}
+		subnet.ResetInheritedFields(oldSubnet)
 
-	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
-		if err := setSubnet6FromDB(tx, subnet); err != nil {
+		if err := subnet.ValidateParams(nil, nil); err != nil {
 			return err
 		}
 
+		newSubnet := &resource.Subnet6{
+			EmbedIpv4:           subnet.EmbedIpv4,
+			UseEui64:            subnet.UseEui64,
+			AddressCode:         subnet.AddressCode,
+			AutoReservationType: subnet.AutoReservationType,
+		}
+
+		setSubnet6FromOldSubnet(subnet, oldSubnet)
+
 		if err := checkUpdateAutoGenAddrFactor(tx, subnet, newSubnet); err != nil {
 			return err
 		}
@@ -438,11 +470,17 @@ func (s *Subnet6Service) Update(subnet *resource.Subnet6) error {
 			resource.SqlColumnAddressCode:              subnet.AddressCode,
 			resource.SqlColumnAutoReservationType:      subnet.AutoReservationType,
 			resource.SqlColumnCapacity:                 subnet.Capacity,
+			resource.SqlColumnInheritedFields:          subnet.InheritedFields,
 		}, map[string]interface{}{restdb.IDField: subnet.GetID()}); err != nil {
 			return errorno.ErrDBError(errorno.ErrDBNameQuery, subnet.GetID(),
 				pg.Error(err).Error())
 		}
 
+		if err := saveOptionValue6s(tx, resource.OptionScopeSubnet6, subnet.GetID(),
+			subnet.GetID(), subnet.Options); err != nil {
+			return err
+		}
+
 		return sendUpdateSubnet6CmdToDHCPAgent(subnet)
 	})
 }
@@ -453,6 +491,11 @@ func setSubnet6FromDB(tx restdb.Transaction, subnet *resource.Subnet6) error {
 		return err
 	}
 
+	setSubnet6FromOldSubnet(subnet, oldSubnet)
+	return nil
+}
+
+func setSubnet6FromOldSubnet(subnet, oldSubnet *resource.Subnet6) {
 	subnet.SubnetId = oldSubnet.SubnetId
 	subnet.Capacity = oldSubnet.Capacity
 	subnet.Subnet = oldSubnet.Subnet
@@ -462,7 +505,6 @@ func setSubnet6FromDB(tx restdb.Transaction, subnet *resource.Subnet6) error {
 	subnet.UseEui64 = oldSubnet.UseEui64
 	subnet.AddressCode = oldSubnet.AddressCode
 	subnet.AutoReservationType = oldSubnet.AutoReservationType
-	return nil
 }
 
 func getSubnet6FromDB(tx restdb.Transaction, subnetId string) (*resource.Subnet6, error) {
@@ -585,7 +627,7 @@ func (s *Subnet6Service) Delete(subnet *resource.Subnet6) error {
 			return err
 		}
 
-		if err := checkSubnet6HasNoBeenAllocated(subnet); err != nil {
+		if err := checkSubnet6CouldBeDelete(tx, subnet); err != nil {
 			return err
 		}
 
@@ -595,10 +637,23 @@ func (s *Subnet6Service) Delete(subnet *resource.Subnet6) error {
 				pg.Error(err).Error())
 		}
 
+		if err := deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnSubnet6: subnet.GetID()}); err != nil {
+			return err
+		}
+
 		return sendDeleteSubnet6CmdToDHCPAgent(subnet, subnet.Nodes)
 	})
 }
 
+func checkSubnet6CouldBeDelete(tx restdb.Transaction, subnet6 *resource.Subnet6) error {
+	if err := checkUsedBySharedNetwork6(tx, subnet6); err != nil {
+		return err
+	}
+
+	return checkSubnet6HasNoBeenAllocated(subnet6)
+}
+
 func checkSubnet6HasNoBeenAllocated(subnet6 *resource.Subnet6) error {
 	if leasesCount, err := getSubnet6LeasesCount(subnet6); err != nil {
 		return err
@@ -808,37 +863,45 @@ func parseSubnet6sFromFile(fileName string, oldSubnets []*resource.Subnet6, sent
 		return nil, nil, nil, nil, nil, nil
 	}
 
+	sqls, reqsForSentryCreate, reqsForSentryDelete, reqForServerCreate,
+		reqForServerDelete := subnet6sAndPoolsToInsertSqlsAndRequests(subnets, subnetPools,
+		subnetReservedPools, subnetReservations, subnetPdPools)
+	return sqls, reqsForSentryCreate, reqsForSentryDelete,
+		reqForServerCreate, reqForServerDelete, nil
+}
+
+func subnet6sAndPoolsToInsertSqlsAndRequests(subnets []*resource.Subnet6, subnetPools map[uint64][]*resource.Pool6, subnetReservedPools map[uint64][]*resource.ReservedPool6, subnetReservations map[uint64][]*resource.Reservation6, subnetPdPools map[uint64][]*resource.PdPool) ([]string, map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, map[string]*pbdhcpagent.DeleteSubnets6Request, *pbdhcpagent.CreateSubnets6AndPoolsRequest, *pbdhcpagent.DeleteSubnets6Request) {
 	sqls := make([]string, 0, 5)
 	reqsForSentryCreate := make(map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, len(subnets))
 	reqForServerCreate := &pbdhcpagent.CreateSubnets6AndPoolsRequest{}
 	reqsForSentryDelete := make(map[string]*pbdhcpagent.DeleteSubnets6Request, len(subnets))
 	reqForServerDelete := &pbdhcpagent.DeleteSubnets6Request{}
-	subnetAndNodes := make(map[uint64][]string, len(subnets))
+	subnets6Map := make(map[uint64]*resource.Subnet6, len(subnets))
 	sqls = append(sqls,
 		subnet6sToInsertSqlAndRequest(subnets, reqsForSentryCreate, reqForServerCreate,
-			reqsForSentryDelete, reqForServerDelete, subnetAndNodes))
+			reqsForSentryDelete, reqForServerDelete, subnets6Map))
 	if len(subnetPools) != 0 {
 		sqls = append(sqls, pool6sToInsertSqlAndRequest(subnetPools,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets6Map))
 	}
 
 	if len(subnetReservedPools) != 0 {
 		sqls = append(sqls, reservedPool6sToInsertSqlAndRequest(subnetReservedPools,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets6Map))
 	}
 
 	if len(subnetReservations) != 0 {
 		sqls = append(sqls, reservation6sToInsertSqlAndRequest(subnetReservations,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets6Map))
 	}
 
 	if len(subnetPdPools) != 0 {
 		sqls = append(sqls, pdpoolsToInsertSqlAndRequest(subnetPdPools,
-			reqForServerCreate, reqsForSentryCreate, subnetAndNodes))
+			reqForServerCreate, reqsForSentryCreate, subnets6Map))
 	}
 
 	return sqls, reqsForSentryCreate, reqsForSentryDelete,
-		reqForServerCreate, reqForServerDelete, nil
+		reqForServerCreate, reqForServerDelete
 }
 
 func parseSubnet6sAndPools(tableHeaderFields, fields []string) (*resource.Subnet6, []*resource.Pool6, []*resource.ReservedPool6, []*resource.Reservation6, []*resource.PdPool, error) {
@@ -1220,7 +1283,7 @@ func checkPdPoolsValid(subnet *resource.Subnet6, pdpools []*resource.PdPool, res
 	return nil
 }
 
-func subnet6sToInsertSqlAndRequest(subnets []*resource.Subnet6, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryDelete map[string]*pbdhcpagent.DeleteSubnets6Request, reqForServerDelete *pbdhcpagent.DeleteSubnets6Request, subnetAndNodes map[uint64][]string) string {
+func subnet6sToInsertSqlAndRequest(subnets []*resource.Subnet6, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryDelete map[string]*pbdhcpagent.DeleteSubnets6Request, reqForServerDelete *pbdhcpagent.DeleteSubnets6Request, subnets6Map map[uint64]*resource.Subnet6) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_subnet6 VALUES ")
 	for _, subnet := range subnets {
@@ -1229,7 +1292,7 @@ func subnet6sToInsertSqlAndRequest(subnets []*resource.Subnet6, reqsForSentryCre
 			continue
 		}
 
-		subnetAndNodes[subnet.SubnetId] = subnet.Nodes
+		subnets6Map[subnet.SubnetId] = subnet
 		pbSubnet := subnet6ToCreateSubnet6Request(subnet)
 		reqForServerCreate.Subnets = append(reqForServerCreate.Subnets, pbSubnet)
 		reqForServerDelete.Ids = append(reqForServerDelete.Ids, subnet.SubnetId)
@@ -1250,15 +1313,15 @@ func subnet6sToInsertSqlAndRequest(subnets []*resource.Subnet6, reqsForSentryCre
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func pool6sToInsertSqlAndRequest(subnetPools map[uint64][]*resource.Pool6, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func pool6sToInsertSqlAndRequest(subnetPools map[uint64][]*resource.Pool6, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnets6Map map[uint64]*resource.Subnet6) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_pool6 VALUES ")
 	for subnetId, pools := range subnetPools {
 		for _, pool := range pools {
 			buf.WriteString(pool6ToInsertDBSqlString(subnetId, pool))
-			pbPool := pool6ToCreatePool6Request(subnetId, pool)
+			pbPool := pool6ToCreatePool6Request(subnets6Map[subnetId], pool)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets6Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.Pools = append(req.Pools, pbPool)
@@ -1274,7 +1337,7 @@ func pool6sToInsertSqlAndRequest(subnetPools map[uint64][]*resource.Pool6, reqFo
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func reservedPool6sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resource.ReservedPool6, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func reservedPool6sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resource.ReservedPool6, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnets6Map map[uint64]*resource.Subnet6) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_reserved_pool6 VALUES ")
 	for subnetId, pools := range subnetReservedPools {
@@ -1282,7 +1345,7 @@ func reservedPool6sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resou
 			buf.WriteString(reservedPool6ToInsertDBSqlString(subnetId, pool))
 			pbReservedPool := reservedPool6ToCreateReservedPool6Request(subnetId, pool)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets6Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.ReservedPools = append(req.ReservedPools, pbReservedPool)
@@ -1299,15 +1362,15 @@ func reservedPool6sToInsertSqlAndRequest(subnetReservedPools map[uint64][]*resou
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func reservation6sToInsertSqlAndRequest(subnetReservations map[uint64][]*resource.Reservation6, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func reservation6sToInsertSqlAndRequest(subnetReservations map[uint64][]*resource.Reservation6, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnets6Map map[uint64]*resource.Subnet6) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_reservation6 VALUES ")
 	for subnetId, reservations := range subnetReservations {
 		for _, reservation := range reservations {
 			buf.WriteString(reservation6ToInsertDBSqlString(subnetId, reservation))
-			pbReservation := reservation6ToCreateReservation6Request(subnetId, reservation)
+			pbReservation := reservation6ToCreateReservation6Request(subnets6Map[subnetId], reservation)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets6Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.Reservations = append(req.Reservations, pbReservation)
@@ -1324,7 +1387,7 @@ func reservation6sToInsertSqlAndRequest(subnetReservations map[uint64][]*resourc
 	return strings.TrimSuffix(buf.String(), ",") + ";"
 }
 
-func pdpoolsToInsertSqlAndRequest(subnetPdPools map[uint64][]*resource.PdPool, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnetAndNodes map[uint64][]string) string {
+func pdpoolsToInsertSqlAndRequest(subnetPdPools map[uint64][]*resource.PdPool, reqForServerCreate *pbdhcpagent.CreateSubnets6AndPoolsRequest, reqsForSentryCreate map[string]*pbdhcpagent.CreateSubnets6AndPoolsRequest, subnets6Map map[uint64]*resource.Subnet6) string {
 	var buf bytes.Buffer
 	buf.WriteString("INSERT INTO gr_pd_pool VALUES ")
 	for subnetId, pdpools := range subnetPdPools {
@@ -1332,7 +1395,7 @@ func pdpoolsToInsertSqlAndRequest(subnetPdPools map[uint64][]*resource.PdPool, r
 			buf.WriteString(pdpoolToInsertDBSqlString(subnetId, pdpool))
 			pbPdPool := pdpoolToCreatePdPoolRequest(subnetId, pdpool)
 			found := false
-			for _, node := range subnetAndNodes[subnetId] {
+			for _, node := range subnets6Map[subnetId].Nodes {
 				if req, ok := reqsForSentryCreate[node]; ok {
 					found = true
 					req.PdPools = append(req.PdPools, pbPdPool)
@@ -1546,7 +1609,7 @@ func sendUpdateSubnet6NodesCmdToDHCPAgent(tx restdb.Transaction, subnet6 *resour
 	}
 
 	if len(subnet6.Nodes) != 0 && len(newNodes) == 0 {
-		if err := checkSubnet6HasNoBeenAllocated(subnet6); err != nil {
+		if err := checkSubnet6CouldBeDelete(tx, subnet6); err != nil {
 			return err
 		}
 	}
@@ -1631,6 +1694,25 @@ func genCreateSubnets6AndPoolsRequestWithSubnet6(tx restdb.Transaction, subnet6
 		return nil, "", err
 	}
 
+	valuesMap, err := getOptionValue6sMap(tx, map[string]interface{}{
+		resource.SqlColumnSubnet6: subnet6.GetID()})
+	if err != nil {
+		return nil, "", err
+	}
+
+	subnet6.Options = valuesMap[subnet6.GetID()]
+	for _, pool := range pools {
+		pool.Options = valuesMap[pool.GetID()]
+	}
+
+	for _, reservation := range reservations {
+		reservation.Options = valuesMap[reservation.GetID()]
+	}
+
+	for _, pdpool := range pdpools {
+		pdpool.Options = valuesMap[pdpool.GetID()]
+	}
+
 	if len(pools) == 0 && len(reservedPools) == 0 && len(reservations) == 0 &&
 		len(pdpools) == 0 && len(reservedPdPools) == 0 {
 		return subnet6ToCreateSubnet6Request(subnet6), kafka.CreateSubnet6, nil
@@ -1643,7 +1725,7 @@ func genCreateSubnets6AndPoolsRequestWithSubnet6(tx restdb.Transaction, subnet6
 	}
 	for _, pool := range pools {
 		req.Pools = append(req.Pools,
-			pool6ToCreatePool6Request(subnet6.SubnetId, pool))
+			pool6ToCreatePool6Request(subnet6, pool))
 	}
 
 	for _, pool := range reservedPools {
@@ -1653,7 +1735,7 @@ func genCreateSubnets6AndPoolsRequestWithSubnet6(tx restdb.Transaction, subnet6
 
 	for _, reservation := range reservations {
 		req.Reservations = append(req.Reservations,
-			reservation6ToCreateReservation6Request(subnet6.SubnetId, reservation))
+			reservation6ToCreateReservation6Request(subnet6, reservation))
 	}
 
 	for _, pdpool := range pdpools {
@@ -1747,3 +1829,154 @@ func getSubnet6WithPrefix(tx restdb.Transaction, prefix string) (*resource.Subne
 		return subnets[0], nil
 	}
 }
+
+func (s *Subnet6Service) EffectiveConfig(subnetId string) (*resource.EffectiveConfig, error) {
+	return getEffectiveConfig6(subnetId, "", "")
+}
+
+func (s *Subnet6Service) Simulate(input *resource.Simulate6Input) (*resource.SimulateResult, error) {
+	return simulate6(input)
+}
+
+func (s *Subnet6Service) CreateFromTemplate(input *resource.SubnetsFromTemplateInput) (*resource.Subnet6ListOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	dhcpConfig, err := resource.GetDhcpConfig(false)
+	if err != nil {
+		return nil, err
+	}
+
+	clientClass6s, err := resource.GetClientClass6s()
+	if err != nil {
+		return nil, err
+	}
+
+	sentryNodes, serverNodes, sentryVip, err := kafka.GetDHCPNodes(kafka.AgentStack6)
+	if err != nil {
+		return nil, err
+	}
+
+	sentryNodesForCheck := sentryNodes
+	if sentryVip != "" {
+		sentryNodesForCheck = []string{sentryVip}
+	}
+
+	subnets := make([]*resource.Subnet6, 0, len(input.Subnets))
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		template, err := getSubnet6TemplateWithOptions(tx, input.Template)
+		if err != nil {
+			return err
+		}
+
+		var oldSubnets []*resource.Subnet6
+		if err := tx.Fill(map[string]interface{}{resource.SqlOrderBy: "subnet_id desc"},
+			&oldSubnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+		}
+
+		if len(oldSubnets)+len(input.Subnets) > config.GetMaxSubnetsCount() {
+			return errorno.ErrExceedMaxCount(errorno.ErrNameNetworkV6,
+				config.GetMaxSubnetsCount())
+		}
+
+		var maxOldSubnetId uint64
+		if len(oldSubnets) != 0 {
+			maxOldSubnetId = oldSubnets[0].SubnetId
+		}
+
+		subnetPools := make(map[uint64][]*resource.Pool6, len(input.Subnets))
+		subnetReservedPools := make(map[uint64][]*resource.ReservedPool6, len(input.Subnets))
+		for _, subnetFromTemplate := range input.Subnets {
+			subnet := template.NewSubnet6(subnetFromTemplate)
+			if err := subnet.Validate(dhcpConfig, clientClass6s, nil); err != nil {
+				return err
+			}
+
+			if err := checkSubnetNodesValid(subnet.Nodes, sentryNodesForCheck); err != nil {
+				return err
+			}
+
+			if err := checkSubnet6ConflictWithSubnet6s(subnet,
+				append(oldSubnets, subnets...)); err != nil {
+				return err
+			}
+
+			pools, reservedPools, err := parsePool6sFromSubnet6Template(tx, template, subnet)
+			if err != nil {
+				return err
+			}
+
+			subnet.SubnetId = maxOldSubnetId + uint64(len(subnets)) + 1
+			subnet.SetID(strconv.FormatUint(subnet.SubnetId, 10))
+			subnets = append(subnets, subnet)
+			if len(pools) != 0 {
+				subnetPools[subnet.SubnetId] = pools
+			}
+
+			if len(reservedPools) != 0 {
+				subnetReservedPools[subnet.SubnetId] = reservedPools
+			}
+		}
+
+		sqls, reqsForSentryCreate, reqsForSentryDelete, reqForServerCreate,
+			reqForServerDelete := subnet6sAndPoolsToInsertSqlsAndRequests(subnets,
+			subnetPools, subnetReservedPools, nil, nil)
+		for _, sql := range sqls {
+			if _, err := tx.Exec(sql); err != nil {
+				return errorno.ErrDBError(errorno.ErrDBNameInsert,
+					string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+			}
+		}
+
+		for _, subnet := range subnets {
+			if err := saveOptionValue6s(tx, resource.OptionScopeSubnet6, subnet.GetID(),
+				subnet.GetID(), subnet.Options); err != nil {
+				return err
+			}
+		}
+
+		if len(reqForServerCreate.Subnets) == 0 {
+			return nil
+		}
+
+		if sentryVip != "" {
+			return sendCreateSubnet6sAndPoolsCmdToDHCPAgentWithHA(sentryNodes,
+				reqForServerCreate)
+		} else {
+			return sendCreateSubnet6sAndPoolsCmdToDHCPAgent(serverNodes, reqsForSentryCreate,
+				reqsForSentryDelete, reqForServerCreate, reqForServerDelete)
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return &resource.Subnet6ListOutput{Subnet6s: subnets}, nil
+}
+
+func parsePool6sFromSubnet6Template(tx restdb.Transaction, template *resource.Subnet6Template, subnet *resource.Subnet6) ([]*resource.Pool6, []*resource.ReservedPool6, error) {
+	pools, reservedPools := template.NewPool6s()
+	for _, pool := range pools {
+		if err := pool.ParseAddressWithTemplate(tx, subnet); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, reservedPool := range reservedPools {
+		if err := reservedPool.ParseAddressWithTemplate(tx, subnet); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if err := checkReservedPool6sValid(subnet, reservedPools, nil); err != nil {
+		return nil, nil, err
+	}
+
+	if err := checkPool6sValid(subnet, pools, reservedPools, nil); err != nil {
+		return nil, nil, err
+	}
+
+	return pools, reservedPools, nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type Subnet6TemplateService struct {
+}
+
+func NewSubnet6TemplateService() *Subnet6TemplateService {
+	return &Subnet6TemplateService{}
+}
+
+func (s *Subnet6TemplateService) Create(template *resource.Subnet6Template) error {
+	if err := template.Validate(nil); err != nil {
+		return err
+	}
+
+	template.SetID(template.Name)
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkPoolTemplatesExist(tx, resource.TablePool6Template,
+			append(template.PoolTemplates, template.ReservedPoolTemplates...)); err != nil {
+			return err
+		}
+
+		if _, err := tx.Insert(template); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameSubnetTemplate, template.Name, err)
+		}
+
+		return saveOptionValue6s(tx, resource.OptionScopeSubnet6Template, template.GetID(),
+			"", template.Options)
+	})
+}
+
+func (s *Subnet6TemplateService) List(conditions map[string]interface{}) ([]*resource.Subnet6Template, error) {
+	var templates []*resource.Subnet6Template
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(conditions, &templates); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameSubnetTemplate), pg.Error(err).Error())
+		}
+
+		templateIds := make([]string, 0, len(templates))
+		for _, template := range templates {
+			templateIds = append(templateIds, template.GetID())
+		}
+
+		optionsMap, err := getOptionValue6sMapWithScopeIds(tx,
+			resource.OptionScopeSubnet6Template, templateIds)
+		if err != nil {
+			return err
+		}
+
+		for _, template := range templates {
+			template.Options = optionsMap[template.GetID()]
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (s *Subnet6TemplateService) Get(id string) (*resource.Subnet6Template, error) {
+	var template *resource.Subnet6Template
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		template, err = getSubnet6TemplateWithOptions(tx, id)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func getSubnet6TemplateWithOptions(tx restdb.Transaction, id string) (*resource.Subnet6Template, error) {
+	var templates []*resource.Subnet6Template
+	if err := tx.Fill(map[string]interface{}{restdb.IDField: id}, &templates); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+	} else if len(templates) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameSubnetTemplate, id)
+	}
+
+	options, err := getOptionValue6s(tx, map[string]interface{}{
+		resource.SqlColumnScope: resource.OptionScopeSubnet6Template, resource.SqlColumnScopeId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	templates[0].Options = options
+	return templates[0], nil
+}
+
+func (s *Subnet6TemplateService) Update(template *resource.Subnet6Template) error {
+	if err := template.Validate(nil); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkPoolTemplatesExist(tx, resource.TablePool6Template,
+			append(template.PoolTemplates, template.ReservedPoolTemplates...)); err != nil {
+			return err
+		}
+
+		if rows, err := tx.Update(resource.TableSubnet6Template, map[string]interface{}{
+			resource.SqlColumnWhiteClientClassStrategy: template.WhiteClientClassStrategy,
+			resource.SqlColumnWhiteClientClasses:       template.WhiteClientClasses,
+			resource.SqlColumnBlackClientClassStrategy: template.BlackClientClassStrategy,
+			resource.SqlColumnBlackClientClasses:       template.BlackClientClasses,
+			resource.SqlColumnValidLifetime:            template.ValidLifetime,
+			resource.SqlColumnMaxValidLifetime:         template.MaxValidLifetime,
+			resource.SqlColumnMinValidLifetime:         template.MinValidLifetime,
+			resource.SqlColumnPreferredLifetime:        template.PreferredLifetime,
+			resource.SqlColumnRapidCommit:              template.RapidCommit,
+			resource.SqlColumnDomainServers:            template.DomainServers,
+			resource.SqlColumnDomainSearchList:         template.DomainSearchList,
+			resource.SqlColumnInformationRefreshTime:   template.InformationRefreshTime,
+			resource.SqlColumnCapWapACAddresses:        template.CapWapACAddresses,
+			resource.SqlColumnCaptivePortalUrl:         template.CaptivePortalUrl,
+			resource.SqlColumnAutoReservationType:      template.AutoReservationType,
+			resource.SqlColumnNodes:                    template.Nodes,
+			resource.SqlColumnPoolTemplates:            template.PoolTemplates,
+			resource.SqlColumnReservedPoolTemplates:    template.ReservedPoolTemplates,
+			resource.SqlColumnComment:                  template.Comment,
+		}, map[string]interface{}{restdb.IDField: template.GetID()}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, template.GetID(),
+				pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameSubnetTemplate, template.GetID())
+		}
+
+		return saveOptionValue6s(tx, resource.OptionScopeSubnet6Template, template.GetID(),
+			"", template.Options)
+	})
+}
+
+func (s *Subnet6TemplateService) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Delete(resource.TableSubnet6Template, map[string]interface{}{
+			restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameSubnetTemplate, id)
+		}
+
+		return deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeSubnet6Template,
+			resource.SqlColumnScopeId: id})
+	})
+}
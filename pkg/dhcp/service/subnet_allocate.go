@@ -0,0 +1,347 @@
+package service
+
+import (
+	"math/big"
+	"net"
+	"sort"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/kafka"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type subnetBlock struct {
+	begin *big.Int
+	ones  int
+}
+
+// free space is kept as maximal aligned blocks, split into buddies when allocated
+type subnetAllocator struct {
+	parent net.IPNet
+	bits   int
+	blocks []*subnetBlock
+}
+
+func newSubnetAllocator(parent net.IPNet, usedIpnets []net.IPNet) *subnetAllocator {
+	ones, bits := parent.Mask.Size()
+	allocator := &subnetAllocator{parent: parent, bits: bits}
+	parentBegin := ipToBigInt(parent.IP, bits)
+	parentEnd := allocator.blockEnd(parentBegin, ones)
+	sort.Slice(usedIpnets, func(i, j int) bool {
+		return ipToBigInt(usedIpnets[i].IP, bits).Cmp(ipToBigInt(usedIpnets[j].IP, bits)) < 0
+	})
+
+	next := parentBegin
+	for _, ipnet := range usedIpnets {
+		usedOnes, _ := ipnet.Mask.Size()
+		usedBegin := ipToBigInt(ipnet.IP, bits)
+		if usedBegin.Cmp(next) > 0 {
+			allocator.addFreeRange(next, new(big.Int).Sub(usedBegin, big.NewInt(1)), ones)
+		}
+
+		if usedEnd := allocator.blockEnd(usedBegin, usedOnes); usedEnd.Cmp(next) >= 0 {
+			next = new(big.Int).Add(usedEnd, big.NewInt(1))
+		}
+	}
+
+	if next.Cmp(parentEnd) <= 0 {
+		allocator.addFreeRange(next, parentEnd, ones)
+	}
+
+	return allocator
+}
+
+func (a *subnetAllocator) blockSize(ones int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(a.bits-ones))
+}
+
+func (a *subnetAllocator) blockEnd(begin *big.Int, ones int) *big.Int {
+	end := new(big.Int).Add(begin, a.blockSize(ones))
+	return end.Sub(end, big.NewInt(1))
+}
+
+func (a *subnetAllocator) addFreeRange(begin, end *big.Int, parentOnes int) {
+	for begin.Cmp(end) <= 0 {
+		hostBits := a.bits - parentOnes
+		if begin.Sign() != 0 && int(begin.TrailingZeroBits()) < hostBits {
+			hostBits = int(begin.TrailingZeroBits())
+		}
+
+		for a.blockEnd(begin, a.bits-hostBits).Cmp(end) > 0 {
+			hostBits--
+		}
+
+		a.blocks = append(a.blocks, &subnetBlock{begin: begin, ones: a.bits - hostBits})
+		begin = new(big.Int).Add(begin, a.blockSize(a.bits-hostBits))
+	}
+}
+
+func (a *subnetAllocator) allocate(prefixLen int, strategy string) *net.IPNet {
+	index := -1
+	for i, block := range a.blocks {
+		if block.ones > prefixLen {
+			continue
+		}
+
+		if index == -1 {
+			index = i
+		} else if chosen := a.blocks[index]; strategy == resource.AllocateStrategyBestFit &&
+			block.ones != chosen.ones {
+			if block.ones > chosen.ones {
+				index = i
+			}
+		} else if block.begin.Cmp(chosen.begin) < 0 {
+			index = i
+		}
+	}
+
+	if index == -1 {
+		return nil
+	}
+
+	block := a.blocks[index]
+	a.blocks = append(a.blocks[:index], a.blocks[index+1:]...)
+	for ones := prefixLen; ones > block.ones; ones-- {
+		a.blocks = append(a.blocks, &subnetBlock{
+			begin: new(big.Int).Add(block.begin, a.blockSize(ones)), ones: ones})
+	}
+
+	return &net.IPNet{IP: bigIntToIp(block.begin, a.bits), Mask: net.CIDRMask(prefixLen, a.bits)}
+}
+
+func allocateSubnets(parent *net.IPNet, usedIpnets []net.IPNet, input *resource.AllocateSubnetsInput) ([]string, error) {
+	ones, bits := parent.Mask.Size()
+	if input.PrefixLen < uint32(ones) || input.PrefixLen > uint32(bits) {
+		return nil, errorno.ErrNotInRange(errorno.ErrNamePrefixLen, ones, bits)
+	}
+
+	allocator := newSubnetAllocator(*parent, usedIpnets)
+	subnets := make([]string, 0, input.Count)
+	for i := uint32(0); i < input.Count; i++ {
+		if ipnet := allocator.allocate(int(input.PrefixLen), input.Strategy); ipnet == nil {
+			return nil, errorno.ErrNoFreeSubnets(parent.String(), input.PrefixLen, int(input.Count))
+		} else {
+			subnets = append(subnets, ipnet.String())
+		}
+	}
+
+	return subnets, nil
+}
+
+func (s *Subnet4Service) Allocate(input *resource.AllocateSubnetsInput) (*resource.AllocateSubnetsOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	parent, err := gohelperip.ParseCIDRv4(input.Parent)
+	if err != nil {
+		return nil, errorno.ErrParseCIDR(input.Parent)
+	}
+
+	var subnets []*resource.Subnet4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&subnets,
+			"SELECT * FROM gr_subnet4 WHERE network($1::inet) >>= network(ipnet::inet) OR network(ipnet::inet) >>= network($2::inet)",
+			parent.String(), parent.String())
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV4),
+			pg.Error(err).Error())
+	}
+
+	usedIpnets := make([]net.IPNet, 0, len(subnets))
+	for _, subnet := range subnets {
+		usedIpnets = append(usedIpnets, subnet.Ipnet)
+	}
+
+	output := &resource.AllocateSubnetsOutput{}
+	if output.Subnets, err = allocateSubnets(parent, usedIpnets, input); err != nil || !input.Create {
+		return output, err
+	}
+
+	if input.Template != "" {
+		templateInput := &resource.SubnetsFromTemplateInput{Template: input.Template}
+		for _, subnet := range output.Subnets {
+			templateInput.Subnets = append(templateInput.Subnets,
+				&resource.SubnetFromTemplate{Subnet: subnet, Tags: input.Tags})
+		}
+
+		if listOutput, err := s.CreateFromTemplate(templateInput); err != nil {
+			return nil, err
+		} else {
+			output.Subnet4s = listOutput.Subnet4s
+		}
+	} else if output.Subnet4s, err = createAllocatedSubnet4s(output.Subnets, input); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func createAllocatedSubnet4s(subnets []string, input *resource.AllocateSubnetsInput) ([]*resource.Subnet4, error) {
+	subnet4s := make([]*resource.Subnet4, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnet4 := &resource.Subnet4{Subnet: subnet, Tags: input.Tags, Nodes: input.Nodes}
+		if err := subnet4.Validate(nil, nil); err != nil {
+			return nil, err
+		}
+
+		subnet4s = append(subnet4s, subnet4)
+	}
+
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkSubnetsCouldBeAdded(tx, resource.TableSubnet4, errorno.ErrNameNetworkV4,
+			len(subnet4s)); err != nil {
+			return err
+		}
+
+		req := &pbdhcpagent.CreateSubnets4AndPoolsRequest{}
+		deleteReq := &pbdhcpagent.DeleteSubnets4Request{}
+		for _, subnet := range subnet4s {
+			if err := checkSubnet4CouldBeCreated(tx, subnet.Subnet); err != nil {
+				return err
+			}
+
+			if err := setSubnet4ID(tx, subnet); err != nil {
+				return err
+			}
+
+			if _, err := tx.Insert(subnet); err != nil {
+				return util.FormatDbInsertError(errorno.ErrNameNetwork, subnet.Subnet, err)
+			}
+
+			if err := saveOptionValue4s(tx, resource.OptionScopeSubnet4, subnet.GetID(),
+				subnet.GetID(), subnet.Options); err != nil {
+				return err
+			}
+
+			req.Subnets = append(req.Subnets, subnet4ToCreateSubnet4Request(subnet))
+			deleteReq.Ids = append(deleteReq.Ids, subnet.SubnetId)
+		}
+
+		return kafka.SendDHCPCmdWithNodes(true, input.Nodes, kafka.CreateSubnet4sAndPools, req,
+			func(nodesForSucceed []string) {
+				if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+					nodesForSucceed, kafka.DeleteSubnet4s, deleteReq); err != nil {
+					log.Errorf("create allocated subnet4s failed, and rollback %v failed: %s",
+						nodesForSucceed, err.Error())
+				}
+			})
+	}); err != nil {
+		return nil, err
+	}
+
+	return subnet4s, nil
+}
+
+func (s *Subnet6Service) Allocate(input *resource.AllocateSubnetsInput) (*resource.AllocateSubnetsOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	parent, err := gohelperip.ParseCIDRv6(input.Parent)
+	if err != nil {
+		return nil, errorno.ErrParseCIDR(input.Parent)
+	}
+
+	var subnets []*resource.Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&subnets,
+			"SELECT * FROM gr_subnet6 WHERE network($1::inet) >>= network(ipnet::inet) OR network(ipnet::inet) >>= network($2::inet)",
+			parent.String(), parent.String())
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV6),
+			pg.Error(err).Error())
+	}
+
+	usedIpnets := make([]net.IPNet, 0, len(subnets))
+	for _, subnet := range subnets {
+		usedIpnets = append(usedIpnets, subnet.Ipnet)
+	}
+
+	output := &resource.AllocateSubnetsOutput{}
+	if output.Subnets, err = allocateSubnets(parent, usedIpnets, input); err != nil || !input.Create {
+		return output, err
+	}
+
+	if input.Template != "" {
+		templateInput := &resource.SubnetsFromTemplateInput{Template: input.Template}
+		for _, subnet := range output.Subnets {
+			templateInput.Subnets = append(templateInput.Subnets,
+				&resource.SubnetFromTemplate{Subnet: subnet, Tags: input.Tags})
+		}
+
+		if listOutput, err := s.CreateFromTemplate(templateInput); err != nil {
+			return nil, err
+		} else {
+			output.Subnet6s = listOutput.Subnet6s
+		}
+	} else if output.Subnet6s, err = createAllocatedSubnet6s(output.Subnets, input); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func createAllocatedSubnet6s(subnets []string, input *resource.AllocateSubnetsInput) ([]*resource.Subnet6, error) {
+	subnet6s := make([]*resource.Subnet6, 0, len(subnets))
+	for _, subnet := range subnets {
+		subnet6 := &resource.Subnet6{Subnet: subnet, Tags: input.Tags, Nodes: input.Nodes}
+		if err := subnet6.Validate(nil, nil, nil); err != nil {
+			return nil, err
+		}
+
+		subnet6s = append(subnet6s, subnet6)
+	}
+
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := checkSubnetsCouldBeAdded(tx, resource.TableSubnet6, errorno.ErrNameNetworkV6,
+			len(subnet6s)); err != nil {
+			return err
+		}
+
+		req := &pbdhcpagent.CreateSubnets6AndPoolsRequest{}
+		deleteReq := &pbdhcpagent.DeleteSubnets6Request{}
+		for _, subnet := range subnet6s {
+			if err := checkSubnet6CouldBeCreated(tx, subnet.Subnet); err != nil {
+				return err
+			}
+
+			if err := setSubnet6ID(tx, subnet); err != nil {
+				return err
+			}
+
+			if _, err := tx.Insert(subnet); err != nil {
+				return util.FormatDbInsertError(errorno.ErrNameNetwork, subnet.Subnet, err)
+			}
+
+			if err := saveOptionValue6s(tx, resource.OptionScopeSubnet6, subnet.GetID(),
+				subnet.GetID(), subnet.Options); err != nil {
+				return err
+			}
+
+			req.Subnets = append(req.Subnets, subnet6ToCreateSubnet6Request(subnet))
+			deleteReq.Ids = append(deleteReq.Ids, subnet.SubnetId)
+		}
+
+		return kafka.SendDHCPCmdWithNodes(false, input.Nodes, kafka.CreateSubnet6sAndPools, req,
+			func(nodesForSucceed []string) {
+				if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+					nodesForSucceed, kafka.DeleteSubnet6s, deleteReq); err != nil {
+					log.Errorf("create allocated subnet6s failed, and rollback %v failed: %s",
+						nodesForSucceed, err.Error())
+				}
+			})
+	}); err != nil {
+		return nil, err
+	}
+
+	return subnet6s, nil
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	"math/big"
+	"net"
+
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/kafka"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+)
+
+func getSupernet(ipnets []net.IPNet, subnets []string) (net.IPNet, int, error) {
+	minOnes, bits := ipnets[0].Mask.Size()
+	for _, ipnet := range ipnets[1:] {
+		if ones, _ := ipnet.Mask.Size(); ones < minOnes {
+			minOnes = ones
+		}
+	}
+
+	var supernet net.IPNet
+	for ones := minOnes; ones >= 0; ones-- {
+		mask := net.CIDRMask(ones, bits)
+		ip := ipnets[0].IP.Mask(mask)
+		contained := true
+		for _, ipnet := range ipnets[1:] {
+			if !ipnet.IP.Mask(mask).Equal(ip) {
+				contained = false
+				break
+			}
+		}
+
+		if contained {
+			supernet = net.IPNet{IP: ip, Mask: mask}
+			break
+		}
+	}
+
+	total := new(big.Int)
+	for _, ipnet := range ipnets {
+		ones, _ := ipnet.Mask.Size()
+		total.Add(total, new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)))
+	}
+
+	ones, _ := supernet.Mask.Size()
+	if total.Cmp(new(big.Int).Lsh(big.NewInt(1), uint(bits-ones))) != 0 {
+		return supernet, 0, errorno.ErrNotSupernet(subnets)
+	}
+
+	for i, ipnet := range ipnets {
+		if ipnet.IP.Equal(supernet.IP) {
+			return supernet, i, nil
+		}
+	}
+
+	return supernet, 0, errorno.ErrNotSupernet(subnets)
+}
+
+func appendAddressesIfMissing(addresses []string, others ...string) []string {
+	for _, other := range others {
+		exists := false
+		for _, address := range addresses {
+			if address == other {
+				exists = true
+				break
+			}
+		}
+
+		if !exists {
+			addresses = append(addresses, other)
+		}
+	}
+
+	return addresses
+}
+
+func (s *Subnet4Service) Merge(input *resource.MergeSubnetsInput) (*resource.Subnet4, error) {
+	if len(input.SubnetIds) < 2 {
+		return nil, errorno.ErrNotSupernet(input.SubnetIds)
+	}
+
+	var merged *resource.Subnet4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		var subnets []*resource.Subnet4
+		if err := tx.Fill(map[string]interface{}{restdb.IDField: restdb.FillValue{
+			Operator: restdb.OperatorAny, Value: input.SubnetIds}}, &subnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV4),
+				pg.Error(err).Error())
+		} else if len(subnets) != len(input.SubnetIds) {
+			return errorno.ErrResourceNotFound(errorno.ErrNameNetworkV4)
+		}
+
+		ipnets := make([]net.IPNet, 0, len(subnets))
+		names := make([]string, 0, len(subnets))
+		for _, subnet := range subnets {
+			ipnets = append(ipnets, subnet.Ipnet)
+			names = append(names, subnet.Subnet)
+		}
+
+		supernet, index, err := getSupernet(ipnets, names)
+		if err != nil {
+			return err
+		}
+
+		survivor := subnets[index]
+		others := append(append([]*resource.Subnet4{}, subnets[:index]...), subnets[index+1:]...)
+		for _, other := range others {
+			if !checkSlicesEqual(survivor.Nodes, other.Nodes) {
+				return errorno.ErrSubnetNodesNotSame(survivor.Subnet, other.Subnet)
+			}
+
+			if err := checkUsedBySharedNetwork(tx, other); err != nil {
+				return err
+			}
+		}
+
+		merged, err = mergeSubnet4s(tx, survivor, others, supernet)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+func mergeSubnet4s(tx restdb.Transaction, survivor *resource.Subnet4, others []*resource.Subnet4, supernet net.IPNet) (*resource.Subnet4, error) {
+	subnetIds := []string{survivor.GetID()}
+	for _, other := range others {
+		subnetIds = append(subnetIds, other.GetID())
+	}
+
+	var reservations []*resource.Reservation4
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: restdb.FillValue{
+		Operator: restdb.OperatorAny, Value: subnetIds}}, &reservations); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation),
+			pg.Error(err).Error())
+	}
+
+	reservationIdentifier := Reservation4IdentifierFromReservations(nil)
+	for _, reservation := range reservations {
+		if err := reservationIdentifier.Add(reservation); err != nil {
+			return nil, err
+		}
+	}
+
+	oldReq, oldCmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, survivor)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *survivor
+	merged.Ipnet = supernet
+	merged.Subnet = supernet.String()
+	if survivor.SubnetMask == net.IP(survivor.Ipnet.Mask).String() {
+		merged.SubnetMask = net.IP(supernet.Mask).String()
+	}
+
+	oldCmds := []*subnetCmd{&subnetCmd{cmd: oldCmd, req: oldReq}}
+	deleteOldReq := &pbdhcpagent.DeleteSubnets4Request{Ids: []uint64{survivor.SubnetId}}
+	for _, other := range others {
+		req, cmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, other)
+		if err != nil {
+			return nil, err
+		}
+
+		oldCmds = append(oldCmds, &subnetCmd{cmd: cmd, req: req})
+		deleteOldReq.Ids = append(deleteOldReq.Ids, other.SubnetId)
+		merged.Capacity += other.Capacity
+		merged.Routers = appendAddressesIfMissing(merged.Routers, other.Routers...)
+		merged.RelayAgentAddresses = appendAddressesIfMissing(merged.RelayAgentAddresses,
+			other.RelayAgentAddresses...)
+		merged.StaticRoutes = append(merged.StaticRoutes, other.StaticRoutes...)
+	}
+
+	staticRoutes := make([]*resource.StaticRoute4, 0, len(merged.StaticRoutes))
+	for _, route := range merged.StaticRoutes {
+		staticRoutes = append(staticRoutes, &resource.StaticRoute4{
+			Destination: route.Destination, NextHops: route.NextHops})
+	}
+
+	merged.StaticRoutes = staticRoutes
+	if err := merged.ValidateStaticRoutes(); err != nil {
+		return nil, err
+	}
+
+	for _, other := range others {
+		if err := deleteOptionValue4s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeSubnet4,
+			resource.SqlColumnScopeId: other.GetID()}); err != nil {
+			return nil, err
+		}
+
+		for table, errName := range map[restdb.ResourceType]errorno.ErrName{
+			resource.TablePool4:         errorno.ErrNameDhcpPool,
+			resource.TableReservedPool4: errorno.ErrNameDhcpReservedPool,
+			resource.TableReservation4:  errorno.ErrNameDhcpReservation,
+			resource.TableOptionValue4:  errorno.ErrNameOptionValue,
+		} {
+			if err := updateResourcesSubnet(tx, table, resource.SqlColumnSubnet4, merged.GetID(),
+				map[string]interface{}{resource.SqlColumnSubnet4: other.GetID()}, errName); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := deleteStaticRoute4s(tx, other.GetID()); err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.Delete(resource.TableSubnet4,
+			map[string]interface{}{restdb.IDField: other.GetID()}); err != nil {
+			return nil, errorno.ErrDBError(errorno.ErrDBNameDelete, other.Subnet,
+				pg.Error(err).Error())
+		}
+	}
+
+	if _, err := tx.Update(resource.TableSubnet4, map[string]interface{}{
+		resource.SqlColumnSubnet:              merged.Subnet,
+		resource.SqlColumnIpnet:               merged.Subnet,
+		resource.SqlColumnSubnetMask:          merged.SubnetMask,
+		resource.SqlColumnRouters:             merged.Routers,
+		resource.SqlColumnRelayAgentAddresses: merged.RelayAgentAddresses,
+		resource.SqlColumnCapacity:            merged.Capacity,
+	}, map[string]interface{}{restdb.IDField: merged.GetID()}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameUpdate, merged.Subnet, pg.Error(err).Error())
+	}
+
+	if err := saveStaticRoute4s(tx, merged.GetID(), merged.StaticRoutes); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec("delete from gr_subnet_lease4 where subnet4 = $1", merged.GetID()); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameDelete, string(errorno.ErrNameLease),
+			pg.Error(err).Error())
+	}
+
+	newReq, newCmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, &merged)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sendReplaceSubnetsCmdToDHCPAgent(true, merged.Nodes, "merge subnet4s into "+merged.Subnet,
+		kafka.DeleteSubnet4s, deleteOldReq, oldCmds,
+		kafka.DeleteSubnet4, &pbdhcpagent.DeleteSubnet4Request{Id: merged.SubnetId},
+		[]*subnetCmd{&subnetCmd{cmd: newCmd, req: newReq}}); err != nil {
+		return nil, err
+	}
+
+	return &merged, nil
+}
+
+func (s *Subnet6Service) Merge(input *resource.MergeSubnetsInput) (*resource.Subnet6, error) {
+	if len(input.SubnetIds) < 2 {
+		return nil, errorno.ErrNotSupernet(input.SubnetIds)
+	}
+
+	var merged *resource.Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		var subnets []*resource.Subnet6
+		if err := tx.Fill(map[string]interface{}{restdb.IDField: restdb.FillValue{
+			Operator: restdb.OperatorAny, Value: input.SubnetIds}}, &subnets); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV6),
+				pg.Error(err).Error())
+		} else if len(subnets) != len(input.SubnetIds) {
+			return errorno.ErrResourceNotFound(errorno.ErrNameNetworkV6)
+		}
+
+		ipnets := make([]net.IPNet, 0, len(subnets))
+		names := make([]string, 0, len(subnets))
+		for _, subnet := range subnets {
+			if subnet.CanNotHasPools() {
+				return errorno.ErrSubnetCanNotHasPools(subnet.Subnet)
+			}
+
+			ipnets = append(ipnets, subnet.Ipnet)
+			names = append(names, subnet.Subnet)
+		}
+
+		supernet, index, err := getSupernet(ipnets, names)
+		if err != nil {
+			return err
+		}
+
+		survivor := subnets[index]
+		others := append(append([]*resource.Subnet6{}, subnets[:index]...), subnets[index+1:]...)
+		for _, other := range others {
+			if !checkSlicesEqual(survivor.Nodes, other.Nodes) {
+				return errorno.ErrSubnetNodesNotSame(survivor.Subnet, other.Subnet)
+			}
+
+			if err := checkUsedBySharedNetwork6(tx, other); err != nil {
+				return err
+			}
+		}
+
+		merged, err = mergeSubnet6s(tx, survivor, others, supernet)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+func mergeSubnet6s(tx restdb.Transaction, survivor *resource.Subnet6, others []*resource.Subnet6, supernet net.IPNet) (*resource.Subnet6, error) {
+	subnetIds := []string{survivor.GetID()}
+	for _, other := range others {
+		subnetIds = append(subnetIds, other.GetID())
+	}
+
+	var reservations []*resource.Reservation6
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: restdb.FillValue{
+		Operator: restdb.OperatorAny, Value: subnetIds}}, &reservations); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation),
+			pg.Error(err).Error())
+	}
+
+	reservationIdentifier := Reservation6IdentifierFromReservations(nil)
+	for _, reservation := range reservations {
+		if err := reservationIdentifier.Add(reservation); err != nil {
+			return nil, err
+		}
+	}
+
+	oldReq, oldCmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, survivor)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *survivor
+	merged.Ipnet = supernet
+	merged.Subnet = supernet.String()
+	oldCmds := []*subnetCmd{&subnetCmd{cmd: oldCmd, req: oldReq}}
+	deleteOldReq := &pbdhcpagent.DeleteSubnets6Request{Ids: []uint64{survivor.SubnetId}}
+	for _, other := range others {
+		req, cmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, other)
+		if err != nil {
+			return nil, err
+		}
+
+		oldCmds = append(oldCmds, &subnetCmd{cmd: cmd, req: req})
+		deleteOldReq.Ids = append(deleteOldReq.Ids, other.SubnetId)
+		merged.AddCapacityWithString(other.Capacity)
+		merged.RelayAgentAddresses = appendAddressesIfMissing(merged.RelayAgentAddresses,
+			other.RelayAgentAddresses...)
+	}
+
+	for _, other := range others {
+		if err := deleteOptionValue6s(tx, map[string]interface{}{
+			resource.SqlColumnScope:   resource.OptionScopeSubnet6,
+			resource.SqlColumnScopeId: other.GetID()}); err != nil {
+			return nil, err
+		}
+
+		for table, errName := range map[restdb.ResourceType]errorno.ErrName{
+			resource.TablePool6:          errorno.ErrNameDhcpPool,
+			resource.TableReservedPool6:  errorno.ErrNameDhcpReservedPool,
+			resource.TableReservation6:   errorno.ErrNameDhcpReservation,
+			resource.TablePdPool:         errorno.ErrNamePdPool,
+			resource.TableReservedPdPool: errorno.ErrNameReservedPdPool,
+			resource.TableOptionValue6:   errorno.ErrNameOptionValue,
+		} {
+			if err := updateResourcesSubnet(tx, table, resource.SqlColumnSubnet6, merged.GetID(),
+				map[string]interface{}{resource.SqlColumnSubnet6: other.GetID()}, errName); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, err := tx.Delete(resource.TableSubnet6,
+			map[string]interface{}{restdb.IDField: other.GetID()}); err != nil {
+			return nil, errorno.ErrDBError(errorno.ErrDBNameDelete, other.Subnet,
+				pg.Error(err).Error())
+		}
+	}
+
+	if _, err := tx.Update(resource.TableSubnet6, map[string]interface{}{
+		resource.SqlColumnSubnet:              merged.Subnet,
+		resource.SqlColumnIpnet:               merged.Subnet,
+		resource.SqlColumnRelayAgentAddresses: merged.RelayAgentAddresses,
+		resource.SqlColumnCapacity:            merged.Capacity,
+	}, map[string]interface{}{restdb.IDField: merged.GetID()}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameUpdate, merged.Subnet, pg.Error(err).Error())
+	}
+
+	if _, err := tx.Exec("delete from gr_subnet_lease6 where subnet6 = $1", merged.GetID()); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameDelete, string(errorno.ErrNameLease),
+			pg.Error(err).Error())
+	}
+
+	newReq, newCmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, &merged)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sendReplaceSubnetsCmdToDHCPAgent(false, merged.Nodes, "merge subnet6s into "+merged.Subnet,
+		kafka.DeleteSubnet6s, deleteOldReq, oldCmds,
+		kafka.DeleteSubnet6, &pbdhcpagent.DeleteSubnet6Request{Id: merged.SubnetId},
+		[]*subnetCmd{&subnetCmd{cmd: newCmd, req: newReq}}); err != nil {
+		return nil, err
+	}
+
+	return &merged, nil
+}
@@ -0,0 +1,499 @@
+package service
+
+import (
+	"context"
+	"math/big"
+	"net"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	transport "github.com/linkingthing/clxone-dhcp/pkg/transport/service"
+)
+
+type freeAddressFinder struct {
+	bits           int
+	next           *big.Int
+	end            *big.Int
+	excludedRanges [][2]*big.Int
+	excludedIps    map[string]struct{}
+}
+
+func newFreeAddressFinder(ipnet net.IPNet, begin, end net.IP) *freeAddressFinder {
+	_, bits := ipnet.Mask.Size()
+	return &freeAddressFinder{
+		bits:        bits,
+		next:        ipToBigInt(begin, bits),
+		end:         ipToBigInt(end, bits),
+		excludedIps: make(map[string]struct{}),
+	}
+}
+
+func (f *freeAddressFinder) excludeRange(begin, end net.IP) {
+	f.excludedRanges = append(f.excludedRanges,
+		[2]*big.Int{ipToBigInt(begin, f.bits), ipToBigInt(end, f.bits)})
+}
+
+func (f *freeAddressFinder) excludeIpnet(ipnet net.IPNet) {
+	ones, _ := ipnet.Mask.Size()
+	begin := ipToBigInt(ipnet.IP, f.bits)
+	end := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(f.bits-ones)), big.NewInt(1))
+	f.excludedRanges = append(f.excludedRanges, [2]*big.Int{begin, end.Add(end, begin)})
+}
+
+func (f *freeAddressFinder) excludeIp(ip net.IP) {
+	f.excludedIps[ip.String()] = struct{}{}
+}
+
+func (f *freeAddressFinder) excludedRangeEnd(i *big.Int) *big.Int {
+	for _, excludedRange := range f.excludedRanges {
+		if excludedRange[0].Cmp(i) <= 0 && excludedRange[1].Cmp(i) >= 0 {
+			return excludedRange[1]
+		}
+	}
+
+	return nil
+}
+
+func (f *freeAddressFinder) candidates(count int, contiguous bool) []net.IP {
+	var ips []net.IP
+	for len(ips) < count && f.next.Cmp(f.end) <= 0 {
+		if rangeEnd := f.excludedRangeEnd(f.next); rangeEnd != nil {
+			f.next = new(big.Int).Add(rangeEnd, big.NewInt(1))
+			if contiguous {
+				ips = nil
+			}
+			continue
+		}
+
+		ip := bigIntToIp(f.next, f.bits)
+		f.next = new(big.Int).Add(f.next, big.NewInt(1))
+		if _, ok := f.excludedIps[ip.String()]; ok {
+			if contiguous {
+				ips = nil
+			}
+			continue
+		}
+
+		ips = append(ips, ip)
+	}
+
+	if len(ips) < count && contiguous {
+		return nil
+	}
+
+	return ips
+}
+
+func (f *freeAddressFinder) find(count int, contiguous bool, getUsedAddresses func([]string) (map[string]struct{}, error)) ([]string, error) {
+	var addresses []string
+	for len(addresses) < count {
+		ips := f.candidates(count-len(addresses), contiguous)
+		if len(ips) == 0 {
+			break
+		}
+
+		candidates := make([]string, 0, len(ips))
+		for _, ip := range ips {
+			candidates = append(candidates, ip.String())
+		}
+
+		usedAddresses, err := getUsedAddresses(candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		if !contiguous {
+			for _, candidate := range candidates {
+				if _, ok := usedAddresses[candidate]; !ok {
+					addresses = append(addresses, candidate)
+				}
+			}
+			continue
+		}
+
+		lastUsed := -1
+		for i, candidate := range candidates {
+			if _, ok := usedAddresses[candidate]; ok {
+				lastUsed = i
+			}
+		}
+
+		if lastUsed == -1 {
+			addresses = candidates
+		} else {
+			f.next = new(big.Int).Add(ipToBigInt(ips[lastUsed], f.bits), big.NewInt(1))
+		}
+	}
+
+	return addresses, nil
+}
+
+func parseNextFreeAddressRange(ipnet net.IPNet, input *resource.NextFreeAddressInput, errName errorno.ErrName, parseIp func(string) (net.IP, error), begin, end net.IP) (net.IP, net.IP, error) {
+	if input.BeginAddress != "" {
+		ip, err := parseIp(input.BeginAddress)
+		if err != nil {
+			return nil, nil, errorno.ErrInvalidAddress(input.BeginAddress)
+		} else if !ipnet.Contains(ip) {
+			return nil, nil, errorno.ErrNotBelongTo(errorno.ErrNameIp, errName,
+				input.BeginAddress, ipnet.String())
+		}
+
+		begin = ip
+	}
+
+	if input.EndAddress != "" {
+		ip, err := parseIp(input.EndAddress)
+		if err != nil {
+			return nil, nil, errorno.ErrInvalidAddress(input.EndAddress)
+		} else if !ipnet.Contains(ip) {
+			return nil, nil, errorno.ErrNotBelongTo(errorno.ErrNameIp, errName,
+				input.EndAddress, ipnet.String())
+		}
+
+		end = ip
+	}
+
+	_, bits := ipnet.Mask.Size()
+	if ipToBigInt(begin, bits).Cmp(ipToBigInt(end, bits)) > 0 {
+		return nil, nil, errorno.ErrInvalidRange(ipnet.String(), begin.String(), end.String())
+	}
+
+	return begin, end, nil
+}
+
+func pingAddresses(isv4 bool, addresses []string, usedAddresses map[string]struct{}) error {
+	var resp *pbdhcpagent.PingAddressesResponse
+	call, ping := transport.CallDhcpAgentGrpc4, pbdhcpagent.DHCPManagerClient.PingAddresses4
+	if !isv4 {
+		call, ping = transport.CallDhcpAgentGrpc6, pbdhcpagent.DHCPManagerClient.PingAddresses6
+	}
+
+	if err := call(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+		resp, err = ping(client, ctx, &pbdhcpagent.PingAddressesRequest{Addresses: addresses})
+		return err
+	}); err != nil {
+		return errorno.ErrNetworkError(errorno.ErrNameIp, formatError(err))
+	}
+
+	for _, result := range resp.GetResults() {
+		if result.GetReachable() {
+			usedAddresses[result.GetAddress()] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+func (s *Subnet4Service) NextFreeAddress(subnetId string, input *resource.NextFreeAddressInput) (*resource.NextFreeAddressOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	output := &resource.NextFreeAddressOutput{}
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		finder, err := newSubnet4FreeAddressFinder(tx, subnet, input)
+		if err != nil {
+			return err
+		}
+
+		if output.Addresses, err = finder.find(int(input.Count),
+			input.CreateMode == resource.NextFreeAddressCreateReservedPool,
+			func(addresses []string) (map[string]struct{}, error) {
+				return getUsedAddress4s(tx, subnet, addresses, input.Ping)
+			}); err != nil {
+			return err
+		} else if len(output.Addresses) < int(input.Count) {
+			return errorno.ErrNoFreeAddresses(subnet.Subnet, int(input.Count))
+		}
+
+		switch input.CreateMode {
+		case resource.NextFreeAddressCreateReservation:
+			output.Reservation4 = &resource.Reservation4{
+				HwAddress: input.HwAddress,
+				Hostname:  input.Hostname,
+				IpAddress: output.Addresses[0],
+				Comment:   input.Comment,
+			}
+			if err := output.Reservation4.Validate(); err != nil {
+				return err
+			}
+
+			return createReservation4(tx, subnet, output.Reservation4)
+		case resource.NextFreeAddressCreateReservedPool:
+			output.ReservedPool4 = &resource.ReservedPool4{
+				BeginAddress: output.Addresses[0],
+				EndAddress:   output.Addresses[len(output.Addresses)-1],
+				Comment:      input.Comment,
+			}
+			if err := output.ReservedPool4.Validate(); err != nil {
+				return err
+			}
+
+			return createReservedPool4(tx, subnet, output.ReservedPool4)
+		default:
+			return nil
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func newSubnet4FreeAddressFinder(tx restdb.Transaction, subnet *resource.Subnet4, input *resource.NextFreeAddressInput) (*freeAddressFinder, error) {
+	begin := gohelperip.IPv4FromUint32(gohelperip.IPv4ToUint32(subnet.Ipnet.IP))
+	end := gohelperip.IPv4FromUint32(gohelperip.IPv4ToUint32(subnet.Ipnet.IP) |
+		^gohelperip.IPv4ToUint32(net.IP(subnet.Ipnet.Mask)))
+	if ones, _ := subnet.Ipnet.Mask.Size(); ones < 31 {
+		begin = gohelperip.IPv4FromUint32(gohelperip.IPv4ToUint32(begin) + 1)
+		end = gohelperip.IPv4FromUint32(gohelperip.IPv4ToUint32(end) - 1)
+	}
+
+	begin, end, err := parseNextFreeAddressRange(subnet.Ipnet, input, errorno.ErrNameNetworkV4,
+		gohelperip.ParseIPv4, begin, end)
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := getPool4sWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	reservedPools, err := getReservedPool4sWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	reservations, err := getReservation4sWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	finder := newFreeAddressFinder(subnet.Ipnet, begin, end)
+	for _, pool := range pools {
+		finder.excludeRange(pool.BeginIp, pool.EndIp)
+	}
+
+	for _, reservedPool := range reservedPools {
+		finder.excludeRange(reservedPool.BeginIp, reservedPool.EndIp)
+	}
+
+	for _, reservation := range reservations {
+		finder.excludeIp(reservation.Ip)
+	}
+
+	for _, router := range subnet.Routers {
+		if ip, err := gohelperip.ParseIPv4(router); err == nil {
+			finder.excludeIp(ip)
+		}
+	}
+
+	return finder, nil
+}
+
+func getUsedAddress4s(tx restdb.Transaction, subnet *resource.Subnet4, addresses []string, ping bool) (map[string]struct{}, error) {
+	usedAddresses := make(map[string]struct{})
+	if len(subnet.Nodes) != 0 {
+		reclaimedSubnetLeases, err := getReclaimedSubnetLease4sWithIps(tx, subnet.GetID(), addresses)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp *pbdhcpagent.GetLeases4Response
+		if err := transport.CallDhcpAgentGrpc4(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+			resp, err = client.GetSubnet4LeasesWithIps(ctx,
+				ipsToPbGetSubnet4LeasesWithIpsRequest(subnet.SubnetId, addresses))
+			return err
+		}); err != nil {
+			return nil, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+		}
+
+		leases, _ := subnetLease4sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases, nil, false)
+		for _, lease := range leases {
+			usedAddresses[lease.Address] = struct{}{}
+		}
+	}
+
+	if ping {
+		if err := pingAddresses(true, addresses, usedAddresses); err != nil {
+			return nil, err
+		}
+	}
+
+	return usedAddresses, nil
+}
+
+func (s *Subnet6Service) NextFreeAddress(subnetId string, input *resource.NextFreeAddressInput) (*resource.NextFreeAddressOutput, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	output := &resource.NextFreeAddressOutput{}
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		finder, err := newSubnet6FreeAddressFinder(tx, subnet, input)
+		if err != nil {
+			return err
+		}
+
+		if output.Addresses, err = finder.find(int(input.Count),
+			input.CreateMode == resource.NextFreeAddressCreateReservedPool,
+			func(addresses []string) (map[string]struct{}, error) {
+				return getUsedAddress6s(tx, subnet, addresses, input.Ping)
+			}); err != nil {
+			return err
+		} else if len(output.Addresses) < int(input.Count) {
+			return errorno.ErrNoFreeAddresses(subnet.Subnet, int(input.Count))
+		}
+
+		switch input.CreateMode {
+		case resource.NextFreeAddressCreateReservation:
+			output.Reservation6 = &resource.Reservation6{
+				Duid:        input.Duid,
+				HwAddress:   input.HwAddress,
+				Hostname:    input.Hostname,
+				IpAddresses: output.Addresses,
+				Comment:     input.Comment,
+			}
+			if err := output.Reservation6.Validate(); err != nil {
+				return err
+			}
+
+			return createReservation6(tx, subnet, output.Reservation6)
+		case resource.NextFreeAddressCreateReservedPool:
+			output.ReservedPool6 = &resource.ReservedPool6{
+				BeginAddress: output.Addresses[0],
+				EndAddress:   output.Addresses[len(output.Addresses)-1],
+				Comment:      input.Comment,
+			}
+			if err := output.ReservedPool6.Validate(); err != nil {
+				return err
+			}
+
+			return createReservedPool6(tx, subnet, output.ReservedPool6)
+		default:
+			return nil
+		}
+	}); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func newSubnet6FreeAddressFinder(tx restdb.Transaction, subnet *resource.Subnet6, input *resource.NextFreeAddressInput) (*freeAddressFinder, error) {
+	begin := ipToBigInt(subnet.Ipnet.IP, net.IPv6len*8)
+	ones, bits := subnet.Ipnet.Mask.Size()
+	end := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)), big.NewInt(1))
+	end.Add(end, begin)
+	if ones < 128 {
+		begin.Add(begin, big.NewInt(1))
+	}
+
+	beginIp, endIp, err := parseNextFreeAddressRange(subnet.Ipnet, input, errorno.ErrNameNetworkV6,
+		gohelperip.ParseIPv6, bigIntToIp(begin, bits), bigIntToIp(end, bits))
+	if err != nil {
+		return nil, err
+	}
+
+	pools, err := getPool6sWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	reservedPools, err := getReservedPool6sWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	reservations, err := getReservation6sWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	pdpools, err := getPdPoolsWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	reservedPdPools, err := getReservedPdPoolsWithSubnetId(tx, subnet.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	finder := newFreeAddressFinder(subnet.Ipnet, beginIp, endIp)
+	for _, pool := range pools {
+		finder.excludeRange(pool.BeginIp, pool.EndIp)
+	}
+
+	for _, reservedPool := range reservedPools {
+		finder.excludeRange(reservedPool.BeginIp, reservedPool.EndIp)
+	}
+
+	for _, reservation := range reservations {
+		for _, ip := range reservation.Ips {
+			finder.excludeIp(ip)
+		}
+
+		for _, ipnet := range reservation.Ipnets {
+			finder.excludeIpnet(ipnet)
+		}
+	}
+
+	for _, pdpool := range pdpools {
+		finder.excludeIpnet(pdpool.PrefixIpnet)
+	}
+
+	for _, reservedPdPool := range reservedPdPools {
+		finder.excludeIpnet(reservedPdPool.PrefixIpnet)
+	}
+
+	return finder, nil
+}
+
+func getUsedAddress6s(tx restdb.Transaction, subnet *resource.Subnet6, addresses []string, ping bool) (map[string]struct{}, error) {
+	usedAddresses := make(map[string]struct{})
+	if len(subnet.Nodes) != 0 {
+		reclaimedSubnetLeases, err := getReclaimedSubnetLease6sWithIps(tx, subnet.GetID(), addresses)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp *pbdhcpagent.GetLeases6Response
+		if err := transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+			resp, err = client.GetSubnet6LeasesWithIps(ctx,
+				ipsToPbGetSubnet6LeasesWithIpsRequest(subnet.SubnetId, addresses))
+			return err
+		}); err != nil {
+			return nil, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+		}
+
+		leases, _ := subnetLease6sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases, nil, false)
+		for _, lease := range leases {
+			usedAddresses[lease.Address] = struct{}{}
+		}
+	}
+
+	if ping {
+		if err := pingAddresses(false, addresses, usedAddresses); err != nil {
+			return nil, err
+		}
+	}
+
+	return usedAddresses, nil
+}
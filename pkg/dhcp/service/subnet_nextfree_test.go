@@ -0,0 +1,28 @@
+package service
+
+import (
+	"net"
+	"testing"
+)
+
+func TestFreeAddressFinderExcludeIpnet(t *testing.T) {
+	_, subnet, _ := net.ParseCIDR("2001:db8::/120")
+	finder := newFreeAddressFinder(*subnet, net.ParseIP("2001:db8::1"), net.ParseIP("2001:db8::ff"))
+	_, pdpool, _ := net.ParseCIDR("2001:db8::/126")
+	_, prefix, _ := net.ParseCIDR("2001:db8::8/125")
+	finder.excludeIpnet(*pdpool)
+	finder.excludeIpnet(*prefix)
+	finder.excludeIp(net.ParseIP("2001:db8::5"))
+
+	want := []string{"2001:db8::4", "2001:db8::6", "2001:db8::7", "2001:db8::10"}
+	ips := finder.candidates(len(want), false)
+	if len(ips) != len(want) {
+		t.Fatalf("candidates got %v, want %v", ips, want)
+	}
+
+	for i, ip := range ips {
+		if ip.String() != want[i] {
+			t.Errorf("candidate %d got %s, want %s", i, ip.String(), want[i])
+		}
+	}
+}
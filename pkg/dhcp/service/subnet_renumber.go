@@ -0,0 +1,741 @@
+package service
+
+import (
+	"encoding/hex"
+	"net"
+	"strconv"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	"github.com/golang/protobuf/proto"
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/kafka"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+)
+
+type subnetRenumberer struct {
+	oldIpnet net.IPNet
+	newIpnet net.IPNet
+	output   *resource.RenumberSubnetOutput
+}
+
+func newSubnetRenumberer(oldIpnet, newIpnet net.IPNet) *subnetRenumberer {
+	return &subnetRenumberer{
+		oldIpnet: oldIpnet,
+		newIpnet: newIpnet,
+		output: &resource.RenumberSubnetOutput{
+			OldSubnet: oldIpnet.String(),
+			NewSubnet: newIpnet.String(),
+		},
+	}
+}
+
+func (r *subnetRenumberer) address(address string) string {
+	return resource.RenumberAddress(address, r.oldIpnet, r.newIpnet)
+}
+
+func (r *subnetRenumberer) prefix(prefix string) string {
+	return resource.RenumberPrefix(prefix, r.oldIpnet, r.newIpnet)
+}
+
+func (r *subnetRenumberer) optionValue(value string) string {
+	return resource.RenumberOptionValue(value, r.oldIpnet, r.newIpnet)
+}
+
+func (r *subnetRenumberer) subnetAddresses(addresses []string) []string {
+	return r.addresses(&r.output.SubnetAddresses, "", addresses)
+}
+
+func (r *subnetRenumberer) subnetAddress(address string) string {
+	if address == "" {
+		return address
+	}
+
+	return r.subnetAddresses([]string{address})[0]
+}
+
+func (r *subnetRenumberer) addresses(mappings *[]*resource.RenumberMapping, id string, addresses []string) []string {
+	if len(addresses) == 0 {
+		return addresses
+	}
+
+	newAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		newAddress := r.address(address)
+		if newAddress != address {
+			*mappings = append(*mappings, &resource.RenumberMapping{Id: id, Old: address, New: newAddress})
+		}
+		newAddresses = append(newAddresses, newAddress)
+	}
+
+	return newAddresses
+}
+
+func (r *subnetRenumberer) overrides4(id string, routers, domainServers []string, nextServer string) map[string]interface{} {
+	count := len(r.output.Overrides)
+	newRouters := r.addresses(&r.output.Overrides, id, routers)
+	newDomainServers := r.addresses(&r.output.Overrides, id, domainServers)
+	newNextServer := nextServer
+	if nextServer != "" {
+		newNextServer = r.addresses(&r.output.Overrides, id, []string{nextServer})[0]
+	}
+
+	if len(r.output.Overrides) == count {
+		return nil
+	}
+
+	return map[string]interface{}{
+		resource.SqlColumnRouters:       newRouters,
+		resource.SqlColumnDomainServers: newDomainServers,
+		resource.SqlColumnNextServer:    newNextServer,
+	}
+}
+
+func (r *subnetRenumberer) overrides6(id string, domainServers []string) map[string]interface{} {
+	count := len(r.output.Overrides)
+	newDomainServers := r.addresses(&r.output.Overrides, id, domainServers)
+	if len(r.output.Overrides) == count {
+		return nil
+	}
+
+	return map[string]interface{}{resource.SqlColumnDomainServers: newDomainServers}
+}
+
+func (r *subnetRenumberer) pool(mappings *[]*resource.RenumberMapping, id, beginAddress, endAddress string) (string, string) {
+	newBeginAddress, newEndAddress := r.address(beginAddress), r.address(endAddress)
+	*mappings = append(*mappings, &resource.RenumberMapping{
+		Id:  id,
+		Old: beginAddress + resource.PoolDelimiter + endAddress,
+		New: newBeginAddress + resource.PoolDelimiter + newEndAddress,
+	})
+	return newBeginAddress, newEndAddress
+}
+
+func checkRenumberPrefixLen(oldIpnet, newIpnet net.IPNet, newSubnet string) error {
+	if oldOnes, _ := oldIpnet.Mask.Size(); oldIpnet.String() == newIpnet.String() {
+		return errorno.ErrInvalidParams(errorno.ErrNamePrefix, newSubnet)
+	} else if newOnes, _ := newIpnet.Mask.Size(); oldOnes != newOnes {
+		return errorno.ErrInvalidParams(errorno.ErrNamePrefix, newSubnet)
+	}
+
+	return nil
+}
+
+func (s *Subnet4Service) Renumber(subnetId string, input *resource.RenumberSubnetInput) (*resource.RenumberSubnetOutput, error) {
+	newIpnet, err := gohelperip.ParseCIDRv4(input.Subnet)
+	if err != nil {
+		return nil, errorno.ErrInvalidParams(errorno.ErrNamePrefix, input.Subnet)
+	}
+
+	var renumberer *subnetRenumberer
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet4, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := checkRenumberPrefixLen(subnet4.Ipnet, *newIpnet, input.Subnet); err != nil {
+			return err
+		}
+
+		var subnets []*resource.Subnet4
+		if err := tx.FillEx(&subnets, "select * from gr_subnet4 where id != $1", subnet4.GetID()); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV4),
+				pg.Error(err).Error())
+		}
+
+		newSubnet4 := *subnet4
+		newSubnet4.Ipnet = *newIpnet
+		newSubnet4.Subnet = newIpnet.String()
+		if err := checkSubnet4ConflictWithSubnet4s(&newSubnet4, subnets); err != nil {
+			return err
+		}
+
+		renumberer = newSubnetRenumberer(subnet4.Ipnet, *newIpnet)
+		if leasesCount, err := getSubnet4LeasesCount(subnet4); err != nil {
+			log.Warnf("get leases count of subnet4 %s failed: %s", subnet4.Subnet, formatError(err))
+		} else {
+			renumberer.output.LeasesCount = leasesCount
+		}
+
+		return renumberSubnet4(tx, subnet4, &newSubnet4, renumberer, input.Preview)
+	}); err != nil {
+		return nil, err
+	}
+
+	return renumberer.output, nil
+}
+
+func renumberSubnet4(tx restdb.Transaction, subnet4, newSubnet4 *resource.Subnet4, renumberer *subnetRenumberer, preview bool) error {
+	var pools []*resource.Pool4
+	var reservedPools []*resource.ReservedPool4
+	var reservations []*resource.Reservation4
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: subnet4.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnBeginIp}, &pools); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: subnet4.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnBeginIp}, &reservedPools); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: subnet4.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnIp}, &reservations); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation),
+			pg.Error(err).Error())
+	}
+
+	routesMap, err := getStaticRoute4sMapWithSubnetIds(tx, []string{subnet4.GetID()})
+	if err != nil {
+		return err
+	}
+
+	optionValues, err := renumberOptionValue4s(tx, subnet4.GetID(), renumberer)
+	if err != nil {
+		return err
+	}
+
+	output := renumberer.output
+	newSubnet4.Routers = renumberer.subnetAddresses(subnet4.Routers)
+	newSubnet4.DomainServers = renumberer.subnetAddresses(subnet4.DomainServers)
+	newSubnet4.NextServer = renumberer.subnetAddress(subnet4.NextServer)
+	newSubnet4.RelayAgentAddresses = renumberer.subnetAddresses(subnet4.RelayAgentAddresses)
+	newSubnet4.StaticRoutes = routesMap[subnet4.GetID()]
+	for _, route := range newSubnet4.StaticRoutes {
+		route.NextHops = renumberer.subnetAddresses(route.NextHops)
+	}
+
+	poolsAddresses := make(map[string][2]string, len(pools))
+	poolsOverrides := make(map[string]map[string]interface{})
+	for _, pool := range pools {
+		begin, end := renumberer.pool(&output.Pools, pool.GetID(), pool.BeginAddress, pool.EndAddress)
+		poolsAddresses[pool.GetID()] = [2]string{begin, end}
+		if overrides := renumberer.overrides4(pool.GetID(), pool.Routers, pool.DomainServers,
+			pool.NextServer); overrides != nil {
+			poolsOverrides[pool.GetID()] = overrides
+		}
+	}
+
+	reservedPoolsAddresses := make(map[string][2]string, len(reservedPools))
+	for _, pool := range reservedPools {
+		begin, end := renumberer.pool(&output.ReservedPools, pool.GetID(), pool.BeginAddress, pool.EndAddress)
+		reservedPoolsAddresses[pool.GetID()] = [2]string{begin, end}
+	}
+
+	reservationsAddress := make(map[string]string, len(reservations))
+	reservationsOverrides := make(map[string]map[string]interface{})
+	for _, reservation := range reservations {
+		address := renumberer.address(reservation.IpAddress)
+		output.Reservations = append(output.Reservations, &resource.RenumberMapping{
+			Id: reservation.GetID(), Old: reservation.IpAddress, New: address})
+		reservationsAddress[reservation.GetID()] = address
+		if overrides := renumberer.overrides4(reservation.GetID(), reservation.Routers,
+			reservation.DomainServers, reservation.NextServer); overrides != nil {
+			reservationsOverrides[reservation.GetID()] = overrides
+		}
+	}
+
+	if preview {
+		return nil
+	}
+
+	oldReq, oldCmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, subnet4)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Update(resource.TableSubnet4, map[string]interface{}{
+		resource.SqlColumnSubnet:              newSubnet4.Subnet,
+		resource.SqlColumnIpnet:               newSubnet4.Subnet,
+		resource.SqlColumnRouters:             newSubnet4.Routers,
+		resource.SqlColumnDomainServers:       newSubnet4.DomainServers,
+		resource.SqlColumnNextServer:          newSubnet4.NextServer,
+		resource.SqlColumnRelayAgentAddresses: newSubnet4.RelayAgentAddresses,
+	}, map[string]interface{}{restdb.IDField: subnet4.GetID()}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameUpdate, subnet4.Subnet, pg.Error(err).Error())
+	}
+
+	if err := saveStaticRoute4s(tx, subnet4.GetID(), newSubnet4.StaticRoutes); err != nil {
+		return err
+	}
+
+	for _, value := range optionValues {
+		if err := updateOptionValue(tx, resource.TableOptionValue4, value.GetID(),
+			value.Value, value.Data); err != nil {
+			return err
+		}
+	}
+
+	for id, addresses := range poolsAddresses {
+		if err := updatePoolAddresses(tx, resource.TablePool4, id, addresses,
+			errorno.ErrNameDhcpPool); err != nil {
+			return err
+		}
+	}
+
+	for id, addresses := range reservedPoolsAddresses {
+		if err := updatePoolAddresses(tx, resource.TableReservedPool4, id, addresses,
+			errorno.ErrNameDhcpReservedPool); err != nil {
+			return err
+		}
+	}
+
+	for id, address := range reservationsAddress {
+		if _, err := tx.Update(resource.TableReservation4, map[string]interface{}{
+			resource.SqlColumnIpAddress: address,
+			resource.SqlColumnIp:        address,
+		}, map[string]interface{}{restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errorno.ErrNameDhcpReservation),
+				pg.Error(err).Error())
+		}
+	}
+
+	if err := updateOverrides(tx, resource.TablePool4, poolsOverrides, errorno.ErrNameDhcpPool); err != nil {
+		return err
+	}
+
+	if err := updateOverrides(tx, resource.TableReservation4, reservationsOverrides,
+		errorno.ErrNameDhcpReservation); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("delete from gr_subnet_lease4 where subnet4 = $1", subnet4.GetID()); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameDelete, string(errorno.ErrNameLease),
+			pg.Error(err).Error())
+	}
+
+	newReq, newCmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, newSubnet4)
+	if err != nil {
+		return err
+	}
+
+	if err := sendRenumberSubnetCmdToDHCPAgent(true, subnet4.Nodes, subnet4.Subnet,
+		kafka.DeleteSubnet4, &pbdhcpagent.DeleteSubnet4Request{Id: subnet4.SubnetId},
+		oldCmd, oldReq, newCmd, newReq); err != nil {
+		return err
+	}
+
+	output.Applied = true
+	return nil
+}
+
+func renumberOptionValue4s(tx restdb.Transaction, subnetId string, renumberer *subnetRenumberer) ([]*resource.OptionValue4, error) {
+	values, err := getOptionValue4s(tx, map[string]interface{}{resource.SqlColumnSubnet4: subnetId})
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+
+	var optionDefs []*resource.OptionDef4
+	if err := tx.Fill(nil, &optionDefs); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameOptionDef),
+			pg.Error(err).Error())
+	}
+
+	var renumberedValues []*resource.OptionValue4
+	for _, value := range values {
+		newValue := renumberer.optionValue(value.Value)
+		if newValue == value.Value {
+			continue
+		}
+
+		var optionDef *resource.OptionDef4
+		for _, def := range optionDefs {
+			if def.Name == value.Name {
+				optionDef = def
+				break
+			}
+		}
+
+		if optionDef == nil {
+			return nil, errorno.ErrNotFound(errorno.ErrNameOptionDef, value.Name)
+		}
+
+		data, err := optionDef.Encode(newValue)
+		if err != nil {
+			return nil, err
+		}
+
+		renumberer.output.Options = append(renumberer.output.Options, &resource.RenumberMapping{
+			Id: value.GetID(), Old: value.Value, New: newValue})
+		value.Value, value.Data = newValue, hex.EncodeToString(data)
+		renumberedValues = append(renumberedValues, value)
+	}
+
+	return renumberedValues, nil
+}
+
+func updateOptionValue(tx restdb.Transaction, table restdb.ResourceType, id, value, data string) error {
+	if _, err := tx.Update(table, map[string]interface{}{
+		resource.SqlColumnValue: value,
+		resource.SqlColumnData:  data,
+	}, map[string]interface{}{restdb.IDField: id}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errorno.ErrNameOptionValue),
+			pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+func updateOverrides(tx restdb.Transaction, table restdb.ResourceType, overrides map[string]map[string]interface{}, errName errorno.ErrName) error {
+	for id, values := range overrides {
+		if _, err := tx.Update(table, values, map[string]interface{}{restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errName), pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func updatePoolAddresses(tx restdb.Transaction, table restdb.ResourceType, id string, addresses [2]string, errName errorno.ErrName) error {
+	if _, err := tx.Update(table, map[string]interface{}{
+		resource.SqlColumnBeginAddress: addresses[0],
+		resource.SqlColumnBeginIp:      addresses[0],
+		resource.SqlColumnEndAddress:   addresses[1],
+		resource.SqlColumnEndIp:        addresses[1],
+	}, map[string]interface{}{restdb.IDField: id}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errName), pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+// rollback to old subnet on nodes if deleting old subnet or creating new subnet failed
+func sendRenumberSubnetCmdToDHCPAgent(isv4 bool, sentryNodes []string, subnet string, deleteCmd kafka.DHCPCmd, deleteReq proto.Message, oldCmd kafka.DHCPCmd, oldReq proto.Message, newCmd kafka.DHCPCmd, newReq proto.Message) error {
+	nodes, err := kafka.GetDHCPNodesWithSentryNodes(sentryNodes, isv4)
+	if err != nil || len(nodes) == 0 {
+		return err
+	}
+
+	if nodesForSucceed, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+		nodes, deleteCmd, deleteReq); err != nil {
+		if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+			nodesForSucceed, oldCmd, oldReq); err != nil {
+			log.Errorf("renumber subnet %s failed, and rollback %v failed: %s",
+				subnet, nodesForSucceed, err.Error())
+		}
+		return err
+	}
+
+	if nodesForSucceed, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+		nodes, newCmd, newReq); err != nil {
+		if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+			nodesForSucceed, deleteCmd, deleteReq); err != nil {
+			log.Errorf("renumber subnet %s failed, and delete new subnet from %v failed: %s",
+				subnet, nodesForSucceed, err.Error())
+		}
+
+		if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+			nodes, oldCmd, oldReq); err != nil {
+			log.Errorf("renumber subnet %s failed, and rollback %v failed: %s",
+				subnet, nodes, err.Error())
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *Subnet6Service) Renumber(subnetId string, input *resource.RenumberSubnetInput) (*resource.RenumberSubnetOutput, error) {
+	newIpnet, err := gohelperip.ParseCIDRv6(input.Subnet)
+	if err != nil {
+		return nil, errorno.ErrInvalidParams(errorno.ErrNamePrefix, input.Subnet)
+	}
+
+	var renumberer *subnetRenumberer
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet6, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if err := checkRenumberPrefixLen(subnet6.Ipnet, *newIpnet, input.Subnet); err != nil {
+			return err
+		}
+
+		var subnets []*resource.Subnet6
+		if err := tx.FillEx(&subnets, "select * from gr_subnet6 where id != $1", subnet6.GetID()); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV6),
+				pg.Error(err).Error())
+		}
+
+		newSubnet6 := *subnet6
+		newSubnet6.Ipnet = *newIpnet
+		newSubnet6.Subnet = newIpnet.String()
+		if err := checkSubnet6ConflictWithSubnet6s(&newSubnet6, subnets); err != nil {
+			return err
+		}
+
+		renumberer = newSubnetRenumberer(subnet6.Ipnet, *newIpnet)
+		if leasesCount, err := getSubnet6LeasesCount(subnet6); err != nil {
+			log.Warnf("get leases count of subnet6 %s failed: %s", subnet6.Subnet, formatError(err))
+		} else {
+			renumberer.output.LeasesCount = leasesCount
+		}
+
+		return renumberSubnet6(tx, subnet6, &newSubnet6, renumberer, input.Preview)
+	}); err != nil {
+		return nil, err
+	}
+
+	return renumberer.output, nil
+}
+
+func renumberSubnet6(tx restdb.Transaction, subnet6, newSubnet6 *resource.Subnet6, renumberer *subnetRenumberer, preview bool) error {
+	var pools []*resource.Pool6
+	var reservedPools []*resource.ReservedPool6
+	var reservations []*resource.Reservation6
+	var pdpools []*resource.PdPool
+	var reservedPdPools []*resource.ReservedPdPool
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnBeginIp}, &pools); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnBeginIp}, &reservedPools); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID(),
+		resource.SqlOrderBy: "ips, ipnets"}, &reservations); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnPrefixIpNet}, &pdpools); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNamePdPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID(),
+		resource.SqlOrderBy: resource.SqlColumnPrefixIpNet}, &reservedPdPools); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameReservedPdPool),
+			pg.Error(err).Error())
+	}
+
+	optionValues, err := renumberOptionValue6s(tx, subnet6.GetID(), renumberer)
+	if err != nil {
+		return err
+	}
+
+	output := renumberer.output
+	newSubnet6.DomainServers = renumberer.subnetAddresses(subnet6.DomainServers)
+	newSubnet6.RelayAgentAddresses = renumberer.subnetAddresses(subnet6.RelayAgentAddresses)
+	poolsAddresses := make(map[string][2]string, len(pools))
+	poolsOverrides := make(map[string]map[string]interface{})
+	for _, pool := range pools {
+		begin, end := renumberer.pool(&output.Pools, pool.GetID(), pool.BeginAddress, pool.EndAddress)
+		poolsAddresses[pool.GetID()] = [2]string{begin, end}
+		if overrides := renumberer.overrides6(pool.GetID(), pool.DomainServers); overrides != nil {
+			poolsOverrides[pool.GetID()] = overrides
+		}
+	}
+
+	reservedPoolsAddresses := make(map[string][2]string, len(reservedPools))
+	for _, pool := range reservedPools {
+		begin, end := renumberer.pool(&output.ReservedPools, pool.GetID(), pool.BeginAddress, pool.EndAddress)
+		reservedPoolsAddresses[pool.GetID()] = [2]string{begin, end}
+	}
+
+	reservationsAddresses := make(map[string][2][]string, len(reservations))
+	reservationsOverrides := make(map[string]map[string]interface{})
+	for _, reservation := range reservations {
+		addresses := make([]string, 0, len(reservation.IpAddresses))
+		for _, address := range reservation.IpAddresses {
+			addresses = append(addresses, renumberer.address(address))
+		}
+
+		prefixes := make([]string, 0, len(reservation.Prefixes))
+		for _, prefix := range reservation.Prefixes {
+			prefixes = append(prefixes, renumberer.prefix(prefix))
+		}
+
+		output.Reservations = append(output.Reservations, &resource.RenumberMapping{
+			Id:  reservation.GetID(),
+			Old: reservation.AddrString(),
+			New: (&resource.Reservation6{IpAddresses: addresses, Prefixes: prefixes}).AddrString(),
+		})
+		reservationsAddresses[reservation.GetID()] = [2][]string{addresses, prefixes}
+		if overrides := renumberer.overrides6(reservation.GetID(), reservation.DomainServers); overrides != nil {
+			reservationsOverrides[reservation.GetID()] = overrides
+		}
+	}
+
+	pdpoolsPrefix := make(map[string]*resource.PdPool, len(pdpools))
+	for _, pdpool := range pdpools {
+		prefix := renumberer.address(pdpool.Prefix)
+		output.PdPools = append(output.PdPools, &resource.RenumberMapping{
+			Id: pdpool.GetID(), Old: pdpool.String(), New: (&resource.PdPool{Prefix: prefix,
+				PrefixLen: pdpool.PrefixLen, DelegatedLen: pdpool.DelegatedLen}).String()})
+		pdpoolsPrefix[pdpool.GetID()] = &resource.PdPool{Prefix: prefix, PrefixLen: pdpool.PrefixLen}
+	}
+
+	reservedPdPoolsPrefix := make(map[string]*resource.PdPool, len(reservedPdPools))
+	for _, pdpool := range reservedPdPools {
+		prefix := renumberer.address(pdpool.Prefix)
+		output.ReservedPdPools = append(output.ReservedPdPools, &resource.RenumberMapping{
+			Id: pdpool.GetID(), Old: pdpool.String(), New: (&resource.ReservedPdPool{Prefix: prefix,
+				PrefixLen: pdpool.PrefixLen, DelegatedLen: pdpool.DelegatedLen}).String()})
+		reservedPdPoolsPrefix[pdpool.GetID()] = &resource.PdPool{Prefix: prefix, PrefixLen: pdpool.PrefixLen}
+	}
+
+	if preview {
+		return nil
+	}
+
+	oldReq, oldCmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, subnet6)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Update(resource.TableSubnet6, map[string]interface{}{
+		resource.SqlColumnSubnet:              newSubnet6.Subnet,
+		resource.SqlColumnIpnet:               newSubnet6.Subnet,
+		resource.SqlColumnDomainServers:       newSubnet6.DomainServers,
+		resource.SqlColumnRelayAgentAddresses: newSubnet6.RelayAgentAddresses,
+	}, map[string]interface{}{restdb.IDField: subnet6.GetID()}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameUpdate, subnet6.Subnet, pg.Error(err).Error())
+	}
+
+	for _, value := range optionValues {
+		if err := updateOptionValue(tx, resource.TableOptionValue6, value.GetID(),
+			value.Value, value.Data); err != nil {
+			return err
+		}
+	}
+
+	for id, addresses := range poolsAddresses {
+		if err := updatePoolAddresses(tx, resource.TablePool6, id, addresses,
+			errorno.ErrNameDhcpPool); err != nil {
+			return err
+		}
+	}
+
+	for id, addresses := range reservedPoolsAddresses {
+		if err := updatePoolAddresses(tx, resource.TableReservedPool6, id, addresses,
+			errorno.ErrNameDhcpReservedPool); err != nil {
+			return err
+		}
+	}
+
+	for id, addresses := range reservationsAddresses {
+		if _, err := tx.Update(resource.TableReservation6, map[string]interface{}{
+			resource.SqlColumnIpAddresses: addresses[0],
+			resource.SqlColumnIps:         addresses[0],
+			resource.SqlColumnPrefixes:    addresses[1],
+			resource.SqlColumnIpNets:      addresses[1],
+		}, map[string]interface{}{restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errorno.ErrNameDhcpReservation),
+				pg.Error(err).Error())
+		}
+	}
+
+	if err := updateOverrides(tx, resource.TablePool6, poolsOverrides, errorno.ErrNameDhcpPool); err != nil {
+		return err
+	}
+
+	if err := updateOverrides(tx, resource.TableReservation6, reservationsOverrides,
+		errorno.ErrNameDhcpReservation); err != nil {
+		return err
+	}
+
+	for id, prefix := range pdpoolsPrefix {
+		if err := updatePdPoolPrefix(tx, resource.TablePdPool, id, prefix,
+			errorno.ErrNamePdPool); err != nil {
+			return err
+		}
+	}
+
+	for id, prefix := range reservedPdPoolsPrefix {
+		if err := updatePdPoolPrefix(tx, resource.TableReservedPdPool, id, prefix,
+			errorno.ErrNameReservedPdPool); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec("delete from gr_subnet_lease6 where subnet6 = $1", subnet6.GetID()); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameDelete, string(errorno.ErrNameLease),
+			pg.Error(err).Error())
+	}
+
+	newReq, newCmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, newSubnet6)
+	if err != nil {
+		return err
+	}
+
+	if err := sendRenumberSubnetCmdToDHCPAgent(false, subnet6.Nodes, subnet6.Subnet,
+		kafka.DeleteSubnet6, &pbdhcpagent.DeleteSubnet6Request{Id: subnet6.SubnetId},
+		oldCmd, oldReq, newCmd, newReq); err != nil {
+		return err
+	}
+
+	output.Applied = true
+	return nil
+}
+
+func renumberOptionValue6s(tx restdb.Transaction, subnetId string, renumberer *subnetRenumberer) ([]*resource.OptionValue6, error) {
+	values, err := getOptionValue6s(tx, map[string]interface{}{resource.SqlColumnSubnet6: subnetId})
+	if err != nil || len(values) == 0 {
+		return nil, err
+	}
+
+	var optionDefs []*resource.OptionDef6
+	if err := tx.Fill(nil, &optionDefs); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameOptionDef),
+			pg.Error(err).Error())
+	}
+
+	var renumberedValues []*resource.OptionValue6
+	for _, value := range values {
+		newValue := renumberer.optionValue(value.Value)
+		if newValue == value.Value {
+			continue
+		}
+
+		var optionDef *resource.OptionDef6
+		for _, def := range optionDefs {
+			if def.Name == value.Name {
+				optionDef = def
+				break
+			}
+		}
+
+		if optionDef == nil {
+			return nil, errorno.ErrNotFound(errorno.ErrNameOptionDef, value.Name)
+		}
+
+		data, err := optionDef.Encode(newValue)
+		if err != nil {
+			return nil, err
+		}
+
+		renumberer.output.Options = append(renumberer.output.Options, &resource.RenumberMapping{
+			Id: value.GetID(), Old: value.Value, New: newValue})
+		value.Value, value.Data = newValue, hex.EncodeToString(data)
+		renumberedValues = append(renumberedValues, value)
+	}
+
+	return renumberedValues, nil
+}
+
+func updatePdPoolPrefix(tx restdb.Transaction, table restdb.ResourceType, id string, pdpool *resource.PdPool, errName errorno.ErrName) error {
+	if _, err := tx.Update(table, map[string]interface{}{
+		resource.SqlColumnPrefix:      pdpool.Prefix,
+		resource.SqlColumnPrefixIpNet: pdpool.Prefix + "/" + strconv.Itoa(int(pdpool.PrefixLen)),
+	}, map[string]interface{}{restdb.IDField: id}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errName), pg.Error(err).Error())
+	}
+
+	return nil
+}
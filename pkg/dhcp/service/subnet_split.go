@@ -0,0 +1,749 @@
+package service
+
+import (
+	"math/big"
+	"net"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/linkingthing/cement/log"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/config"
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/kafka"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type subnetSplitter struct {
+	ipnet     net.IPNet
+	prefixLen uint32
+	bits      int
+	base      *big.Int
+	ipnets    []net.IPNet
+}
+
+func newSubnetSplitter(ipnet net.IPNet, prefixLen uint32) (*subnetSplitter, error) {
+	ones, bits := ipnet.Mask.Size()
+	if prefixLen <= uint32(ones) || prefixLen > uint32(bits) {
+		return nil, errorno.ErrNotInRange(errorno.ErrNamePrefixLen, ones+1, bits)
+	}
+
+	if diff := prefixLen - uint32(ones); diff >= 63 || 1<<diff > resource.MaxSplitSubnetsCount {
+		return nil, errorno.ErrExceedMaxCount(errorno.ErrNameNetwork, resource.MaxSplitSubnetsCount)
+	}
+
+	splitter := &subnetSplitter{ipnet: ipnet, prefixLen: prefixLen, bits: bits}
+	splitter.base = ipToBigInt(ipnet.IP, bits)
+	count := 1 << (prefixLen - uint32(ones))
+	step := new(big.Int).Lsh(big.NewInt(1), uint(bits)-uint(prefixLen))
+	for i := 0; i < count; i++ {
+		ip := new(big.Int).Add(splitter.base, new(big.Int).Mul(step, big.NewInt(int64(i))))
+		splitter.ipnets = append(splitter.ipnets, net.IPNet{
+			IP:   bigIntToIp(ip, bits),
+			Mask: net.CIDRMask(int(prefixLen), bits),
+		})
+	}
+
+	return splitter, nil
+}
+
+func ipToBigInt(ip net.IP, bits int) *big.Int {
+	if bits == net.IPv4len*8 {
+		return new(big.Int).SetBytes(ip.To4())
+	} else {
+		return new(big.Int).SetBytes(ip.To16())
+	}
+}
+
+func bigIntToIp(i *big.Int, bits int) net.IP {
+	return net.IP(i.FillBytes(make([]byte, bits/8)))
+}
+
+func (s *subnetSplitter) index(ip net.IP) int {
+	offset := new(big.Int).Sub(ipToBigInt(ip, s.bits), s.base)
+	return int(offset.Rsh(offset, uint(s.bits)-uint(s.prefixLen)).Int64())
+}
+
+func (s *subnetSplitter) rangeIndex(errName errorno.ErrName, value string, begin, end net.IP) (int, error) {
+	if index := s.index(begin); index != s.index(end) {
+		return 0, errorno.ErrStraddleSubnets(errName, value, s.prefixLen)
+	} else {
+		return index, nil
+	}
+}
+
+func (s *subnetSplitter) prefixIndex(errName errorno.ErrName, prefix net.IPNet) (int, error) {
+	if ones, _ := prefix.Mask.Size(); uint32(ones) < s.prefixLen {
+		return 0, errorno.ErrStraddleSubnets(errName, prefix.String(), s.prefixLen)
+	} else {
+		return s.index(prefix.IP), nil
+	}
+}
+
+func (s *subnetSplitter) ipsIndex(errName errorno.ErrName, value string, ips []net.IP, prefixes []net.IPNet) (int, error) {
+	index := -1
+	for _, ip := range ips {
+		if i := s.index(ip); index != -1 && i != index {
+			return 0, errorno.ErrStraddleSubnets(errName, value, s.prefixLen)
+		} else {
+			index = i
+		}
+	}
+
+	for _, prefix := range prefixes {
+		if i, err := s.prefixIndex(errName, prefix); err != nil {
+			return 0, err
+		} else if index != -1 && i != index {
+			return 0, errorno.ErrStraddleSubnets(errName, value, s.prefixLen)
+		} else {
+			index = i
+		}
+	}
+
+	if index == -1 {
+		index = 0
+	}
+
+	return index, nil
+}
+
+// addresses not in any child subnet, such as dns servers, belong to all children
+func (s *subnetSplitter) addresses(addresses []string) [][]string {
+	childAddresses := make([][]string, len(s.ipnets))
+	for _, address := range addresses {
+		if ip := net.ParseIP(address); ip != nil && s.ipnet.Contains(ip) {
+			index := s.index(ip)
+			childAddresses[index] = append(childAddresses[index], address)
+		} else {
+			for i := range childAddresses {
+				childAddresses[i] = append(childAddresses[i], address)
+			}
+		}
+	}
+
+	return childAddresses
+}
+
+func (s *subnetSplitter) subnetMask(subnetMask string) string {
+	if subnetMask == net.IP(s.ipnet.Mask).String() {
+		return net.IP(net.CIDRMask(int(s.prefixLen), s.bits)).String()
+	} else {
+		return subnetMask
+	}
+}
+
+func checkSubnetsCouldBeAdded(tx restdb.Transaction, table restdb.ResourceType, errName errorno.ErrName, count int) error {
+	if total, err := tx.Count(table, nil); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameCount, string(errName), pg.Error(err).Error())
+	} else if total+int64(count) > int64(config.GetMaxSubnetsCount()) {
+		return errorno.ErrExceedMaxCount(errName, config.GetMaxSubnetsCount())
+	}
+
+	return nil
+}
+
+func updateResourcesSubnet(tx restdb.Transaction, table restdb.ResourceType, subnetColumn, subnetId string, conditions map[string]interface{}, errName errorno.ErrName) error {
+	if _, err := tx.Update(table, map[string]interface{}{subnetColumn: subnetId},
+		conditions); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errName), pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+func moveResourcesToSubnet(tx restdb.Transaction, table restdb.ResourceType, subnetColumn, subnetId string, ids []string, errName errorno.ErrName) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return updateResourcesSubnet(tx, table, subnetColumn, subnetId, map[string]interface{}{
+		restdb.IDField: restdb.FillValue{Operator: restdb.OperatorAny, Value: ids}}, errName)
+}
+
+type subnetCmd struct {
+	cmd kafka.DHCPCmd
+	req proto.Message
+}
+
+// recreate old subnets on nodes if deleting old subnets or creating new subnets failed
+func sendReplaceSubnetsCmdToDHCPAgent(isv4 bool, sentryNodes []string, action string, deleteOldCmd kafka.DHCPCmd, deleteOldReq proto.Message, oldCmds []*subnetCmd, deleteNewCmd kafka.DHCPCmd, deleteNewReq proto.Message, newCmds []*subnetCmd) error {
+	recreateOldSubnets := func(nodes []string) {
+		for _, old := range oldCmds {
+			if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+				nodes, old.cmd, old.req); err != nil {
+				log.Errorf("%s failed, and rollback %v failed: %s", action, nodes, err.Error())
+			}
+		}
+	}
+
+	if err := kafka.SendDHCPCmdWithNodes(isv4, sentryNodes, deleteOldCmd, deleteOldReq,
+		recreateOldSubnets); err != nil {
+		return err
+	}
+
+	for _, newCmd := range newCmds {
+		if err := kafka.SendDHCPCmdWithNodes(isv4, sentryNodes, newCmd.cmd, newCmd.req,
+			func(nodesForSucceed []string) {
+				nodes, err := kafka.GetDHCPNodesWithSentryNodes(sentryNodes, isv4)
+				if err != nil {
+					log.Errorf("%s failed, and get nodes for rollback failed: %s", action, err.Error())
+					return
+				}
+
+				if _, err := kafka.GetDHCPAgentService().SendDHCPCmdWithNodes(
+					nodes, deleteNewCmd, deleteNewReq); err != nil {
+					log.Errorf("%s failed, and delete new subnets from %v failed: %s",
+						action, nodes, err.Error())
+				}
+
+				recreateOldSubnets(nodes)
+			}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Subnet4Service) Split(subnetId string, input *resource.SplitSubnetInput) (*resource.Subnet4ListOutput, error) {
+	var children []*resource.Subnet4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet4, err := getSubnet4FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		splitter, err := newSubnetSplitter(subnet4.Ipnet, input.PrefixLen)
+		if err != nil {
+			return err
+		}
+
+		if err := checkSubnetsCouldBeAdded(tx, resource.TableSubnet4, errorno.ErrNameNetworkV4,
+			len(splitter.ipnets)-1); err != nil {
+			return err
+		}
+
+		children, err = splitSubnet4(tx, subnet4, splitter)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &resource.Subnet4ListOutput{Subnet4s: children}, nil
+}
+
+func splitSubnet4(tx restdb.Transaction, subnet4 *resource.Subnet4, splitter *subnetSplitter) ([]*resource.Subnet4, error) {
+	var pools []*resource.Pool4
+	var reservedPools []*resource.ReservedPool4
+	var reservations []*resource.Reservation4
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: subnet4.GetID()},
+		&pools); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: subnet4.GetID()},
+		&reservedPools); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet4: subnet4.GetID()},
+		&reservations); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation),
+			pg.Error(err).Error())
+	}
+
+	oldReq, oldCmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, subnet4)
+	if err != nil {
+		return nil, err
+	}
+
+	routers := splitter.addresses(subnet4.Routers)
+	relayAgentAddresses := splitter.addresses(subnet4.RelayAgentAddresses)
+	children := make([]*resource.Subnet4, 0, len(splitter.ipnets))
+	for i, ipnet := range splitter.ipnets {
+		child := *subnet4
+		child.Ipnet = ipnet
+		child.Subnet = ipnet.String()
+		child.SubnetMask = splitter.subnetMask(subnet4.SubnetMask)
+		child.Routers = routers[i]
+		child.RelayAgentAddresses = relayAgentAddresses[i]
+		child.Capacity = 0
+		child.StaticRoutes = nil
+		children = append(children, &child)
+	}
+
+	for _, route := range subnet4.StaticRoutes {
+		index := -1
+		for _, nextHop := range route.NextHops {
+			if i := splitter.index(net.ParseIP(nextHop)); index != -1 && i != index {
+				return nil, errorno.ErrStraddleSubnets(errorno.ErrNameStaticRoute,
+					route.Destination, splitter.prefixLen)
+			} else {
+				index = i
+			}
+		}
+
+		if index == -1 {
+			index = 0
+		}
+
+		children[index].StaticRoutes = append(children[index].StaticRoutes,
+			&resource.StaticRoute4{Destination: route.Destination, NextHops: route.NextHops})
+	}
+
+	childPools := make([][]*resource.Pool4, len(children))
+	childPoolIds := make([][]string, len(children))
+	for _, pool := range pools {
+		index, err := splitter.rangeIndex(errorno.ErrNameDhcpPool, pool.String(),
+			pool.BeginIp, pool.EndIp)
+		if err != nil {
+			return nil, err
+		}
+
+		children[index].Capacity += pool.Capacity
+		childPools[index] = append(childPools[index], pool)
+		childPoolIds[index] = append(childPoolIds[index], pool.GetID())
+	}
+
+	childReservedPoolIds := make([][]string, len(children))
+	for _, pool := range reservedPools {
+		index, err := splitter.rangeIndex(errorno.ErrNameDhcpReservedPool, pool.String(),
+			pool.BeginIp, pool.EndIp)
+		if err != nil {
+			return nil, err
+		}
+
+		childReservedPoolIds[index] = append(childReservedPoolIds[index], pool.GetID())
+	}
+
+	childReservationIds := make([][]string, len(children))
+	poolsCapacity := make(map[string]uint64)
+	for _, reservation := range reservations {
+		index := splitter.index(reservation.Ip)
+		recalculateSubnetAndPoolsCapacityWithReservation4(children[index], childPools[index],
+			reservation, poolsCapacity, true)
+		childReservationIds[index] = append(childReservationIds[index], reservation.GetID())
+	}
+
+	for _, child := range children {
+		if err := child.ValidateStaticRoutes(); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Update(resource.TableSubnet4, map[string]interface{}{
+		resource.SqlColumnSubnet:              children[0].Subnet,
+		resource.SqlColumnIpnet:               children[0].Subnet,
+		resource.SqlColumnSubnetMask:          children[0].SubnetMask,
+		resource.SqlColumnRouters:             children[0].Routers,
+		resource.SqlColumnRelayAgentAddresses: children[0].RelayAgentAddresses,
+		resource.SqlColumnCapacity:            children[0].Capacity,
+	}, map[string]interface{}{restdb.IDField: subnet4.GetID()}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameUpdate, subnet4.Subnet, pg.Error(err).Error())
+	}
+
+	ddns, err := getSubnetDdns4sWithSubnetId(tx, subnet4.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	for i, child := range children {
+		if i != 0 {
+			if err := insertSplitSubnet4(tx, child, subnet4.Options, ddns); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := saveStaticRoute4s(tx, child.GetID(), child.StaticRoutes); err != nil {
+			return nil, err
+		}
+
+		if err := moveSubnet4ChildrenToSubnet(tx, child.GetID(), childPoolIds[i],
+			childReservedPoolIds[i], childReservationIds[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec("delete from gr_subnet_lease4 where subnet4 = $1", subnet4.GetID()); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameDelete, string(errorno.ErrNameLease),
+			pg.Error(err).Error())
+	}
+
+	newCmds := make([]*subnetCmd, 0, len(children))
+	deleteNewReq := &pbdhcpagent.DeleteSubnets4Request{}
+	for _, child := range children {
+		req, cmd, err := genCreateSubnets4AndPoolsRequestWithSubnet4(tx, child)
+		if err != nil {
+			return nil, err
+		}
+
+		newCmds = append(newCmds, &subnetCmd{cmd: cmd, req: req})
+		deleteNewReq.Ids = append(deleteNewReq.Ids, child.SubnetId)
+	}
+
+	if err := sendReplaceSubnetsCmdToDHCPAgent(true, subnet4.Nodes, "split subnet4 "+subnet4.Subnet,
+		kafka.DeleteSubnet4, &pbdhcpagent.DeleteSubnet4Request{Id: subnet4.SubnetId},
+		[]*subnetCmd{&subnetCmd{cmd: oldCmd, req: oldReq}},
+		kafka.DeleteSubnet4s, deleteNewReq, newCmds); err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+func insertSplitSubnet4(tx restdb.Transaction, subnet *resource.Subnet4, options []*resource.OptionValue4, ddns []*resource.SubnetDdns4) error {
+	if err := setSubnet4ID(tx, subnet); err != nil {
+		return err
+	}
+
+	if _, err := tx.Insert(subnet); err != nil {
+		return util.FormatDbInsertError(errorno.ErrNameNetwork, subnet.Subnet, err)
+	}
+
+	subnet.Options = make([]*resource.OptionValue4, 0, len(options))
+	for _, option := range options {
+		subnet.Options = append(subnet.Options, &resource.OptionValue4{
+			Name: option.Name, Code: option.Code, Value: option.Value, Data: option.Data})
+	}
+
+	if err := saveOptionValue4s(tx, resource.OptionScopeSubnet4, subnet.GetID(),
+		subnet.GetID(), subnet.Options); err != nil {
+		return err
+	}
+
+	for _, setting := range ddns {
+		newSetting := *setting
+		newSetting.Subnet4 = subnet.GetID()
+		newSetting.SetID(subnet.GetID())
+		if _, err := tx.Insert(&newSetting); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert, string(errorno.ErrNameDdns),
+				pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func getSubnetDdns4sWithSubnetId(tx restdb.Transaction, subnetId string) ([]*resource.SubnetDdns4, error) {
+	var settings []*resource.SubnetDdns4
+	if err := tx.Fill(map[string]interface{}{restdb.IDField: subnetId}, &settings); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDdns),
+			pg.Error(err).Error())
+	}
+
+	return settings, nil
+}
+
+func moveSubnet4ChildrenToSubnet(tx restdb.Transaction, subnetId string, poolIds, reservedPoolIds, reservationIds []string) error {
+	if err := moveResourcesToSubnet(tx, resource.TablePool4, resource.SqlColumnSubnet4,
+		subnetId, poolIds, errorno.ErrNameDhcpPool); err != nil {
+		return err
+	}
+
+	if err := moveResourcesToSubnet(tx, resource.TableReservedPool4, resource.SqlColumnSubnet4,
+		subnetId, reservedPoolIds, errorno.ErrNameDhcpReservedPool); err != nil {
+		return err
+	}
+
+	if err := moveResourcesToSubnet(tx, resource.TableReservation4, resource.SqlColumnSubnet4,
+		subnetId, reservationIds, errorno.ErrNameDhcpReservation); err != nil {
+		return err
+	}
+
+	if scopeIds := append(append([]string{}, poolIds...), reservationIds...); len(scopeIds) != 0 {
+		return updateResourcesSubnet(tx, resource.TableOptionValue4, resource.SqlColumnSubnet4,
+			subnetId, map[string]interface{}{resource.SqlColumnScopeId: restdb.FillValue{
+				Operator: restdb.OperatorAny, Value: scopeIds}}, errorno.ErrNameOptionValue)
+	}
+
+	return nil
+}
+
+func (s *Subnet6Service) Split(subnetId string, input *resource.SplitSubnetInput) (*resource.Subnet6ListOutput, error) {
+	var children []*resource.Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		subnet6, err := getSubnet6FromDB(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if subnet6.CanNotHasPools() {
+			return errorno.ErrSubnetCanNotHasPools(subnet6.Subnet)
+		}
+
+		splitter, err := newSubnetSplitter(subnet6.Ipnet, input.PrefixLen)
+		if err != nil {
+			return err
+		}
+
+		if err := checkSubnetsCouldBeAdded(tx, resource.TableSubnet6, errorno.ErrNameNetworkV6,
+			len(splitter.ipnets)-1); err != nil {
+			return err
+		}
+
+		children, err = splitSubnet6(tx, subnet6, splitter)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return &resource.Subnet6ListOutput{Subnet6s: children}, nil
+}
+
+func splitSubnet6(tx restdb.Transaction, subnet6 *resource.Subnet6, splitter *subnetSplitter) ([]*resource.Subnet6, error) {
+	var pools []*resource.Pool6
+	var reservedPools []*resource.ReservedPool6
+	var reservations []*resource.Reservation6
+	var pdpools []*resource.PdPool
+	var reservedPdPools []*resource.ReservedPdPool
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID()},
+		&pools); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID()},
+		&reservedPools); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID()},
+		&reservations); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID()},
+		&pdpools); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNamePdPool),
+			pg.Error(err).Error())
+	}
+
+	if err := tx.Fill(map[string]interface{}{resource.SqlColumnSubnet6: subnet6.GetID()},
+		&reservedPdPools); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameReservedPdPool),
+			pg.Error(err).Error())
+	}
+
+	oldReq, oldCmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, subnet6)
+	if err != nil {
+		return nil, err
+	}
+
+	relayAgentAddresses := splitter.addresses(subnet6.RelayAgentAddresses)
+	children := make([]*resource.Subnet6, 0, len(splitter.ipnets))
+	for i, ipnet := range splitter.ipnets {
+		child := *subnet6
+		child.Ipnet = ipnet
+		child.Subnet = ipnet.String()
+		child.RelayAgentAddresses = relayAgentAddresses[i]
+		child.Capacity = "0"
+		children = append(children, &child)
+	}
+
+	childPools := make([][]*resource.Pool6, len(children))
+	childPoolIds := make([][]string, len(children))
+	for _, pool := range pools {
+		index, err := splitter.rangeIndex(errorno.ErrNameDhcpPool, pool.String(),
+			pool.BeginIp, pool.EndIp)
+		if err != nil {
+			return nil, err
+		}
+
+		children[index].AddCapacityWithString(pool.Capacity)
+		childPools[index] = append(childPools[index], pool)
+		childPoolIds[index] = append(childPoolIds[index], pool.GetID())
+	}
+
+	childReservedPoolIds := make([][]string, len(children))
+	for _, pool := range reservedPools {
+		index, err := splitter.rangeIndex(errorno.ErrNameDhcpReservedPool, pool.String(),
+			pool.BeginIp, pool.EndIp)
+		if err != nil {
+			return nil, err
+		}
+
+		childReservedPoolIds[index] = append(childReservedPoolIds[index], pool.GetID())
+	}
+
+	childPdPools := make([][]*resource.PdPool, len(children))
+	childPdPoolIds := make([][]string, len(children))
+	for _, pdpool := range pdpools {
+		index, err := splitter.prefixIndex(errorno.ErrNamePdPool, pdpool.PrefixIpnet)
+		if err != nil {
+			return nil, err
+		}
+
+		children[index].AddCapacityWithString(pdpool.Capacity)
+		childPdPools[index] = append(childPdPools[index], pdpool)
+		childPdPoolIds[index] = append(childPdPoolIds[index], pdpool.GetID())
+	}
+
+	childReservedPdPoolIds := make([][]string, len(children))
+	for _, pdpool := range reservedPdPools {
+		index, err := splitter.prefixIndex(errorno.ErrNameReservedPdPool, pdpool.PrefixIpnet)
+		if err != nil {
+			return nil, err
+		}
+
+		childReservedPdPoolIds[index] = append(childReservedPdPoolIds[index], pdpool.GetID())
+	}
+
+	childReservationIds := make([][]string, len(children))
+	poolsCapacity := make(map[string]string)
+	for _, reservation := range reservations {
+		index, err := splitter.ipsIndex(errorno.ErrNameDhcpReservation, reservation.AddrString(),
+			reservation.Ips, reservation.Ipnets)
+		if err != nil {
+			return nil, err
+		}
+
+		recalculateSubnet6AndPool6sCapacityWithIps(children[index], childPools[index],
+			reservation.Ips, poolsCapacity, true)
+		recalculateSubnet6AndPdPoolsCapacityWithPrefixes(children[index], childPdPools[index],
+			reservation.Ipnets, poolsCapacity, true)
+		childReservationIds[index] = append(childReservationIds[index], reservation.GetID())
+	}
+
+	if _, err := tx.Update(resource.TableSubnet6, map[string]interface{}{
+		resource.SqlColumnSubnet:              children[0].Subnet,
+		resource.SqlColumnIpnet:               children[0].Subnet,
+		resource.SqlColumnRelayAgentAddresses: children[0].RelayAgentAddresses,
+		resource.SqlColumnCapacity:            children[0].Capacity,
+	}, map[string]interface{}{restdb.IDField: subnet6.GetID()}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameUpdate, subnet6.Subnet, pg.Error(err).Error())
+	}
+
+	ddns, err := getSubnetDdns6sWithSubnetId(tx, subnet6.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	for i, child := range children {
+		if i == 0 {
+			continue
+		}
+
+		if err := insertSplitSubnet6(tx, child, subnet6.Options, ddns); err != nil {
+			return nil, err
+		}
+
+		if err := moveSubnet6ChildrenToSubnet(tx, child.GetID(), childPoolIds[i],
+			childReservedPoolIds[i], childReservationIds[i], childPdPoolIds[i],
+			childReservedPdPoolIds[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.Exec("delete from gr_subnet_lease6 where subnet6 = $1", subnet6.GetID()); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameDelete, string(errorno.ErrNameLease),
+			pg.Error(err).Error())
+	}
+
+	newCmds := make([]*subnetCmd, 0, len(children))
+	deleteNewReq := &pbdhcpagent.DeleteSubnets6Request{}
+	for _, child := range children {
+		req, cmd, err := genCreateSubnets6AndPoolsRequestWithSubnet6(tx, child)
+		if err != nil {
+			return nil, err
+		}
+
+		newCmds = append(newCmds, &subnetCmd{cmd: cmd, req: req})
+		deleteNewReq.Ids = append(deleteNewReq.Ids, child.SubnetId)
+	}
+
+	if err := sendReplaceSubnetsCmdToDHCPAgent(false, subnet6.Nodes, "split subnet6 "+subnet6.Subnet,
+		kafka.DeleteSubnet6, &pbdhcpagent.DeleteSubnet6Request{Id: subnet6.SubnetId},
+		[]*subnetCmd{&subnetCmd{cmd: oldCmd, req: oldReq}},
+		kafka.DeleteSubnet6s, deleteNewReq, newCmds); err != nil {
+		return nil, err
+	}
+
+	return children, nil
+}
+
+func insertSplitSubnet6(tx restdb.Transaction, subnet *resource.Subnet6, options []*resource.OptionValue6, ddns []*resource.SubnetDdns6) error {
+	if err := setSubnet6ID(tx, subnet); err != nil {
+		return err
+	}
+
+	if _, err := tx.Insert(subnet); err != nil {
+		return util.FormatDbInsertError(errorno.ErrNameNetwork, subnet.Subnet, err)
+	}
+
+	subnet.Options = make([]*resource.OptionValue6, 0, len(options))
+	for _, option := range options {
+		subnet.Options = append(subnet.Options, &resource.OptionValue6{
+			Name: option.Name, Code: option.Code, Value: option.Value, Data: option.Data})
+	}
+
+	if err := saveOptionValue6s(tx, resource.OptionScopeSubnet6, subnet.GetID(),
+		subnet.GetID(), subnet.Options); err != nil {
+		return err
+	}
+
+	for _, setting := range ddns {
+		newSetting := *setting
+		newSetting.Subnet6 = subnet.GetID()
+		newSetting.SetID(subnet.GetID())
+		if _, err := tx.Insert(&newSetting); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert, string(errorno.ErrNameDdns),
+				pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func getSubnetDdns6sWithSubnetId(tx restdb.Transaction, subnetId string) ([]*resource.SubnetDdns6, error) {
+	var settings []*resource.SubnetDdns6
+	if err := tx.Fill(map[string]interface{}{restdb.IDField: subnetId}, &settings); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDdns),
+			pg.Error(err).Error())
+	}
+
+	return settings, nil
+}
+
+func moveSubnet6ChildrenToSubnet(tx restdb.Transaction, subnetId string, poolIds, reservedPoolIds, reservationIds, pdpoolIds, reservedPdPoolIds []string) error {
+	if err := moveResourcesToSubnet(tx, resource.TablePool6, resource.SqlColumnSubnet6,
+		subnetId, poolIds, errorno.ErrNameDhcpPool); err != nil {
+		return err
+	}
+
+	if err := moveResourcesToSubnet(tx, resource.TableReservedPool6, resource.SqlColumnSubnet6,
+		subnetId, reservedPoolIds, errorno.ErrNameDhcpReservedPool); err != nil {
+		return err
+	}
+
+	if err := moveResourcesToSubnet(tx, resource.TableReservation6, resource.SqlColumnSubnet6,
+		subnetId, reservationIds, errorno.ErrNameDhcpReservation); err != nil {
+		return err
+	}
+
+	if err := moveResourcesToSubnet(tx, resource.TablePdPool, resource.SqlColumnSubnet6,
+		subnetId, pdpoolIds, errorno.ErrNamePdPool); err != nil {
+		return err
+	}
+
+	if err := moveResourcesToSubnet(tx, resource.TableReservedPdPool, resource.SqlColumnSubnet6,
+		subnetId, reservedPdPoolIds, errorno.ErrNameReservedPdPool); err != nil {
+		return err
+	}
+
+	if scopeIds := append(append(append([]string{}, poolIds...), reservationIds...),
+		pdpoolIds...); len(scopeIds) != 0 {
+		return updateResourcesSubnet(tx, resource.TableOptionValue6, resource.SqlColumnSubnet6,
+			subnetId, map[string]interface{}{resource.SqlColumnScopeId: restdb.FillValue{
+				Operator: restdb.OperatorAny, Value: scopeIds}}, errorno.ErrNameOptionValue)
+	}
+
+	return nil
+}
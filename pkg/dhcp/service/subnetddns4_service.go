@@ -0,0 +1,142 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+)
+
+type SubnetDdns4Service struct {
+}
+
+func NewSubnetDdns4Service() *SubnetDdns4Service {
+	return &SubnetDdns4Service{}
+}
+
+func (s *SubnetDdns4Service) List(subnetId string) ([]*resource.SubnetDdns4, error) {
+	var setting *resource.SubnetDdns4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		setting, err = getSubnetDdns4(tx, subnetId)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return []*resource.SubnetDdns4{setting}, nil
+}
+
+func (s *SubnetDdns4Service) Get(subnetId, id string) (*resource.SubnetDdns4, error) {
+	if id != subnetId {
+		return nil, errorno.ErrNotFound(errorno.ErrNameDdns, id)
+	}
+
+	var setting *resource.SubnetDdns4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		setting, err = getSubnetDdns4(tx, subnetId)
+		return
+	}); err != nil {
+		return nil, err
+	}
+
+	return setting, nil
+}
+
+func getSubnetDdns4(tx restdb.Transaction, subnetId string) (*resource.SubnetDdns4, error) {
+	if _, err := getSubnet4FromDB(tx, subnetId); err != nil {
+		return nil, err
+	}
+
+	if setting, err := getStoredSubnetDdns4(tx, subnetId); err != nil {
+		return nil, err
+	} else if setting != nil {
+		setting.TsigSecret = ""
+		return setting, nil
+	}
+
+	setting := &resource.SubnetDdns4{
+		Subnet4:            subnetId,
+		ReplaceClientName:  resource.DdnsReplaceClientNameNever,
+		GeneratedPrefix:    resource.DefaultDdnsGeneratedPrefix,
+		ConflictResolution: resource.DdnsConflictResolutionCheckWithDhcid,
+	}
+	setting.SetID(subnetId)
+	return setting, nil
+}
+
+func getStoredSubnetDdns4(tx restdb.Transaction, subnetId string) (*resource.SubnetDdns4, error) {
+	var settings []*resource.SubnetDdns4
+	if err := tx.Fill(map[string]interface{}{restdb.IDField: subnetId}, &settings); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDdns),
+			pg.Error(err).Error())
+	} else if len(settings) != 0 {
+		return settings[0], nil
+	} else {
+		return nil, nil
+	}
+}
+
+// Update keeps the stored tsig secret when the secret is omitted and the
+// key name is unchanged, the secret is never returned
+func (s *SubnetDdns4Service) Update(subnetId string, setting *resource.SubnetDdns4) error {
+	if setting.GetID() != subnetId {
+		return errorno.ErrNotFound(errorno.ErrNameDdns, setting.GetID())
+	}
+
+	setting.Subnet4 = subnetId
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if _, err := getSubnet4FromDB(tx, subnetId); err != nil {
+			return err
+		}
+
+		oldSetting, err := getStoredSubnetDdns4(tx, subnetId)
+		if err != nil {
+			return err
+		}
+
+		if oldSetting != nil && setting.TsigSecret == "" &&
+			setting.TsigKeyName == oldSetting.TsigKeyName {
+			setting.TsigSecret = oldSetting.TsigSecret
+		}
+
+		if err := setting.Validate(); err != nil {
+			return err
+		}
+
+		if oldSetting == nil {
+			if _, err := tx.Insert(setting); err != nil {
+				return errorno.ErrDBError(errorno.ErrDBNameInsert, string(errorno.ErrNameDdns),
+					pg.Error(err).Error())
+			}
+
+			return nil
+		}
+
+		if _, err := tx.Update(resource.TableSubnetDdns4, map[string]interface{}{
+			resource.SqlColumnEnabled:            setting.Enabled,
+			resource.SqlColumnForwardZone:        setting.ForwardZone,
+			resource.SqlColumnReverseZone:        setting.ReverseZone,
+			resource.SqlColumnServer:             setting.Server,
+			resource.SqlColumnTsigKeyName:        setting.TsigKeyName,
+			resource.SqlColumnTsigAlgorithm:      setting.TsigAlgorithm,
+			resource.SqlColumnTsigSecret:         setting.TsigSecret,
+			resource.SqlColumnTtl:                setting.Ttl,
+			resource.SqlColumnQualifyingSuffix:   setting.QualifyingSuffix,
+			resource.SqlColumnReplaceClientName:  setting.ReplaceClientName,
+			resource.SqlColumnGeneratedPrefix:    setting.GeneratedPrefix,
+			resource.SqlColumnConflictResolution: setting.ConflictResolution,
+		}, map[string]interface{}{restdb.IDField: subnetId}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, string(errorno.ErrNameDdns),
+				pg.Error(err).Error())
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	setting.TsigSecret = ""
+	return nil
+}
@@ -41,6 +41,52 @@ func (l *SubnetLease4Service) List(subnet *resource.Subnet4, ip string) ([]*reso
 	return ListSubnetLease4(subnet, ip)
 }
 
+// ListWithFilter filters, sorts and pages leases in dhcp agent, and reclaimed
+// leases are excluded by agent before paging and counting
+func (l *SubnetLease4Service) ListWithFilter(subnet *resource.Subnet4, filter *pbdhcpagent.LeaseFilter) ([]*resource.SubnetLease4, string, uint64, error) {
+	var subnet4 *resource.Subnet4
+	var reservations []*resource.Reservation4
+	var reclaimedSubnetLeases []*resource.SubnetLease4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		if subnet4, err = getSubnet4FromDB(tx, subnet.GetID()); err != nil {
+			return err
+		} else if len(subnet4.Nodes) == 0 {
+			return ErrorSubnetNotInNodes
+		}
+
+		reservations, reclaimedSubnetLeases, err = getReservation4sAndReclaimedSubnetLease4s(tx, subnet4, nil)
+		return err
+	}); err != nil {
+		if err == ErrorSubnetNotInNodes {
+			return nil, "", 0, nil
+		} else {
+			return nil, "", 0, err
+		}
+	}
+
+	for _, lease := range reclaimedSubnetLeases {
+		filter.ExcludedLeases = append(filter.ExcludedLeases, &pbdhcpagent.ExcludedLease{
+			Address:        lease.Address,
+			ExpirationTime: lease.ExpirationTime,
+		})
+	}
+
+	var resp *pbdhcpagent.ListLeases4Response
+	if err := transport.CallDhcpAgentGrpc4(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+		resp, err = client.ListSubnet4Leases(ctx, &pbdhcpagent.ListSubnet4LeasesRequest{
+			Id:     subnet4.SubnetId,
+			Filter: filter,
+		})
+		return err
+	}); err != nil {
+		return nil, "", 0, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+	}
+
+	leases, _ := subnetLease4sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases,
+		reservationMapFromReservation4s(reservations), false)
+	return leases, resp.GetNextPageToken(), resp.GetTotalCount(), nil
+}
+
 func (l *SubnetLease4Service) ActionListToReservation(subnet *resource.Subnet4, input *resource.ConvToReservationInput) (*resource.ConvToReservationInput, error) {
 	if len(input.Addresses) == 0 {
 		return &resource.ConvToReservationInput{Data: []resource.ConvToReservationItem{}}, nil
@@ -404,6 +450,12 @@ func getSubnetLease4sWithoutReclaimed(subnetId uint64, reclaimedSubnetLeases []*
 		return nil, nil, nil
 	}
 
+	leases, reclaimleasesForRetain := subnetLease4sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases,
+		reservationMap, len(needFilterDeclineLeases) != 0 && needFilterDeclineLeases[0])
+	return leases, reclaimleasesForRetain, nil
+}
+
+func subnetLease4sFromPbLeases(pbLeases []*pbdhcpagent.DHCPLease4, reclaimedSubnetLeases []*resource.SubnetLease4, reservationMap map[string]*resource.Reservation4, needFilterDeclineLease bool) ([]*resource.SubnetLease4, []string) {
 	reclaimedAddrAndLeases := make(map[string]*resource.SubnetLease4)
 	for _, subnetLease := range reclaimedSubnetLeases {
 		reclaimedAddrAndLeases[subnetLease.Address] = subnetLease
@@ -411,8 +463,7 @@ func getSubnetLease4sWithoutReclaimed(subnetId uint64, reclaimedSubnetLeases []*
 
 	var leases []*resource.SubnetLease4
 	var reclaimleasesForRetain []string
-	needFilterDeclineLease := len(needFilterDeclineLeases) != 0 && needFilterDeclineLeases[0]
-	for _, lease := range resp.GetLeases() {
+	for _, lease := range pbLeases {
 		if needFilterDeclineLease && lease.GetLeaseState() != pbdhcpagent.LeaseState_NORMAL {
 			continue
 		}
@@ -425,7 +476,7 @@ func getSubnetLease4sWithoutReclaimed(subnetId uint64, reclaimedSubnetLeases []*
 		}
 	}
 
-	return leases, reclaimleasesForRetain, nil
+	return leases, reclaimleasesForRetain
 }
 
 func ipsToPbGetSubnet4LeasesWithIpsRequest(subnetId uint64, ips []string) *pbdhcpagent.GetSubnet4LeasesWithIpsRequest {
@@ -535,11 +586,31 @@ func (l *SubnetLease4Service) BatchDeleteLease4s(subnetId string, leaseIds []str
 }
 
 func GetSubnets4LeasesWithMacs(hwAddresses []string, needFilterDeclineLeases ...bool) ([]*resource.SubnetLease4, error) {
+	return getSubnets4Leases(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases4Response, error) {
+		return client.GetSubnets4LeasesWithMacs(ctx,
+			&pbdhcpagent.GetSubnets4LeasesWithMacsRequest{HwAddresses: util.ToLower(hwAddresses)})
+	}, needFilterDeclineLeases...)
+}
+
+func GetSubnets4LeasesWithHostnames(hostnames []string, needFilterDeclineLeases ...bool) ([]*resource.SubnetLease4, error) {
+	return getSubnets4Leases(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases4Response, error) {
+		return client.GetSubnets4LeasesWithHostnames(ctx,
+			&pbdhcpagent.GetSubnets4LeasesWithHostnamesRequest{Hostnames: hostnames})
+	}, needFilterDeclineLeases...)
+}
+
+func GetSubnets4LeasesWithClientIds(clientIds []string, needFilterDeclineLeases ...bool) ([]*resource.SubnetLease4, error) {
+	return getSubnets4Leases(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases4Response, error) {
+		return client.GetSubnets4LeasesWithClientIds(ctx,
+			&pbdhcpagent.GetSubnets4LeasesWithClientIdsRequest{ClientIds: clientIds})
+	}, needFilterDeclineLeases...)
+}
+
+func getSubnets4Leases(getLeases func(context.Context, pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases4Response, error), needFilterDeclineLeases ...bool) ([]*resource.SubnetLease4, error) {
 	var err error
 	var resp *pbdhcpagent.GetLeases4Response
 	if err = transport.CallDhcpAgentGrpc4(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) error {
-		resp, err = client.GetSubnets4LeasesWithMacs(ctx,
-			&pbdhcpagent.GetSubnets4LeasesWithMacsRequest{HwAddresses: util.ToLower(hwAddresses)})
+		resp, err = getLeases(ctx, client)
 		return err
 	}); err != nil {
 		return nil, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
@@ -576,6 +647,41 @@ func GetSubnets4LeasesWithMacs(hwAddresses []string, needFilterDeclineLeases ...
 	return leases, nil
 }
 
+func FilterReclaimedSubnetLease4s(leases []*resource.SubnetLease4) ([]*resource.SubnetLease4, error) {
+	if len(leases) == 0 {
+		return leases, nil
+	}
+
+	addresses := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		addresses = append(addresses, lease.Address)
+	}
+
+	var reclaimedLeases []*resource.SubnetLease4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&reclaimedLeases,
+			"select * from gr_subnet_lease4 where address = any($1::text[])", addresses)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameLease), pg.Error(err).Error())
+	}
+
+	reclaimedLeaseMap := make(map[string]*resource.SubnetLease4, len(reclaimedLeases))
+	for _, reclaimedLease := range reclaimedLeases {
+		reclaimedLeaseMap[reclaimedLease.Subnet4+"-"+reclaimedLease.Address] = reclaimedLease
+	}
+
+	validLeases := make([]*resource.SubnetLease4, 0, len(leases))
+	for _, lease := range leases {
+		if reclaimedLease, ok := reclaimedLeaseMap[lease.Subnet4+"-"+lease.Address]; !ok ||
+			!reclaimedLease.Equal(lease) {
+			validLeases = append(validLeases, lease)
+		}
+	}
+
+	return validLeases, nil
+}
+
 func getAddrAndReservationMapWithAddresses(addresses []string) (map[string]*resource.Reservation4, error) {
 	var reservations []*resource.Reservation4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
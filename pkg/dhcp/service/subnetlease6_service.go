@@ -33,6 +33,52 @@ func (l *SubnetLease6Service) List(subnet *resource.Subnet6, ip string) ([]*reso
 	return ListSubnetLease6(subnet, ip)
 }
 
+// ListWithFilter filters, sorts and pages leases in dhcp agent, and reclaimed
+// leases are excluded by agent before paging and counting
+func (l *SubnetLease6Service) ListWithFilter(subnet *resource.Subnet6, filter *pbdhcpagent.LeaseFilter) ([]*resource.SubnetLease6, string, uint64, error) {
+	var subnet6 *resource.Subnet6
+	var reservations []*resource.Reservation6
+	var reclaimedSubnetLeases []*resource.SubnetLease6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		if subnet6, err = getSubnet6FromDB(tx, subnet.GetID()); err != nil {
+			return err
+		} else if len(subnet6.Nodes) == 0 {
+			return ErrorSubnetNotInNodes
+		}
+
+		reservations, reclaimedSubnetLeases, err = getReservation6sAndReclaimedSubnetLease6s(tx, subnet6, nil)
+		return err
+	}); err != nil {
+		if err == ErrorSubnetNotInNodes {
+			return nil, "", 0, nil
+		} else {
+			return nil, "", 0, err
+		}
+	}
+
+	for _, lease := range reclaimedSubnetLeases {
+		filter.ExcludedLeases = append(filter.ExcludedLeases, &pbdhcpagent.ExcludedLease{
+			Address:        lease.Address,
+			ExpirationTime: lease.ExpirationTime,
+		})
+	}
+
+	var resp *pbdhcpagent.ListLeases6Response
+	if err := transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+		resp, err = client.ListSubnet6Leases(ctx, &pbdhcpagent.ListSubnet6LeasesRequest{
+			Id:     subnet6.SubnetId,
+			Filter: filter,
+		})
+		return err
+	}); err != nil {
+		return nil, "", 0, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+	}
+
+	leases, _ := subnetLease6sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases,
+		reservationMapFromReservation6s(reservations), false)
+	return leases, resp.GetNextPageToken(), resp.GetTotalCount(), nil
+}
+
 func (l *SubnetLease6Service) ActionListToReservation(subnet *resource.Subnet6, input *resource.ConvToReservationInput) (*resource.ConvToReservationInput, error) {
 	if len(input.Addresses) == 0 {
 		return &resource.ConvToReservationInput{Data: []resource.ConvToReservationItem{}}, nil
@@ -425,6 +471,12 @@ func getSubnetLease6sWithoutReclaimed(subnetId uint64, reclaimedSubnetLeases []*
 		return nil, nil, nil
 	}
 
+	leases, reclaimleasesForRetain := subnetLease6sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases,
+		reservationMap, len(needFilterDeclineLeases) != 0 && needFilterDeclineLeases[0])
+	return leases, reclaimleasesForRetain, nil
+}
+
+func subnetLease6sFromPbLeases(pbLeases []*pbdhcpagent.DHCPLease6, reclaimedSubnetLeases []*resource.SubnetLease6, reservationMap map[string]*resource.Reservation6, needFilterDeclineLease bool) ([]*resource.SubnetLease6, []string) {
 	reclaimedAddrAndLeases := make(map[string]*resource.SubnetLease6)
 	for _, subnetLease := range reclaimedSubnetLeases {
 		reclaimedAddrAndLeases[subnetLease.Address] = subnetLease
@@ -432,8 +484,7 @@ func getSubnetLease6sWithoutReclaimed(subnetId uint64, reclaimedSubnetLeases []*
 
 	var leases []*resource.SubnetLease6
 	var reclaimleasesForRetain []string
-	needFilterDeclineLease := len(needFilterDeclineLeases) != 0 && needFilterDeclineLeases[0]
-	for _, lease := range resp.GetLeases() {
+	for _, lease := range pbLeases {
 		if needFilterDeclineLease && lease.GetLeaseState() != pbdhcpagent.LeaseState_NORMAL {
 			continue
 		}
@@ -446,7 +497,7 @@ func getSubnetLease6sWithoutReclaimed(subnetId uint64, reclaimedSubnetLeases []*
 		}
 	}
 
-	return leases, reclaimleasesForRetain, nil
+	return leases, reclaimleasesForRetain
 }
 
 func ipsToPbGetSubnet6LeasesWithIpsRequest(subnetId uint64, ips []string) *pbdhcpagent.GetSubnet6LeasesWithIpsRequest {
@@ -510,15 +561,17 @@ func (l *SubnetLease6Service) batchDeleteLease6s(tx restdb.Transaction, subnetId
 	}
 
 	for leaseType, addrs := range leaseTypeAndAddrs {
-		return transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) error {
-			_, err = client.DeleteLeases6(ctx,
+		if err := transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) error {
+			_, err := client.DeleteLeases6(ctx,
 				&pbdhcpagent.DeleteLeases6Request{SubnetId: subnet6.SubnetId,
 					LeaseType: leaseType, Addresses: addrs})
 			if err != nil {
 				err = errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
 			}
 			return err
-		})
+		}); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -564,11 +617,31 @@ func (l *SubnetLease6Service) BatchDeleteLease6s(subnetId string, leaseIds []str
 }
 
 func GetSubnets6LeasesWithMacs(hwAddresses []string, needFilterDeclineLeases ...bool) ([]*resource.SubnetLease6, error) {
+	return getSubnets6Leases(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases6Response, error) {
+		return client.GetSubnets6LeasesWithMacs(ctx,
+			&pbdhcpagent.GetSubnets6LeasesWithMacsRequest{HwAddresses: util.ToLower(hwAddresses)})
+	}, needFilterDeclineLeases...)
+}
+
+func GetSubnets6LeasesWithDuids(duids []string, needFilterDeclineLeases ...bool) ([]*resource.SubnetLease6, error) {
+	return getSubnets6Leases(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases6Response, error) {
+		return client.GetSubnets6LeasesWithDuids(ctx,
+			&pbdhcpagent.GetSubnets6LeasesWithDuidsRequest{Duids: duids})
+	}, needFilterDeclineLeases...)
+}
+
+func GetSubnets6LeasesWithHostnames(hostnames []string, needFilterDeclineLeases ...bool) ([]*resource.SubnetLease6, error) {
+	return getSubnets6Leases(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases6Response, error) {
+		return client.GetSubnets6LeasesWithHostnames(ctx,
+			&pbdhcpagent.GetSubnets6LeasesWithHostnamesRequest{Hostnames: hostnames})
+	}, needFilterDeclineLeases...)
+}
+
+func getSubnets6Leases(getLeases func(context.Context, pbdhcpagent.DHCPManagerClient) (*pbdhcpagent.GetLeases6Response, error), needFilterDeclineLeases ...bool) ([]*resource.SubnetLease6, error) {
 	var err error
 	var resp *pbdhcpagent.GetLeases6Response
 	if err = transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) error {
-		resp, err = client.GetSubnets6LeasesWithMacs(ctx,
-			&pbdhcpagent.GetSubnets6LeasesWithMacsRequest{HwAddresses: util.ToLower(hwAddresses)})
+		resp, err = getLeases(ctx, client)
 		return err
 	}); err != nil {
 		return nil, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
@@ -610,6 +683,41 @@ func GetSubnets6LeasesWithMacs(hwAddresses []string, needFilterDeclineLeases ...
 	return leases, nil
 }
 
+func FilterReclaimedSubnetLease6s(leases []*resource.SubnetLease6) ([]*resource.SubnetLease6, error) {
+	if len(leases) == 0 {
+		return leases, nil
+	}
+
+	addresses := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		addresses = append(addresses, lease.Address)
+	}
+
+	var reclaimedLeases []*resource.SubnetLease6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		return tx.FillEx(&reclaimedLeases,
+			"select * from gr_subnet_lease6 where address = any($1::text[])", addresses)
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameLease), pg.Error(err).Error())
+	}
+
+	reclaimedLeaseMap := make(map[string]*resource.SubnetLease6, len(reclaimedLeases))
+	for _, reclaimedLease := range reclaimedLeases {
+		reclaimedLeaseMap[reclaimedLease.Subnet6+"-"+reclaimedLease.Address] = reclaimedLease
+	}
+
+	validLeases := make([]*resource.SubnetLease6, 0, len(leases))
+	for _, lease := range leases {
+		if reclaimedLease, ok := reclaimedLeaseMap[lease.Subnet6+"-"+lease.Address]; !ok ||
+			!reclaimedLease.Equal(lease) {
+			validLeases = append(validLeases, lease)
+		}
+	}
+
+	return validLeases, nil
+}
+
 func getAddrAndReservation6MapWithSubnetIds(subnetIds []string) (map[string]*resource.Reservation6, error) {
 	var reservations []*resource.Reservation6
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
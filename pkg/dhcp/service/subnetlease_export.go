@@ -0,0 +1,326 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/linkingthing/cement/log"
+	"github.com/linkingthing/clxone-utils/excel"
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	transport "github.com/linkingthing/clxone-dhcp/pkg/transport/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	SubnetLease4FileNamePrefix = "subnet-lease4-"
+	SubnetLease6FileNamePrefix = "subnet-lease6-"
+
+	LeaseExportPageSize = 1000
+
+	LeaseExportFailedFormat = "#子网%s租赁导出失败: %s"
+)
+
+type leaseExportFilter struct {
+	leaseStates    []string
+	clientType     string
+	hostname       string
+	expirationFrom time.Time
+	expirationTo   time.Time
+}
+
+func newLeaseExportFilter(input *resource.ExportLeasesInput) (*leaseExportFilter, error) {
+	filter := &leaseExportFilter{
+		clientType: strings.TrimSpace(input.ClientType),
+		hostname:   strings.ToLower(strings.TrimSpace(input.Hostname)),
+	}
+
+	for _, state := range input.LeaseStates {
+		if state = strings.TrimSpace(state); state != "" {
+			filter.leaseStates = append(filter.leaseStates, state)
+		}
+	}
+
+	if filter.hostname != "" {
+		if _, err := path.Match(filter.hostname, ""); err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameHostname, input.Hostname)
+		}
+	}
+
+	if input.ExpirationFrom != "" {
+		from, err := parseLeaseHistoryTime(input.ExpirationFrom, util.TimeFromSuffix)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.expirationFrom = from
+	}
+
+	if input.ExpirationTo != "" {
+		to, err := parseLeaseHistoryTime(input.ExpirationTo, util.TimeToSuffix)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.expirationTo = to
+	}
+
+	return filter, nil
+}
+
+func (f *leaseExportFilter) match(leaseState, clientType, hostname, expirationTime string) bool {
+	if len(f.leaseStates) != 0 {
+		matched := false
+		for _, state := range f.leaseStates {
+			if strings.EqualFold(state, leaseState) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	if f.clientType != "" && !strings.EqualFold(f.clientType, clientType) {
+		return false
+	}
+
+	if f.hostname != "" {
+		hostname = strings.ToLower(hostname)
+		if strings.ContainsAny(f.hostname, "*?[") {
+			if matched, _ := path.Match(f.hostname, hostname); !matched {
+				return false
+			}
+		} else if !strings.Contains(hostname, f.hostname) {
+			return false
+		}
+	}
+
+	if f.expirationFrom.IsZero() && f.expirationTo.IsZero() {
+		return true
+	}
+
+	expiration, err := parseLeaseExpirationTime(expirationTime)
+	if err != nil {
+		return false
+	}
+
+	return (f.expirationFrom.IsZero() || !expiration.Before(f.expirationFrom)) &&
+		(f.expirationTo.IsZero() || !expiration.After(f.expirationTo))
+}
+
+func parseLeaseExpirationTime(expirationTime string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, expirationTime); err == nil {
+		return t, nil
+	}
+
+	return time.ParseInLocation(excel.TimeFormat, expirationTime, time.Local)
+}
+
+func (l *SubnetLease4Service) ActionExport(subnet *resource.Subnet4, input *resource.ExportLeasesInput) (*excel.ExportFile, error) {
+	filter, err := newLeaseExportFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnet4 *resource.Subnet4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		subnet4, err = getSubnet4FromDB(tx, subnet.GetID())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	strMatrix, err := appendSubnetLease4Rows(nil, subnet4, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportLeases(SubnetLease4FileNamePrefix, TableHeaderSubnetLease4, strMatrix)
+}
+
+func (l *SubnetLease6Service) ActionExport(subnet *resource.Subnet6, input *resource.ExportLeasesInput) (*excel.ExportFile, error) {
+	filter, err := newLeaseExportFilter(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnet6 *resource.Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		subnet6, err = getSubnet6FromDB(tx, subnet.GetID())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	strMatrix, err := appendSubnetLease6Rows(nil, subnet6, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return exportLeases(SubnetLease6FileNamePrefix, TableHeaderSubnetLease6, strMatrix)
+}
+
+func (s *Subnet4Service) ExportLeases(input *resource.ExportSubnetLeasesInput) (*excel.ExportFile, error) {
+	filter, err := newLeaseExportFilter(&input.ExportLeasesInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnets []*resource.Subnet4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if len(input.Subnets) == 0 {
+			return tx.Fill(map[string]interface{}{resource.SqlOrderBy: resource.SqlColumnSubnetId}, &subnets)
+		} else {
+			return tx.FillEx(&subnets, "select * from gr_subnet4 where id = any($1::text[]) order by subnet_id", input.Subnets)
+		}
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV4), pg.Error(err).Error())
+	}
+
+	var strMatrix [][]string
+	for _, subnet := range subnets {
+		if strMatrix, err = appendSubnetLease4Rows(strMatrix, subnet, filter); err != nil {
+			log.Warnf("export leases of subnet4 %s failed: %s", subnet.Subnet, err.Error())
+			strMatrix = append(strMatrix, leaseExportFailedRow(TableHeaderSubnetLease4, subnet.Subnet, err))
+		}
+	}
+
+	return exportLeases(SubnetLease4FileNamePrefix, TableHeaderSubnetLease4, strMatrix)
+}
+
+func (s *Subnet6Service) ExportLeases(input *resource.ExportSubnetLeasesInput) (*excel.ExportFile, error) {
+	filter, err := newLeaseExportFilter(&input.ExportLeasesInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var subnets []*resource.Subnet6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if len(input.Subnets) == 0 {
+			return tx.Fill(map[string]interface{}{resource.SqlOrderBy: resource.SqlColumnSubnetId}, &subnets)
+		} else {
+			return tx.FillEx(&subnets, "select * from gr_subnet6 where id = any($1::text[]) order by subnet_id", input.Subnets)
+		}
+	}); err != nil {
+		return nil, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameNetworkV6), pg.Error(err).Error())
+	}
+
+	var strMatrix [][]string
+	for _, subnet := range subnets {
+		if strMatrix, err = appendSubnetLease6Rows(strMatrix, subnet, filter); err != nil {
+			log.Warnf("export leases of subnet6 %s failed: %s", subnet.Subnet, err.Error())
+			strMatrix = append(strMatrix, leaseExportFailedRow(TableHeaderSubnetLease6, subnet.Subnet, err))
+		}
+	}
+
+	return exportLeases(SubnetLease6FileNamePrefix, TableHeaderSubnetLease6, strMatrix)
+}
+
+func exportLeases(fileNamePrefix string, header []string, strMatrix [][]string) (*excel.ExportFile, error) {
+	if filepath, err := excel.WriteExcelFile(fileNamePrefix+
+		time.Now().Format(excel.TimeFormat), header, strMatrix); err != nil {
+		return nil, errorno.ErrOperateResource(errorno.ErrNameExport,
+			string(errorno.ErrNameLease), err.Error())
+	} else {
+		return &excel.ExportFile{Path: filepath}, nil
+	}
+}
+
+func leaseExportFailedRow(header []string, subnet string, err error) []string {
+	row := make([]string, len(header))
+	row[0] = fmt.Sprintf(LeaseExportFailedFormat, subnet, errorno.TryGetErrorCNMsg(err))
+	return row
+}
+
+func appendSubnetLease4Rows(strMatrix [][]string, subnet4 *resource.Subnet4, filter *leaseExportFilter) ([][]string, error) {
+	if len(subnet4.Nodes) == 0 {
+		return strMatrix, nil
+	}
+
+	var reservations []*resource.Reservation4
+	var reclaimedSubnetLeases []*resource.SubnetLease4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		reservations, reclaimedSubnetLeases, err = getReservation4sAndReclaimedSubnetLease4s(tx, subnet4, nil)
+		return err
+	}); err != nil {
+		return strMatrix, err
+	}
+
+	reservationMap := reservationMapFromReservation4s(reservations)
+	pageToken := ""
+	for {
+		var resp *pbdhcpagent.ListLeases4Response
+		if err := transport.CallDhcpAgentGrpc4(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+			resp, err = client.ListSubnet4Leases(ctx, &pbdhcpagent.ListSubnet4LeasesRequest{
+				Id:     subnet4.SubnetId,
+				Filter: &pbdhcpagent.LeaseFilter{PageSize: LeaseExportPageSize, PageToken: pageToken},
+			})
+			return err
+		}); err != nil {
+			return strMatrix, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+		}
+
+		leases, _ := subnetLease4sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases, reservationMap, false)
+		for _, lease := range leases {
+			if filter.match(lease.LeaseState, lease.ClientType, lease.Hostname, lease.ExpirationTime) {
+				strMatrix = append(strMatrix, localizationSubnetLease4ToStrSlice(lease))
+			}
+		}
+
+		if pageToken = resp.GetNextPageToken(); pageToken == "" {
+			return strMatrix, nil
+		}
+	}
+}
+
+func appendSubnetLease6Rows(strMatrix [][]string, subnet6 *resource.Subnet6, filter *leaseExportFilter) ([][]string, error) {
+	if len(subnet6.Nodes) == 0 {
+		return strMatrix, nil
+	}
+
+	var reservations []*resource.Reservation6
+	var reclaimedSubnetLeases []*resource.SubnetLease6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		reservations, reclaimedSubnetLeases, err = getReservation6sAndReclaimedSubnetLease6s(tx, subnet6, nil)
+		return err
+	}); err != nil {
+		return strMatrix, err
+	}
+
+	reservationMap := reservationMapFromReservation6s(reservations)
+	pageToken := ""
+	for {
+		var resp *pbdhcpagent.ListLeases6Response
+		if err := transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) (err error) {
+			resp, err = client.ListSubnet6Leases(ctx, &pbdhcpagent.ListSubnet6LeasesRequest{
+				Id:     subnet6.SubnetId,
+				Filter: &pbdhcpagent.LeaseFilter{PageSize: LeaseExportPageSize, PageToken: pageToken},
+			})
+			return err
+		}); err != nil {
+			return strMatrix, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err))
+		}
+
+		leases, _ := subnetLease6sFromPbLeases(resp.GetLeases(), reclaimedSubnetLeases, reservationMap, false)
+		for _, lease := range leases {
+			if filter.match(lease.LeaseState, lease.ClientType, lease.Hostname, lease.ExpirationTime) {
+				strMatrix = append(strMatrix, localizationSubnetLease6ToStrSlice(lease))
+			}
+		}
+
+		if pageToken = resp.GetNextPageToken(); pageToken == "" {
+			return strMatrix, nil
+		}
+	}
+}
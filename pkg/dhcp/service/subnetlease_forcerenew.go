@@ -0,0 +1,225 @@
+package service
+
+import (
+	"context"
+	"net"
+
+	gohelperip "github.com/cuityhj/gohelper/ip"
+	"github.com/linkingthing/cement/log"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+	transport "github.com/linkingthing/clxone-dhcp/pkg/transport/service"
+)
+
+type forceRenewOutcome struct {
+	output  *resource.ForceRenewLeasesOutput
+	results map[string]*resource.ForceRenewResult
+}
+
+func newForceRenewOutcome(addresses []string) *forceRenewOutcome {
+	outcome := &forceRenewOutcome{
+		output:  &resource.ForceRenewLeasesOutput{Results: make([]*resource.ForceRenewResult, 0, len(addresses))},
+		results: make(map[string]*resource.ForceRenewResult, len(addresses)),
+	}
+
+	for _, address := range addresses {
+		key := canonicalAddress(address)
+		if _, ok := outcome.results[key]; ok {
+			continue
+		}
+
+		result := &resource.ForceRenewResult{
+			Address:      address,
+			ErrorMessage: errorno.ErrNotFound(errorno.ErrNameLease, address).Error(),
+		}
+		outcome.results[key] = result
+		outcome.output.Results = append(outcome.output.Results, result)
+	}
+
+	return outcome
+}
+
+func (o *forceRenewOutcome) setAgentResults(results []*pbdhcpagent.ForceRenewResult) {
+	for _, r := range results {
+		if result, ok := o.results[canonicalAddress(r.GetAddress())]; ok {
+			result.Succeed = r.GetSucceed()
+			result.ErrorMessage = r.GetErrorMessage()
+		}
+	}
+}
+
+func (o *forceRenewOutcome) setAgentError(addresses []string, err error) {
+	for _, address := range addresses {
+		if result, ok := o.results[canonicalAddress(address)]; ok {
+			result.ErrorMessage = err.Error()
+		}
+	}
+}
+
+func (o *forceRenewOutcome) requestType(address string) string {
+	if result, ok := o.results[canonicalAddress(address)]; ok && result.Succeed {
+		return LeaseRequestTypeForceRenew
+	} else {
+		return LeaseRequestTypeForceRenewFailed
+	}
+}
+
+func canonicalAddress(address string) string {
+	if ip := net.ParseIP(address); ip != nil {
+		return ip.String()
+	} else {
+		return address
+	}
+}
+
+func (o *forceRenewOutcome) log(subnet string) {
+	for _, result := range o.output.Results {
+		if result.Succeed {
+			log.Infof("force renew lease %s of subnet %s succeed", result.Address, subnet)
+		} else {
+			log.Warnf("force renew lease %s of subnet %s failed: %s",
+				result.Address, subnet, result.ErrorMessage)
+		}
+	}
+}
+
+func (l *SubnetLease4Service) ActionForceRenew(subnet *resource.Subnet4, input *resource.ForceRenewLeasesInput) (*resource.ForceRenewLeasesOutput, error) {
+	if len(input.Addresses) == 0 {
+		return nil, errorno.ErrEmpty(string(errorno.ErrNameIp))
+	}
+
+	for _, address := range input.Addresses {
+		if _, err := gohelperip.ParseIPv4(address); err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameIp, address)
+		}
+	}
+
+	var subnet4 *resource.Subnet4
+	var reclaimedSubnetLeases []*resource.SubnetLease4
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		if subnet4, err = getSubnet4FromDB(tx, subnet.GetID()); err != nil {
+			return err
+		}
+
+		reclaimedSubnetLeases, err = getReclaimedSubnetLease4sWithIps(tx, subnet.GetID(), input.Addresses)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	lease4s, _, err := getSubnetLease4sWithoutReclaimed(subnet4.SubnetId, reclaimedSubnetLeases, nil, input.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := newForceRenewOutcome(input.Addresses)
+	if len(lease4s) != 0 {
+		addresses := make([]string, 0, len(lease4s))
+		for _, lease4 := range lease4s {
+			addresses = append(addresses, lease4.Address)
+		}
+
+		if err := transport.CallDhcpAgentGrpc4(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) error {
+			resp, err := client.ForceRenewLeases4(ctx, &pbdhcpagent.ForceRenewLeases4Request{
+				SubnetId: subnet4.SubnetId, Addresses: addresses, ReleaseLease: input.ReleaseLease})
+			if err == nil {
+				outcome.setAgentResults(resp.GetResults())
+			}
+			return err
+		}); err != nil {
+			outcome.setAgentError(addresses, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err)))
+		}
+	}
+
+	for _, lease4 := range lease4s {
+		addLeaseHistory(&resource.LeaseHistory{
+			Version:        resource.LeaseHistoryVersion4,
+			Address:        lease4.Address,
+			HwAddress:      lease4.HwAddress,
+			ClientId:       lease4.ClientId,
+			Hostname:       lease4.Hostname,
+			RequestType:    outcome.requestType(lease4.Address),
+			LeaseState:     lease4.LeaseState,
+			SubnetId:       subnet4.SubnetId,
+			Subnet:         subnet4.Subnet,
+			ValidLifetime:  lease4.ValidLifetime,
+			ExpirationTime: lease4.ExpirationTime,
+		})
+	}
+
+	outcome.log(subnet4.Subnet)
+	return outcome.output, nil
+}
+
+func (l *SubnetLease6Service) ActionForceRenew(subnet *resource.Subnet6, input *resource.ForceRenewLeasesInput) (*resource.ForceRenewLeasesOutput, error) {
+	if len(input.Addresses) == 0 {
+		return nil, errorno.ErrEmpty(string(errorno.ErrNameIp))
+	}
+
+	for _, address := range input.Addresses {
+		if _, err := gohelperip.ParseIPv6(address); err != nil {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameIp, address)
+		}
+	}
+
+	var subnet6 *resource.Subnet6
+	var reclaimedSubnetLeases []*resource.SubnetLease6
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
+		if subnet6, err = getSubnet6FromDB(tx, subnet.GetID()); err != nil {
+			return err
+		}
+
+		reclaimedSubnetLeases, err = getReclaimedSubnetLease6sWithIps(tx, subnet.GetID(), input.Addresses)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	lease6s, _, err := getSubnetLease6sWithoutReclaimed(subnet6.SubnetId, reclaimedSubnetLeases, nil, input.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	leaseTypeAndAddrs := make(map[string][]string)
+	for _, lease6 := range lease6s {
+		leaseTypeAndAddrs[lease6.LeaseType] = append(leaseTypeAndAddrs[lease6.LeaseType], lease6.Address)
+	}
+
+	outcome := newForceRenewOutcome(input.Addresses)
+	for leaseType, addresses := range leaseTypeAndAddrs {
+		if err := transport.CallDhcpAgentGrpc6(func(ctx context.Context, client pbdhcpagent.DHCPManagerClient) error {
+			resp, err := client.ForceRenewLeases6(ctx, &pbdhcpagent.ForceRenewLeases6Request{
+				SubnetId: subnet6.SubnetId, LeaseType: leaseType, Addresses: addresses,
+				ReleaseLease: input.ReleaseLease})
+			if err == nil {
+				outcome.setAgentResults(resp.GetResults())
+			}
+			return err
+		}); err != nil {
+			outcome.setAgentError(addresses, errorno.ErrNetworkError(errorno.ErrNameLease, formatError(err)))
+		}
+	}
+
+	for _, lease6 := range lease6s {
+		addLeaseHistory(&resource.LeaseHistory{
+			Version:        resource.LeaseHistoryVersion6,
+			Address:        lease6.Address,
+			HwAddress:      lease6.HwAddress,
+			Duid:           lease6.Duid,
+			Hostname:       lease6.Hostname,
+			RequestType:    outcome.requestType(lease6.Address),
+			LeaseState:     lease6.LeaseState,
+			SubnetId:       subnet6.SubnetId,
+			Subnet:         subnet6.Subnet,
+			ValidLifetime:  lease6.ValidLifetime,
+			ExpirationTime: lease6.ExpirationTime,
+		})
+	}
+
+	outcome.log(subnet6.Subnet)
+	return outcome.output, nil
+}
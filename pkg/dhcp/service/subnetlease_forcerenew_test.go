@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+
+	pbdhcpagent "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp-agent"
+)
+
+func TestForceRenewOutcomeCanonicalAddress(t *testing.T) {
+	outcome := newForceRenewOutcome([]string{"2001:DB8::1", "2001:db8:0:0:0:0:0:1", "2001:db8::2"})
+	if len(outcome.output.Results) != 2 {
+		t.Fatalf("results got %d, want 2", len(outcome.output.Results))
+	}
+
+	outcome.setAgentResults([]*pbdhcpagent.ForceRenewResult{
+		{Address: "2001:db8::1", Succeed: true},
+		{Address: "2001:0db8::2", Succeed: false, ErrorMessage: "no nonce"},
+	})
+
+	if result := outcome.output.Results[0]; !result.Succeed || result.ErrorMessage != "" {
+		t.Errorf("result of %s got succeed %v with %q, want succeed", result.Address,
+			result.Succeed, result.ErrorMessage)
+	}
+
+	if result := outcome.output.Results[1]; result.Succeed || result.ErrorMessage != "no nonce" {
+		t.Errorf("result of %s got succeed %v with %q, want failed with no nonce", result.Address,
+			result.Succeed, result.ErrorMessage)
+	}
+
+	if requestType := outcome.requestType("2001:0db8::1"); requestType != LeaseRequestTypeForceRenew {
+		t.Errorf("request type of 2001:db8::1 got %s, want %s", requestType, LeaseRequestTypeForceRenew)
+	}
+
+	if requestType := outcome.requestType("2001:db8::2"); requestType != LeaseRequestTypeForceRenewFailed {
+		t.Errorf("request type of 2001:db8::2 got %s, want %s", requestType, LeaseRequestTypeForceRenewFailed)
+	}
+}
@@ -49,6 +49,8 @@ const (
 	FieldNameNextServer               = "启动服务地址"
 	FieldNameAutoReservationType      = "自动固定地址"
 	FieldNameV6Prefix64               = "NAT64前缀"
+	FieldNameStaticRoutes             = "静态路由"
+	FieldNameOption249                = "微软静态路由"
 
 	FieldNamePools         = "动态地址池"
 	FieldNameReservedPools = "保留地址池"
@@ -63,6 +65,26 @@ const (
 	FieldNameOperatingSystem   = "操作系统"
 	FieldNameAccessNetworkTime = "入网时间"
 
+	FieldNameLeaseSubnet                = "子网"
+	FieldNameLeaseAddress               = "IP地址"
+	FieldNameLeaseType                  = "租赁类型"
+	FieldNameLeasePrefixLen             = "前缀长度"
+	FieldNameLeaseDuid                  = "DUID"
+	FieldNameLeaseHwAddress             = "MAC地址"
+	FieldNameLeaseHwAddressOrganization = "网卡厂商"
+	FieldNameLeaseClientId              = "客户端ID"
+	FieldNameLeaseIaid                  = "IAID"
+	FieldNameLeaseHostname              = "主机名"
+	FieldNameLeaseState                 = "租赁状态"
+	FieldNameLeaseRequestType           = "请求类型"
+	FieldNameLeaseRequestTime           = "请求时间"
+	FieldNameLeaseExpirationTime        = "过期时间"
+	FieldNameLeaseFingerprint           = "指纹"
+	FieldNameLeaseVendorId              = "厂商标识"
+	FieldNameLeaseClientType            = "终端类型"
+	FieldNameLeaseRequestSourceAddr     = "请求源地址"
+	FieldNameLeaseAllocateMode          = "分配方式"
+
 	FieldNameCode  = "编码值（十六进制）*"
 	FieldNameValue = "编码用途*"
 
@@ -80,6 +102,7 @@ var (
 		FieldNameRelayCircuitId, FieldNameRelayRemoteId, FieldNameRelayAddresses,
 		FieldNameOption108, FieldNameOption138, FieldNameNodes, FieldNameNextServer,
 		FieldNameOption114, FieldNameOption119, FieldNameAutoReservationType,
+		FieldNameStaticRoutes, FieldNameOption249,
 		FieldNamePools, FieldNameReservedPools, FieldNameReservations,
 	}
 
@@ -103,6 +126,25 @@ var (
 
 	TableHeaderSegment = []string{FieldNameValue, FieldNameCode}
 
+	TableHeaderSubnetLease4 = []string{
+		FieldNameLeaseSubnet, FieldNameLeaseAddress, FieldNameLeaseHwAddress,
+		FieldNameLeaseHwAddressOrganization, FieldNameLeaseClientId, FieldNameLeaseHostname,
+		FieldNameLeaseState, FieldNameLeaseRequestType, FieldNameLeaseRequestTime,
+		FieldNameValidLifetime, FieldNameLeaseExpirationTime, FieldNameLeaseFingerprint,
+		FieldNameLeaseVendorId, FieldNameOperatingSystem, FieldNameLeaseClientType,
+		FieldNameLeaseAllocateMode,
+	}
+
+	TableHeaderSubnetLease6 = []string{
+		FieldNameLeaseSubnet, FieldNameLeaseAddress, FieldNameLeaseType, FieldNameLeasePrefixLen,
+		FieldNameLeaseDuid, FieldNameLeaseHwAddress, FieldNameLeaseHwAddressOrganization,
+		FieldNameLeaseIaid, FieldNameLeaseHostname, FieldNameLeaseState,
+		FieldNameLeaseRequestType, FieldNameLeaseRequestTime, FieldNameValidLifetime,
+		FieldNamePreferredLifetime, FieldNameLeaseExpirationTime, FieldNameLeaseFingerprint,
+		FieldNameLeaseVendorId, FieldNameOperatingSystem, FieldNameLeaseClientType,
+		FieldNameLeaseRequestSourceAddr, FieldNameLeaseAllocateMode,
+	}
+
 	TableHeaderSubnet4Fail = append(TableHeaderSubnet4, FailReasonLocalization)
 	TableHeaderSubnet6Fail = append(TableHeaderSubnet6, FailReasonLocalization)
 	TableHeaderAssetFail   = append(TableHeaderAsset, FailReasonLocalization)
@@ -129,6 +171,7 @@ var (
 		"linkingthing", "tftp.bin", "Gi1/1/1", "11:11:11:11:11:11", "127.0.0.1",
 		"1800", "127.0.0.1\n127.0.0.2", "127.0.0.2\n127.0.0.3", "127.0.0.1",
 		"https://portal.linkinthing.com/login", "linkingthing.com", "MAC固定",
+		"10.0.0.0/8-127.0.0.1_127.0.0.2\n192.168.1.0/24-127.0.0.3", "开启",
 		"127.0.0.6-127.0.0.100-备注1\n127.0.0.106-127.0.0.200-备注2",
 		"127.0.0.1-127.0.0.5-备注3\n127.0.0.200-127.0.0.255-备注4",
 		"mac$11:11:11:11:11:11$127.0.0.66$备注5\nhostname$linking$127.0.0.101$备注6",
@@ -216,9 +259,20 @@ func localizationSubnet4ToStrSlice(subnet4 *resource.Subnet4) []string {
 		subnet4.NextServer, subnet4.CaptivePortalUrl,
 		strings.Join(subnet4.DomainSearchList, resource.CommonDelimiter),
 		resource.AutoReservationTypeToString(subnet4.AutoReservationType),
+		localizationStaticRoute4s(subnet4.StaticRoutes),
+		localizationBoolSwitch(subnet4.UseOption249),
 	}
 }
 
+func localizationStaticRoute4s(routes []*resource.StaticRoute4) string {
+	routeSlices := make([]string, 0, len(routes))
+	for _, route := range routes {
+		routeSlices = append(routeSlices, route.String())
+	}
+
+	return strings.Join(routeSlices, resource.CommonDelimiter)
+}
+
 func localizationSubnet6ToStrSlice(subnet6 *resource.Subnet6) []string {
 	return []string{
 		subnet6.Subnet, subnet6.Tags,
@@ -253,6 +307,29 @@ func localizationAssetToStrSlice(asset *resource.Asset) []string {
 	}
 }
 
+func localizationSubnetLease4ToStrSlice(lease *resource.SubnetLease4) []string {
+	return []string{
+		lease.Subnet, lease.Address, lease.HwAddress,
+		lease.HwAddressOrganization, lease.ClientId, lease.Hostname,
+		lease.LeaseState, lease.RequestType, lease.RequestTime,
+		uint32ToString(lease.ValidLifetime), lease.ExpirationTime, lease.Fingerprint,
+		lease.VendorId, lease.OperatingSystem, lease.ClientType,
+		lease.AllocateMode,
+	}
+}
+
+func localizationSubnetLease6ToStrSlice(lease *resource.SubnetLease6) []string {
+	return []string{
+		lease.Subnet, lease.Address, lease.LeaseType, uint32ToString(lease.PrefixLen),
+		lease.Duid, lease.HwAddress, lease.HwAddressOrganization,
+		uint32ToString(lease.Iaid), lease.Hostname, lease.LeaseState,
+		lease.RequestType, lease.RequestTime, uint32ToString(lease.ValidLifetime),
+		uint32ToString(lease.PreferredLifetime), lease.ExpirationTime, lease.Fingerprint,
+		lease.VendorId, lease.OperatingSystem, lease.ClientType,
+		lease.RequestSourceAddr, lease.AllocateMode,
+	}
+}
+
 func localizationSegmentToStrSlice(segment *resource.AddressCodeLayoutSegment) []string {
 	return []string{localizationSegmentValue(segment.Value), segment.Code}
 }
@@ -384,7 +461,11 @@ func subnet4ToInsertDBSqlString(subnet4 *resource.Subnet4) string {
 	buf.WriteString(strings.Join(subnet4.DomainSearchList, ","))
 	buf.WriteString("}','")
 	buf.WriteString(uint32ToString(subnet4.AutoReservationType))
+	buf.WriteString("','")
+	buf.WriteString(boolToString(subnet4.UseOption249))
 	buf.WriteString("','{")
+	buf.WriteString(strings.Join(subnet4.InheritedFields, ","))
+	buf.WriteString("}','{")
 	buf.WriteString(strings.Join(subnet4.Nodes, ","))
 	buf.WriteString("}','")
 	buf.WriteString(strconv.FormatUint(subnet4.Capacity, 10))
@@ -392,6 +473,23 @@ func subnet4ToInsertDBSqlString(subnet4 *resource.Subnet4) string {
 	return buf.String()
 }
 
+func staticRoute4ToInsertDBSqlString(subnetId uint64, route *resource.StaticRoute4) string {
+	id, _ := uuid.Gen()
+	var buf bytes.Buffer
+	buf.WriteString("('")
+	buf.WriteString(id)
+	buf.WriteString("','")
+	buf.WriteString(time.Now().Format(time.RFC3339))
+	buf.WriteString("','")
+	buf.WriteString(strconv.FormatUint(subnetId, 10))
+	buf.WriteString("','")
+	buf.WriteString(route.Destination)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(route.NextHops, ","))
+	buf.WriteString("}'),")
+	return buf.String()
+}
+
 func pool4ToInsertDBSqlString(subnetId uint64, pool4 *resource.Pool4) string {
 	id, _ := uuid.Gen()
 	var buf bytes.Buffer
@@ -412,6 +510,30 @@ func pool4ToInsertDBSqlString(subnetId uint64, pool4 *resource.Pool4) string {
 	buf.WriteString("','")
 	buf.WriteString(pool4.Comment)
 	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool4.ValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool4.MaxValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool4.MinValidLifetime))
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool4.Routers, ","))
+	buf.WriteString("}','{")
+	buf.WriteString(strings.Join(pool4.DomainServers, ","))
+	buf.WriteString("}','")
+	buf.WriteString(pool4.NextServer)
+	buf.WriteString("','")
+	buf.WriteString(pool4.Bootfile)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool4.DomainSearchList, ","))
+	buf.WriteString("}','")
+	buf.WriteString(pool4.WhiteClientClassStrategy)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool4.WhiteClientClasses, ","))
+	buf.WriteString("}','")
+	buf.WriteString(pool4.BlackClientClassStrategy)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool4.BlackClientClasses, ","))
+	buf.WriteString("}','")
 	buf.WriteString(strconv.FormatUint(subnetId, 10))
 	buf.WriteString("'),")
 	return buf.String()
@@ -464,6 +586,22 @@ func reservation4ToInsertDBSqlString(subnetId uint64, reservation4 *resource.Res
 	buf.WriteString("','")
 	buf.WriteString(boolToString(reservation4.AutoCreate))
 	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation4.ValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation4.MaxValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation4.MinValidLifetime))
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(reservation4.Routers, ","))
+	buf.WriteString("}','{")
+	buf.WriteString(strings.Join(reservation4.DomainServers, ","))
+	buf.WriteString("}','")
+	buf.WriteString(reservation4.NextServer)
+	buf.WriteString("','")
+	buf.WriteString(reservation4.Bootfile)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(reservation4.DomainSearchList, ","))
+	buf.WriteString("}','")
 	buf.WriteString(strconv.FormatUint(subnetId, 10))
 	buf.WriteString("'),")
 	return buf.String()
@@ -528,6 +666,8 @@ func subnet6ToInsertDBSqlString(subnet6 *resource.Subnet6) string {
 	buf.WriteString("','")
 	buf.WriteString(uint32ToString(subnet6.AutoReservationType))
 	buf.WriteString("','{")
+	buf.WriteString(strings.Join(subnet6.InheritedFields, ","))
+	buf.WriteString("}','{")
 	buf.WriteString(strings.Join(subnet6.Nodes, ","))
 	buf.WriteString("}','")
 	buf.WriteString(subnet6.Capacity)
@@ -555,6 +695,26 @@ func pool6ToInsertDBSqlString(subnetId uint64, pool6 *resource.Pool6) string {
 	buf.WriteString("','")
 	buf.WriteString(pool6.Comment)
 	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool6.ValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool6.MaxValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool6.MinValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(pool6.PreferredLifetime))
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool6.DomainServers, ","))
+	buf.WriteString("}','{")
+	buf.WriteString(strings.Join(pool6.DomainSearchList, ","))
+	buf.WriteString("}','")
+	buf.WriteString(pool6.WhiteClientClassStrategy)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool6.WhiteClientClasses, ","))
+	buf.WriteString("}','")
+	buf.WriteString(pool6.BlackClientClassStrategy)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pool6.BlackClientClasses, ","))
+	buf.WriteString("}','")
 	buf.WriteString(strconv.FormatUint(subnetId, 10))
 	buf.WriteString("'),")
 	return buf.String()
@@ -613,6 +773,18 @@ func reservation6ToInsertDBSqlString(subnetId uint64, reservation6 *resource.Res
 	buf.WriteString("','")
 	buf.WriteString(boolToString(reservation6.AutoCreate))
 	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation6.ValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation6.MaxValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation6.MinValidLifetime))
+	buf.WriteString("','")
+	buf.WriteString(uint32ToString(reservation6.PreferredLifetime))
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(reservation6.DomainServers, ","))
+	buf.WriteString("}','{")
+	buf.WriteString(strings.Join(reservation6.DomainSearchList, ","))
+	buf.WriteString("}','")
 	buf.WriteString(strconv.FormatUint(subnetId, 10))
 	buf.WriteString("'),")
 	return buf.String()
@@ -656,6 +828,14 @@ func pdpoolToInsertDBSqlString(subnetId uint64, pdpool *resource.PdPool) string
 	buf.WriteString("','")
 	buf.WriteString(pdpool.Comment)
 	buf.WriteString("','")
+	buf.WriteString(pdpool.WhiteClientClassStrategy)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pdpool.WhiteClientClasses, ","))
+	buf.WriteString("}','")
+	buf.WriteString(pdpool.BlackClientClassStrategy)
+	buf.WriteString("','{")
+	buf.WriteString(strings.Join(pdpool.BlackClientClasses, ","))
+	buf.WriteString("}','")
 	buf.WriteString(strconv.FormatUint(subnetId, 10))
 	buf.WriteString("'),")
 	return buf.String()
@@ -681,7 +861,7 @@ func assetToInsertDBSqlString(asset *resource.Asset) string {
 	buf.WriteString(asset.OperatingSystem)
 	buf.WriteString("','")
 	buf.WriteString(asset.AccessNetworkTime)
-	buf.WriteString("'),")
+	buf.WriteString("','','','',''),")
 	return buf.String()
 
 }
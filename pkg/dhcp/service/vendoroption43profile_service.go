@@ -0,0 +1,183 @@
+package service
+
+import (
+	pg "github.com/linkingthing/clxone-utils/postgresql"
+	restdb "github.com/linkingthing/gorest/db"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/db"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+type VendorOption43ProfileService struct {
+}
+
+func NewVendorOption43ProfileService() *VendorOption43ProfileService {
+	return &VendorOption43ProfileService{}
+}
+
+func (v *VendorOption43ProfileService) Create(profile *resource.VendorOption43Profile) error {
+	profile.SetID(profile.Name)
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if exists, err := tx.Exists(resource.TableClientClass4,
+			map[string]interface{}{restdb.IDField: profile.Name}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameExists, profile.Name,
+				pg.Error(err).Error())
+		} else if exists {
+			return errorno.ErrDuplicate(errorno.ErrNameClientClass, profile.Name)
+		}
+
+		if _, err := tx.Insert(profile); err != nil {
+			return util.FormatDbInsertError(errorno.ErrNameVendorOption43Profile,
+				profile.Name, err)
+		}
+
+		if err := saveVendorSubOption43s(tx, profile); err != nil {
+			return err
+		}
+
+		return sendCreateClientClass4CmdToAgent(profile.ToClientClass4())
+	})
+}
+
+func saveVendorSubOption43s(tx restdb.Transaction, profile *resource.VendorOption43Profile) error {
+	if err := deleteVendorSubOption43s(tx, profile.GetID()); err != nil {
+		return err
+	}
+
+	for _, subOption := range profile.SubOptions {
+		subOption.Profile = profile.GetID()
+		if _, err := tx.Insert(subOption); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameInsert,
+				string(errorno.ErrNameSubOption), pg.Error(err).Error())
+		}
+	}
+
+	return nil
+}
+
+func deleteVendorSubOption43s(tx restdb.Transaction, profileId string) error {
+	if _, err := tx.Delete(resource.TableVendorSubOption43,
+		map[string]interface{}{resource.SqlColumnProfile: profileId}); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameDelete,
+			string(errorno.ErrNameSubOption), pg.Error(err).Error())
+	}
+
+	return nil
+}
+
+func (v *VendorOption43ProfileService) List(conditions map[string]interface{}) ([]*resource.VendorOption43Profile, error) {
+	var profiles []*resource.VendorOption43Profile
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(conditions, &profiles); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery,
+				string(errorno.ErrNameVendorOption43Profile), pg.Error(err).Error())
+		}
+
+		return setVendorOption43ProfilesSubOptions(tx, profiles)
+	}); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func setVendorOption43ProfilesSubOptions(tx restdb.Transaction, profiles []*resource.VendorOption43Profile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	profileIds := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		profileIds = append(profileIds, profile.GetID())
+	}
+
+	var subOptions []*resource.VendorSubOption43
+	if err := tx.Fill(map[string]interface{}{
+		resource.SqlColumnProfile: restdb.FillValue{
+			Operator: restdb.OperatorAny, Value: profileIds},
+		resource.SqlOrderBy: resource.SqlColumnCode}, &subOptions); err != nil {
+		return errorno.ErrDBError(errorno.ErrDBNameQuery,
+			string(errorno.ErrNameSubOption), pg.Error(err).Error())
+	}
+
+	subOptionsMap := make(map[string][]*resource.VendorSubOption43, len(profiles))
+	for _, subOption := range subOptions {
+		subOptionsMap[subOption.Profile] = append(subOptionsMap[subOption.Profile], subOption)
+	}
+
+	for _, profile := range profiles {
+		profile.SubOptions = subOptionsMap[profile.GetID()]
+	}
+
+	return nil
+}
+
+func (v *VendorOption43ProfileService) Get(id string) (*resource.VendorOption43Profile, error) {
+	var profiles []*resource.VendorOption43Profile
+	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if err := tx.Fill(map[string]interface{}{restdb.IDField: id}, &profiles); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameQuery, id, pg.Error(err).Error())
+		}
+
+		return setVendorOption43ProfilesSubOptions(tx, profiles)
+	}); err != nil {
+		return nil, err
+	} else if len(profiles) == 0 {
+		return nil, errorno.ErrNotFound(errorno.ErrNameVendorOption43Profile, id)
+	}
+
+	return profiles[0], nil
+}
+
+func (v *VendorOption43ProfileService) Update(profile *resource.VendorOption43Profile) error {
+	profile.Name = profile.GetID()
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Update(resource.TableVendorOption43Profile,
+			map[string]interface{}{
+				resource.SqlColumnClassCondition:  profile.Condition,
+				resource.SqlColumnClassRegexp:     profile.Regexp,
+				resource.SqlColumnClassBeginIndex: profile.BeginIndex,
+				resource.SqlColumnData:            profile.Data,
+				resource.SqlColumnComment:         profile.Comment,
+			},
+			map[string]interface{}{restdb.IDField: profile.GetID()}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameUpdate, profile.GetID(),
+				pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameVendorOption43Profile, profile.GetID())
+		}
+
+		if err := saveVendorSubOption43s(tx, profile); err != nil {
+			return err
+		}
+
+		return sendUpdateClientClass4CmdToDHCPAgent(profile.ToClientClass4())
+	})
+}
+
+func (v *VendorOption43ProfileService) Delete(id string) error {
+	return restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) error {
+		if rows, err := tx.Delete(resource.TableVendorOption43Profile,
+			map[string]interface{}{restdb.IDField: id}); err != nil {
+			return errorno.ErrDBError(errorno.ErrDBNameDelete, id, pg.Error(err).Error())
+		} else if rows == 0 {
+			return errorno.ErrNotFound(errorno.ErrNameVendorOption43Profile, id)
+		}
+
+		if err := deleteVendorSubOption43s(tx, id); err != nil {
+			return err
+		}
+
+		return sendDeleteClientClass4CmdToDHCPAgent(id)
+	})
+}
@@ -33,6 +33,29 @@ const (
 	ErrNameUser                    ErrName = "user"
 
 	ErrNameSharedNetwork            ErrName = "sharedNetwork"
+	ErrNameSubnetTemplate           ErrName = "subnetTemplate"
+	ErrNameDdns                     ErrName = "ddns"
+	ErrNameDnsZone                  ErrName = "dnsZone"
+	ErrNameDnsServer                ErrName = "dnsServer"
+	ErrNameTsigKey                  ErrName = "tsigKey"
+	ErrNameDdnsReplaceClientName    ErrName = "ddnsReplaceClientName"
+	ErrNameDdnsConflictResolution   ErrName = "ddnsConflictResolution"
+	ErrNameLeaseHistory             ErrName = "leaseHistory"
+	ErrNameSearchKeyword            ErrName = "searchKeyword"
+	ErrNameSearchType               ErrName = "searchType"
+	ErrNameInactiveDays             ErrName = "inactiveDays"
+	ErrNameLeaseState               ErrName = "leaseState"
+	ErrNameAllocateMode             ErrName = "allocateMode"
+	ErrNameSortBy                   ErrName = "sortBy"
+	ErrNameSortOrder                ErrName = "sortOrder"
+	ErrNamePageSize                 ErrName = "pageSize"
+	ErrNameClientId                 ErrName = "clientId"
+	ErrNamePrefixLen                ErrName = "prefixLen"
+	ErrNameCount                    ErrName = "count"
+	ErrNameAllocateStrategy         ErrName = "allocateStrategy"
+	ErrNameNetworkContainer         ErrName = "networkContainer"
+	ErrNameTags                     ErrName = "tags"
+	ErrNameCreateMode               ErrName = "addressCreateMode"
 	ErrNameConfig                   ErrName = "config"
 	ErrNameClientClass              ErrName = "clientClass"
 	ErrNameClientClassStrategy      ErrName = "clientClassStrategy"
@@ -109,6 +132,17 @@ const (
 	ErrNameAutoReservationType      ErrName = "autoReservationType"
 	ErrNameCaptivePortalUrl         ErrName = "captivePortalUrl"
 	ErrNameV6Prefix64               ErrName = "v6Prefix64"
+	ErrNameIpv6OnlyPreferred        ErrName = "ipv6OnlyPreferred"
+	ErrNameOptionDef                ErrName = "optionDef"
+	ErrNameOptionType               ErrName = "optionType"
+	ErrNameOptionRecordTypes        ErrName = "recordTypes"
+	ErrNameOptionValue              ErrName = "optionValue"
+	ErrNameEnterpriseNumber         ErrName = "enterpriseNumber"
+	ErrNameInformationRefreshTime   ErrName = "informationRefreshTime"
+	ErrNameStaticRoute              ErrName = "staticRoute"
+	ErrNameNextHop                  ErrName = "nextHop"
+	ErrNameVendorOption43Profile    ErrName = "vendorOption43Profile"
+	ErrNameSubOption                ErrName = "subOption"
 
 	ErrNameMetric      ErrName = "metric"
 	ErrNameUsedRatio   ErrName = "usedRatio"
@@ -187,6 +221,17 @@ var ErrNameMap = map[ErrName]string{
 	ErrNameAutoReservationType:     "自动固定地址",
 	ErrNameCaptivePortalUrl:        "PORTAL认证URL",
 	ErrNameV6Prefix64:              "NAT64前缀",
+	ErrNameIpv6OnlyPreferred:       "IPv6优先",
+	ErrNameOptionDef:               "自定义选项",
+	ErrNameOptionType:              "选项类型",
+	ErrNameOptionRecordTypes:       "记录字段类型",
+	ErrNameOptionValue:             "选项值",
+	ErrNameEnterpriseNumber:        "企业编号",
+	ErrNameInformationRefreshTime:  "Information消息刷新时间",
+	ErrNameStaticRoute:             "静态路由",
+	ErrNameNextHop:                 "下一跳",
+	ErrNameVendorOption43Profile:   "OPTION43厂商模板",
+	ErrNameSubOption:               "子选项",
 
 	ErrDBNameInsert: "写入数据",
 	ErrDBNameUpdate: "更新数据",
@@ -208,6 +253,29 @@ var ErrNameMap = map[ErrName]string{
 	ErrMethodPing:     "Ping",
 
 	ErrNameSharedNetwork:            "共享网络",
+	ErrNameSubnetTemplate:           "子网模板",
+	ErrNameDdns:                     "动态DNS",
+	ErrNameDnsZone:                  "DNS区",
+	ErrNameDnsServer:                "DNS服务器",
+	ErrNameTsigKey:                  "TSIG密钥",
+	ErrNameDdnsReplaceClientName:    "客户端主机名替换策略",
+	ErrNameDdnsConflictResolution:   "DDNS冲突解决模式",
+	ErrNameLeaseHistory:             "租赁历史",
+	ErrNameSearchKeyword:            "搜索关键字",
+	ErrNameSearchType:               "搜索类型",
+	ErrNameInactiveDays:             "不活跃天数",
+	ErrNameLeaseState:               "租赁状态",
+	ErrNameAllocateMode:             "分配方式",
+	ErrNameSortBy:                   "排序字段",
+	ErrNameSortOrder:                "排序方式",
+	ErrNamePageSize:                 "分页大小",
+	ErrNameClientId:                 "客户端ID",
+	ErrNamePrefixLen:                "前缀长度",
+	ErrNameCount:                    "数量",
+	ErrNameAllocateStrategy:         "分配策略",
+	ErrNameNetworkContainer:         "网络容器",
+	ErrNameTags:                     "标签",
+	ErrNameCreateMode:               "地址创建方式",
 	ErrNameConfig:                   "配置",
 	ErrNameClientClass:              "OPTION",
 	ErrNameClientClassStrategy:      "OPTION策略",
@@ -115,6 +115,21 @@ var (
 			fmt.Sprintf(`%s should in scope %v-%v`, target, begin, end),
 			fmt.Sprintf(`%s 应该位于范围 %v-%v 中`, localizeErrName(target), begin, end))
 	}
+	ErrReservedOptionCode = func(code uint32) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf(`option code %d is reserved by dhcp protocol`, code),
+			fmt.Sprintf(`选项编码 %d 被DHCP协议保留`, code))
+	}
+	ErrInvalidOptionValue = func(name, value, errMsg string) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf(`option %s value %s is invalid: %s`, name, value, errMsg),
+			fmt.Sprintf(`选项 %s 的值 %s 不合法: %s`, name, value, errMsg))
+	}
+	ErrInvalidExpression = func(expression, errMsg string) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf(`expression %s is invalid: %s`, expression, errMsg),
+			fmt.Sprintf(`表达式 %s 不合法: %s`, expression, errMsg))
+	}
 	ErrNotInScope = func(target ErrName, values ...string) *goresterr.ErrorMessage {
 		localizeValues := make([]string, len(values))
 		for i, val := range values {
@@ -381,6 +396,36 @@ var (
 			fmt.Sprintf("%s %s 不属于 %s %s", localizeErrName(source), sourceValue, localizeErrName(target), targetValue),
 		)
 	}
+	ErrStraddleSubnets = func(target ErrName, value string, prefixLen uint32) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf("%s %s straddles subnets with prefix length %d", target, value, prefixLen),
+			fmt.Sprintf("%s %s 跨越了多个前缀长度为 %d 的子网", localizeErrName(target), value, prefixLen),
+		)
+	}
+	ErrNotSupernet = func(subnets []string) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf("subnets %v can not be merged into a supernet", subnets),
+			fmt.Sprintf("子网 %v 不能合并为一个超网", subnets),
+		)
+	}
+	ErrSubnetNodesNotSame = func(subnet, another string) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf("nodes of subnet %s and %s are not the same", subnet, another),
+			fmt.Sprintf("子网 %s 和 %s 的节点不一致", subnet, another),
+		)
+	}
+	ErrNoFreeSubnets = func(parent string, prefixLen uint32, count int) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf("%s has no %d free subnets with prefix length %d", parent, count, prefixLen),
+			fmt.Sprintf("%s 中没有 %d 个前缀长度为 %d 的空闲子网", parent, count, prefixLen),
+		)
+	}
+	ErrNoFreeAddresses = func(subnet string, count int) *goresterr.ErrorMessage {
+		return goresterr.NewErrorMessage(
+			fmt.Sprintf("%s has no %d free addresses", subnet, count),
+			fmt.Sprintf("%s 中没有 %d 个空闲地址", subnet, count),
+		)
+	}
 )
 
 func HandleAPIError(code goresterr.ErrorCode, err error) *goresterr.APIError {
@@ -1,6 +1,8 @@
 package parser
 
 import (
+	"time"
+
 	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
 	pbdhcp "github.com/linkingthing/clxone-dhcp/pkg/proto/dhcp"
 )
@@ -220,3 +222,27 @@ func SubnetLease6ToPbDHCPLease6(lease *resource.SubnetLease6) *pbdhcp.Lease6 {
 		AddressCodeEnd:        lease.AddressCodeEnd,
 	}
 }
+
+func LeaseHistoriesToPbDHCPLeaseHistories(histories []*resource.LeaseHistory) []*pbdhcp.LeaseHistory {
+	pbHistories := make([]*pbdhcp.LeaseHistory, len(histories))
+	for i, history := range histories {
+		pbHistories[i] = &pbdhcp.LeaseHistory{
+			Address:        history.Address,
+			HwAddress:      history.HwAddress,
+			Duid:           history.Duid,
+			ClientId:       history.ClientId,
+			Hostname:       history.Hostname,
+			RequestType:    history.RequestType,
+			LeaseState:     history.LeaseState,
+			SubnetId:       history.SubnetId,
+			Subnet:         history.Subnet,
+			Node:           history.Node,
+			ValidLifetime:  history.ValidLifetime,
+			ExpirationTime: history.ExpirationTime,
+			Version:        history.Version,
+			Time:           history.GetCreationTimestamp().Format(time.RFC3339),
+		}
+	}
+
+	return pbHistories
+}
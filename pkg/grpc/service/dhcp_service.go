@@ -242,7 +242,7 @@ func (d *DHCPService) GetSubnet4AndLease4WithIp(ip string) (map[string]*pbdhcp.I
 	var addressType resource.AddressType
 	var subnetLeases []*resource.SubnetLease4
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
-		addressType, err = getIPv4AddressType(tx, subnet.Id, ip)
+		addressType, err = service.GetIPv4AddressType(tx, subnet.Id, ip)
 		if err != nil {
 			return
 		}
@@ -266,34 +266,6 @@ func (d *DHCPService) GetSubnet4AndLease4WithIp(ip string) (map[string]*pbdhcp.I
 	return ipv4Info, nil
 }
 
-func getIPv4AddressType(tx restdb.Transaction, subnetId, ip string) (resource.AddressType, error) {
-	addressType := resource.AddressTypeExclusion
-	if exists, err := tx.Exists(resource.TableReservation4,
-		map[string]interface{}{resource.SqlColumnIpAddress: ip, resource.SqlColumnSubnet4: subnetId}); err != nil {
-		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
-	} else if exists {
-		return resource.AddressTypeReservation, nil
-	}
-
-	if count, err := tx.CountEx(resource.TableReservedPool4,
-		"select count(*) from gr_reserved_pool4 where subnet4 = $1 and begin_ip <= $2 and end_ip >= $3",
-		subnetId, ip, ip); err != nil {
-		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
-	} else if count != 0 {
-		return resource.AddressTypeReserve, nil
-	}
-
-	if count, err := tx.CountEx(resource.TablePool4,
-		"select count(*) from gr_pool4 where subnet4 = $1 and begin_ip <= $2 and end_ip >= $3",
-		subnetId, ip, ip); err != nil {
-		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
-	} else if count != 0 {
-		return resource.AddressTypeDynamic, nil
-	}
-
-	return addressType, nil
-}
-
 func (d *DHCPService) GetSubnet6AndLease6WithIp(ip string) (map[string]*pbdhcp.Ipv6Information, error) {
 	subnets, err := getSubnet6WithIp(ip)
 	if err != nil {
@@ -310,7 +282,7 @@ func (d *DHCPService) GetSubnet6AndLease6WithIp(ip string) (map[string]*pbdhcp.I
 	var addressType resource.AddressType
 	var subnetLeases []*resource.SubnetLease6
 	if err := restdb.WithTx(db.GetDB(), func(tx restdb.Transaction) (err error) {
-		addressType, err = getIPv6AddressType(tx, subnet.Id, ip)
+		addressType, err = service.GetIPv6AddressType(tx, subnet.Id, ip)
 		if err != nil {
 			return
 		}
@@ -337,35 +309,6 @@ func (d *DHCPService) GetSubnet6AndLease6WithIp(ip string) (map[string]*pbdhcp.I
 	return ipv6Info, nil
 }
 
-func getIPv6AddressType(tx restdb.Transaction, subnetId, ip string) (resource.AddressType, error) {
-	addressType := resource.AddressTypeExclusion
-	if count, err := tx.CountEx(resource.TableReservation6,
-		"select count(*) from gr_reservation6 where subnet6 = $1 and $2::text = any(ip_addresses)",
-		subnetId, ip); err != nil {
-		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservation), pg.Error(err).Error())
-	} else if count != 0 {
-		return resource.AddressTypeReservation, nil
-	}
-
-	if count, err := tx.CountEx(resource.TableReservedPool6,
-		"select count(*) from gr_reserved_pool6 where subnet6 = $1 and begin_ip <= $2 and end_ip >= $3",
-		subnetId, ip, ip); err != nil {
-		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpReservedPool), pg.Error(err).Error())
-	} else if count != 0 {
-		return resource.AddressTypeReserve, nil
-	}
-
-	if count, err := tx.CountEx(resource.TablePool6,
-		"select count(*) from gr_pool6 where subnet6 = $1 and begin_ip <= $2 and end_ip >= $3",
-		subnetId, ip, ip); err != nil {
-		return addressType, errorno.ErrDBError(errorno.ErrDBNameQuery, string(errorno.ErrNameDhcpPool), pg.Error(err).Error())
-	} else if count != 0 {
-		return resource.AddressTypeDynamic, nil
-	}
-
-	return addressType, nil
-}
-
 func (d *DHCPService) GetSubnets4AndLeases4WithIps(ips []string) (map[string]*pbdhcp.Ipv4Information, error) {
 	subnets, err := getSubnets4WithIps(ips)
 	if err != nil {
@@ -896,3 +839,19 @@ func (d *DHCPService) CreateReservation6s(prefix string, pools []*pbdhcp.Reserva
 func (d *DHCPService) CreateReservedPool6s(prefix string, pools []*pbdhcp.ReservedPool6) error {
 	return service.BatchCreateReservedPool6s(prefix, parser.ReservedPool6sFromPbDHCPReservedPool6s(pools))
 }
+
+func (d *DHCPService) GetLeaseHistories(req *pbdhcp.GetLeaseHistoriesRequest) ([]*pbdhcp.LeaseHistory, error) {
+	if histories, err := service.ListLeaseHistories(&service.LeaseHistoryConditions{
+		Address:   req.GetAddress(),
+		HwAddress: req.GetHwAddress(),
+		Duid:      req.GetDuid(),
+		Hostname:  req.GetHostname(),
+		From:      req.GetFrom(),
+		To:        req.GetTo(),
+		AtTime:    req.GetAtTime(),
+	}); err != nil {
+		return nil, err
+	} else {
+		return parser.LeaseHistoriesToPbDHCPLeaseHistories(histories), nil
+	}
+}
@@ -293,3 +293,11 @@ func (g *GrpcService) CreateReservedPool6S(ctx context.Context, request *dhcppb.
 		return &dhcppb.CreateReservedPool6SResponse{Succeed: true}, nil
 	}
 }
+
+func (g *GrpcService) GetLeaseHistories(ctx context.Context, request *dhcppb.GetLeaseHistoriesRequest) (*dhcppb.GetLeaseHistoriesResponse, error) {
+	if histories, err := GetDHCPService().GetLeaseHistories(request); err != nil {
+		return nil, err
+	} else {
+		return &dhcppb.GetLeaseHistoriesResponse{LeaseHistories: histories}, nil
+	}
+}
@@ -36,6 +36,7 @@ const (
 
 	CreatePool4  DHCPCmd = "create_pool4"
 	DeletePool4  DHCPCmd = "delete_pool4"
+	UpdatePool4  DHCPCmd = "update_pool4"
 	CreatePool4s DHCPCmd = "create_pool4s"
 	DeletePool4s DHCPCmd = "delete_pool4s"
 
@@ -46,6 +47,7 @@ const (
 
 	CreateReservation4  DHCPCmd = "create_reservation4"
 	DeleteReservation4  DHCPCmd = "delete_reservation4"
+	UpdateReservation4  DHCPCmd = "update_reservation4"
 	CreateReservation4s DHCPCmd = "create_reservation4s"
 	DeleteReservation4s DHCPCmd = "delete_reservation4s"
 
@@ -58,12 +60,19 @@ const (
 	CreateSubnet6sAndPools DHCPCmd = "create_subnet6s_and_pools"
 	DeleteSubnet6s         DHCPCmd = "delete_subnet6s"
 
+	CreateSharedNetwork6  DHCPCmd = "create_sharednetwork6"
+	UpdateSharedNetwork6  DHCPCmd = "update_sharednetwork6"
+	DeleteSharedNetwork6  DHCPCmd = "delete_sharednetwork6"
+	CreateSharedNetwork6s DHCPCmd = "create_sharednetwork6s"
+	DeleteSharedNetwork6s DHCPCmd = "delete_sharednetwork6s"
+
 	CreateSubnet6 DHCPCmd = "create_subnet6"
 	UpdateSubnet6 DHCPCmd = "update_subnet6"
 	DeleteSubnet6 DHCPCmd = "delete_subnet6"
 
 	CreatePool6  DHCPCmd = "create_pool6"
 	DeletePool6  DHCPCmd = "delete_pool6"
+	UpdatePool6  DHCPCmd = "update_pool6"
 	CreatePool6s DHCPCmd = "create_pool6s"
 	DeletePool6s DHCPCmd = "delete_pool6s"
 
@@ -74,6 +83,7 @@ const (
 
 	CreatePdPool  DHCPCmd = "create_pdpool"
 	DeletePdPool  DHCPCmd = "delete_pdpool"
+	UpdatePdPool  DHCPCmd = "update_pdpool"
 	CreatePdPools DHCPCmd = "create_pdpools"
 	DeletePdPools DHCPCmd = "delete_pdpools"
 
@@ -84,6 +94,7 @@ const (
 
 	CreateReservation6  DHCPCmd = "create_reservation6"
 	DeleteReservation6  DHCPCmd = "delete_reservation6"
+	UpdateReservation6  DHCPCmd = "update_reservation6"
 	CreateReservation6s DHCPCmd = "create_reservation6s"
 	DeleteReservation6s DHCPCmd = "delete_reservation6s"
 
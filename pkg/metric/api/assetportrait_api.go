@@ -0,0 +1,26 @@
+package api
+
+import (
+	resterror "github.com/linkingthing/gorest/error"
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/metric/service"
+)
+
+type AssetPortraitApi struct {
+	Service *service.AssetPortraitService
+}
+
+func NewAssetPortraitApi() *AssetPortraitApi {
+	return &AssetPortraitApi{Service: service.NewAssetPortraitService()}
+}
+
+func (a *AssetPortraitApi) List(ctx *restresource.Context) (interface{}, *resterror.APIError) {
+	portraits, err := a.Service.List(ctx)
+	if err != nil {
+		return nil, errorno.HandleAPIError(resterror.ServerError, err)
+	}
+
+	return portraits, nil
+}
@@ -33,5 +33,6 @@ func RegisterApi(apiServer *gorest.Server, router gin.IRoutes) error {
 	apiServer.Schemas.MustImport(&Version, resource.LeaseTotal{}, api.NewLeaseTotalApi(conf))
 	apiServer.Schemas.MustImport(&Version, resource.PacketStat{}, api.NewPacketStatApi(conf))
 	apiServer.Schemas.MustImport(&Version, resource.SubnetUsedRatio{}, api.NewSubnetUsedRatioApi(conf))
+	apiServer.Schemas.MustImport(&Version, resource.AssetPortrait{}, api.NewAssetPortraitApi())
 	return nil
 }
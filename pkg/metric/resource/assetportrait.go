@@ -0,0 +1,23 @@
+package resource
+
+import (
+	restresource "github.com/linkingthing/gorest/resource"
+)
+
+type AssetPortrait struct {
+	restresource.ResourceBase `json:",inline"`
+	InactiveDays              int                    `json:"inactiveDays"`
+	DeviceTotal               uint64                 `json:"deviceTotal"`
+	OnlineTotal               uint64                 `json:"onlineTotal"`
+	OfflineTotal              uint64                 `json:"offlineTotal"`
+	InactiveTotal             uint64                 `json:"inactiveTotal"`
+	SubnetStatistics          []*AssetStateStatistic `json:"subnetStatistics"`
+	TypeStatistics            []*AssetStateStatistic `json:"typeStatistics"`
+}
+
+type AssetStateStatistic struct {
+	Name     string `json:"name"`
+	Online   uint64 `json:"online"`
+	Offline  uint64 `json:"offline"`
+	Inactive uint64 `json:"inactive"`
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	restresource "github.com/linkingthing/gorest/resource"
+
+	"github.com/linkingthing/clxone-dhcp/config"
+	dhcpresource "github.com/linkingthing/clxone-dhcp/pkg/dhcp/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/dhcp/service"
+	"github.com/linkingthing/clxone-dhcp/pkg/errorno"
+	"github.com/linkingthing/clxone-dhcp/pkg/metric/resource"
+	"github.com/linkingthing/clxone-dhcp/pkg/util"
+)
+
+const (
+	AssetPortraitID = "asset"
+
+	FilterNameInactiveDays = "inactive_days"
+)
+
+type AssetPortraitService struct{}
+
+func NewAssetPortraitService() *AssetPortraitService {
+	return &AssetPortraitService{}
+}
+
+func (a *AssetPortraitService) List(ctx *restresource.Context) (interface{}, error) {
+	inactiveDays := config.GetAssetInactiveDays()
+	if days, ok := util.GetFilterValueWithEqModifierFromFilters(FilterNameInactiveDays,
+		ctx.GetFilters()); ok {
+		if value, err := strconv.Atoi(days); err != nil || value <= 0 {
+			return nil, errorno.ErrInvalidParams(errorno.ErrNameInactiveDays, days)
+		} else {
+			inactiveDays = value
+		}
+	}
+
+	assets, err := service.NewAssetService().List(map[string]interface{}{}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	portrait := &resource.AssetPortrait{InactiveDays: inactiveDays}
+	portrait.SetID(AssetPortraitID)
+	subnetStatistics := make(map[string]*resource.AssetStateStatistic)
+	typeStatistics := make(map[string]*resource.AssetStateStatistic)
+	now := time.Now()
+	for _, asset := range assets {
+		state := asset.CalculateState(now, inactiveDays)
+		portrait.DeviceTotal += 1
+		addAssetState(&portrait.OnlineTotal, &portrait.OfflineTotal, &portrait.InactiveTotal, state)
+		if asset.LastSeenSubnet != "" {
+			addAssetStateStatistic(subnetStatistics, asset.LastSeenSubnet, state)
+		}
+		addAssetStateStatistic(typeStatistics, asset.AssetType, state)
+	}
+
+	portrait.SubnetStatistics = sortAssetStateStatistics(subnetStatistics)
+	portrait.TypeStatistics = sortAssetStateStatistics(typeStatistics)
+	return []*resource.AssetPortrait{portrait}, nil
+}
+
+func addAssetState(online, offline, inactive *uint64, state dhcpresource.AssetState) {
+	switch state {
+	case dhcpresource.AssetStateOnline:
+		*online += 1
+	case dhcpresource.AssetStateOffline:
+		*offline += 1
+	default:
+		*inactive += 1
+	}
+}
+
+func addAssetStateStatistic(statistics map[string]*resource.AssetStateStatistic, name string, state dhcpresource.AssetState) {
+	statistic, ok := statistics[name]
+	if !ok {
+		statistic = &resource.AssetStateStatistic{Name: name}
+		statistics[name] = statistic
+	}
+
+	addAssetState(&statistic.Online, &statistic.Offline, &statistic.Inactive, state)
+}
+
+func sortAssetStateStatistics(statistics map[string]*resource.AssetStateStatistic) []*resource.AssetStateStatistic {
+	sorted := make([]*resource.AssetStateStatistic, 0, len(statistics))
+	for _, statistic := range statistics {
+		sorted = append(sorted, statistic)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
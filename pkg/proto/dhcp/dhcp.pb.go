@@ -3500,6 +3500,299 @@ func (x *GetLease6SResponse) GetLease6S() []*Lease6 {
 	return nil
 }
 
+type LeaseHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address        string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	HwAddress      string `protobuf:"bytes,2,opt,name=hw_address,json=hwAddress,proto3" json:"hw_address,omitempty"`
+	Duid           string `protobuf:"bytes,3,opt,name=duid,proto3" json:"duid,omitempty"`
+	ClientId       string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Hostname       string `protobuf:"bytes,5,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	RequestType    string `protobuf:"bytes,6,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	LeaseState     string `protobuf:"bytes,7,opt,name=lease_state,json=leaseState,proto3" json:"lease_state,omitempty"`
+	SubnetId       uint64 `protobuf:"varint,8,opt,name=subnet_id,json=subnetId,proto3" json:"subnet_id,omitempty"`
+	Subnet         string `protobuf:"bytes,9,opt,name=subnet,proto3" json:"subnet,omitempty"`
+	Node           string `protobuf:"bytes,10,opt,name=node,proto3" json:"node,omitempty"`
+	ValidLifetime  uint32 `protobuf:"varint,11,opt,name=valid_lifetime,json=validLifetime,proto3" json:"valid_lifetime,omitempty"`
+	ExpirationTime string `protobuf:"bytes,12,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	Version        string `protobuf:"bytes,13,opt,name=version,proto3" json:"version,omitempty"`
+	Time           string `protobuf:"bytes,14,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *LeaseHistory) Reset() {
+	*x = LeaseHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dhcp_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaseHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaseHistory) ProtoMessage() {}
+
+func (x *LeaseHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_dhcp_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaseHistory.ProtoReflect.Descriptor instead.
+func (*LeaseHistory) Descriptor() ([]byte, []int) {
+	return file_dhcp_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *LeaseHistory) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetHwAddress() string {
+	if x != nil {
+		return x.HwAddress
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetDuid() string {
+	if x != nil {
+		return x.Duid
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetRequestType() string {
+	if x != nil {
+		return x.RequestType
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetLeaseState() string {
+	if x != nil {
+		return x.LeaseState
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetSubnetId() uint64 {
+	if x != nil {
+		return x.SubnetId
+	}
+	return 0
+}
+
+func (x *LeaseHistory) GetSubnet() string {
+	if x != nil {
+		return x.Subnet
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetValidLifetime() uint32 {
+	if x != nil {
+		return x.ValidLifetime
+	}
+	return 0
+}
+
+func (x *LeaseHistory) GetExpirationTime() string {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LeaseHistory) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+type GetLeaseHistoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	HwAddress string `protobuf:"bytes,2,opt,name=hw_address,json=hwAddress,proto3" json:"hw_address,omitempty"`
+	Duid      string `protobuf:"bytes,3,opt,name=duid,proto3" json:"duid,omitempty"`
+	Hostname  string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	From      string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	AtTime    string `protobuf:"bytes,7,opt,name=at_time,json=atTime,proto3" json:"at_time,omitempty"`
+}
+
+func (x *GetLeaseHistoriesRequest) Reset() {
+	*x = GetLeaseHistoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dhcp_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaseHistoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaseHistoriesRequest) ProtoMessage() {}
+
+func (x *GetLeaseHistoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dhcp_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaseHistoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaseHistoriesRequest) Descriptor() ([]byte, []int) {
+	return file_dhcp_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetLeaseHistoriesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetLeaseHistoriesRequest) GetHwAddress() string {
+	if x != nil {
+		return x.HwAddress
+	}
+	return ""
+}
+
+func (x *GetLeaseHistoriesRequest) GetDuid() string {
+	if x != nil {
+		return x.Duid
+	}
+	return ""
+}
+
+func (x *GetLeaseHistoriesRequest) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *GetLeaseHistoriesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetLeaseHistoriesRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetLeaseHistoriesRequest) GetAtTime() string {
+	if x != nil {
+		return x.AtTime
+	}
+	return ""
+}
+
+type GetLeaseHistoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaseHistories []*LeaseHistory `protobuf:"bytes,1,rep,name=lease_histories,json=leaseHistories,proto3" json:"lease_histories,omitempty"`
+}
+
+func (x *GetLeaseHistoriesResponse) Reset() {
+	*x = GetLeaseHistoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dhcp_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaseHistoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaseHistoriesResponse) ProtoMessage() {}
+
+func (x *GetLeaseHistoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_dhcp_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaseHistoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaseHistoriesResponse) Descriptor() ([]byte, []int) {
+	return file_dhcp_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetLeaseHistoriesResponse) GetLeaseHistories() []*LeaseHistory {
+	if x != nil {
+		return x.LeaseHistories
+	}
+	return nil
+}
+
 var File_dhcp_proto protoreflect.FileDescriptor
 
 var file_dhcp_proto_rawDesc = []byte{
@@ -3963,151 +4256,199 @@ var file_dhcp_proto_rawDesc = []byte{
 	0x37, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73,
 	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x52,
-	0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x32, 0xe6, 0x11, 0x0a, 0x0b, 0x44, 0x68, 0x63,
-	0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x12, 0x18, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x57,
-	0x69, 0x74, 0x68, 0x49, 0x70, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x19, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x57, 0x69, 0x74, 0x68,
-	0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73,
-	0x12, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x12,
-	0x1a, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x57, 0x69, 0x74,
-	0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x57,
-	0x69, 0x74, 0x68, 0x49, 0x70, 0x12, 0x21, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
-	0x74, 0x34, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x34, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x12, 0x21, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x57,
-	0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73,
-	0x65, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x6b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x41,
-	0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73,
-	0x12, 0x24, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x41, 0x6e,
+	0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x22, 0x9f, 0x03, 0x0a, 0x0c, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x75, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4c, 0x69, 0x66, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x77, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x77, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x53, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0f, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x0e, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x32, 0xb2, 0x12, 0x0a, 0x0b, 0x44, 0x68, 0x63, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x57, 0x69, 0x74,
+	0x68, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x12,
+	0x18, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34,
+	0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x12,
+	0x21, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x41, 0x6e, 0x64, 0x4c,
+	0x65, 0x61, 0x73, 0x65, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x41,
+	0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x57, 0x69, 0x74,
+	0x68, 0x49, 0x70, 0x12, 0x21, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36,
+	0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x57, 0x69, 0x74, 0x68,
+	0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x34, 0x41, 0x6e,
 	0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x34, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x73, 0x34, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x34, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a,
-	0x1c, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x41, 0x6e, 0x64, 0x4c,
-	0x65, 0x61, 0x73, 0x65, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x12, 0x24, 0x2e,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x36,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x12, 0x24, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x6e, 0x65, 0x74, 0x73, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x36, 0x57,
+	0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
 	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73,
-	0x36, 0x41, 0x6e, 0x64, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49,
-	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x12, 0x12, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x61, 0x73, 0x65, 0x73, 0x36, 0x57, 0x69, 0x74, 0x68, 0x49, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x36, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x73, 0x42, 0x79,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
 	0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47,
 	0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34,
-	0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x12, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x34, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x36, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x12,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x36, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x34,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
-	0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x42,
+	0x73, 0x65, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x42,
 	0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
 	0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f,
-	0x6c, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x19, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73,
+	0x12, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73,
 	0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
 	0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f,
-	0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x73,
-	0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
-	0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x36, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x73,
-	0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x73,
-	0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x42, 0x79,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73,
-	0x65, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x42, 0x79,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b,
-	0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x36, 0x73, 0x42, 0x79,
-	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e,
-	0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62,
-	0x6e, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x42, 0x79, 0x49, 0x70, 0x12, 0x14, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x79, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x42, 0x79,
-	0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x42, 0x79, 0x49, 0x70, 0x12, 0x14, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x42, 0x79, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x42, 0x79, 0x49,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x1a, 0x12, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f,
+	0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x34,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x73, 0x42, 0x79, 0x53,
+	0x75, 0x62, 0x6e, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65,
+	0x74, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x42, 0x79,
+	0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x42, 0x79, 0x53, 0x75,
+	0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50,
+	0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x36, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74,
+	0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x50, 0x6f, 0x6f, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x64,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x34, 0x42, 0x79, 0x49, 0x70, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x42, 0x79, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x42, 0x79, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x36, 0x42, 0x79, 0x49, 0x70, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65,
+	0x42, 0x79, 0x49, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x42, 0x79, 0x49, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x73, 0x12, 0x1b, 0x2e, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x73,
-	0x12, 0x1b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x34, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f,
-	0x6c, 0x34, 0x73, 0x12, 0x1c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x50, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x73, 0x12, 0x1b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x12, 0x1c, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65,
-	0x61, 0x73, 0x65, 0x34, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x73, 0x12, 0x18, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
-	0x73, 0x65, 0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61,
-	0x63, 0x73, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74,
-	0x68, 0x4d, 0x61, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2e, 0x2f, 0x64, 0x68, 0x63, 0x70, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x34, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x34, 0x73, 0x12, 0x1c, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x6f, 0x6c, 0x34, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c,
+	0x34, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x13, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36,
+	0x73, 0x12, 0x1b, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x36, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f,
+	0x6f, 0x6c, 0x36, 0x73, 0x12, 0x1c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x50, 0x6f, 0x6f, 0x6c, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x53, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x34, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
+	0x73, 0x65, 0x36, 0x53, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x61, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73,
+	0x65, 0x36, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2e, 0x2f, 0x64, 0x68,
+	0x63, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4122,7 +4463,7 @@ func file_dhcp_proto_rawDescGZIP() []byte {
 	return file_dhcp_proto_rawDescData
 }
 
-var file_dhcp_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_dhcp_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
 var file_dhcp_proto_goTypes = []interface{}{
 	(*GetSubnet4WithIpRequest)(nil),              // 0: GetSubnet4WithIpRequest
 	(*Subnet4)(nil),                              // 1: Subnet4
@@ -4181,28 +4522,31 @@ var file_dhcp_proto_goTypes = []interface{}{
 	(*GetLeaseWithMacsRequest)(nil),              // 54: GetLeaseWithMacsRequest
 	(*GetLease4SResponse)(nil),                   // 55: GetLease4sResponse
 	(*GetLease6SResponse)(nil),                   // 56: GetLease6sResponse
-	nil,                                          // 57: GetSubnet4WithIpResponse.SubnetsEntry
-	nil,                                          // 58: GetSubnet6WithIpResponse.SubnetsEntry
-	nil,                                          // 59: GetSubnets4WithIpsResponse.SubnetsEntry
-	nil,                                          // 60: GetSubnets6WithIpsResponse.SubnetsEntry
-	nil,                                          // 61: GetSubnet4AndLease4WithIpResponse.Ipv4InformationsEntry
-	nil,                                          // 62: GetSubnet6AndLease6WithIpResponse.Ipv6InformationsEntry
-	nil,                                          // 63: GetSubnets4AndLeases4WithIpsResponse.Ipv4InformationsEntry
-	nil,                                          // 64: GetSubnets6AndLeases6WithIpsResponse.Ipv6InformationsEntry
+	(*LeaseHistory)(nil),                         // 57: LeaseHistory
+	(*GetLeaseHistoriesRequest)(nil),             // 58: GetLeaseHistoriesRequest
+	(*GetLeaseHistoriesResponse)(nil),            // 59: GetLeaseHistoriesResponse
+	nil,                                          // 60: GetSubnet4WithIpResponse.SubnetsEntry
+	nil,                                          // 61: GetSubnet6WithIpResponse.SubnetsEntry
+	nil,                                          // 62: GetSubnets4WithIpsResponse.SubnetsEntry
+	nil,                                          // 63: GetSubnets6WithIpsResponse.SubnetsEntry
+	nil,                                          // 64: GetSubnet4AndLease4WithIpResponse.Ipv4InformationsEntry
+	nil,                                          // 65: GetSubnet6AndLease6WithIpResponse.Ipv6InformationsEntry
+	nil,                                          // 66: GetSubnets4AndLeases4WithIpsResponse.Ipv4InformationsEntry
+	nil,                                          // 67: GetSubnets6AndLeases6WithIpsResponse.Ipv6InformationsEntry
 }
 var file_dhcp_proto_depIdxs = []int32{
-	57, // 0: GetSubnet4WithIpResponse.subnets:type_name -> GetSubnet4WithIpResponse.SubnetsEntry
-	58, // 1: GetSubnet6WithIpResponse.subnets:type_name -> GetSubnet6WithIpResponse.SubnetsEntry
-	59, // 2: GetSubnets4WithIpsResponse.subnets:type_name -> GetSubnets4WithIpsResponse.SubnetsEntry
-	60, // 3: GetSubnets6WithIpsResponse.subnets:type_name -> GetSubnets6WithIpsResponse.SubnetsEntry
+	60, // 0: GetSubnet4WithIpResponse.subnets:type_name -> GetSubnet4WithIpResponse.SubnetsEntry
+	61, // 1: GetSubnet6WithIpResponse.subnets:type_name -> GetSubnet6WithIpResponse.SubnetsEntry
+	62, // 2: GetSubnets4WithIpsResponse.subnets:type_name -> GetSubnets4WithIpsResponse.SubnetsEntry
+	63, // 3: GetSubnets6WithIpsResponse.subnets:type_name -> GetSubnets6WithIpsResponse.SubnetsEntry
 	1,  // 4: Ipv4Information.subnet:type_name -> Subnet4
 	11, // 5: Ipv4Information.lease:type_name -> Lease4
-	61, // 6: GetSubnet4AndLease4WithIpResponse.ipv4_informations:type_name -> GetSubnet4AndLease4WithIpResponse.Ipv4InformationsEntry
+	64, // 6: GetSubnet4AndLease4WithIpResponse.ipv4_informations:type_name -> GetSubnet4AndLease4WithIpResponse.Ipv4InformationsEntry
 	4,  // 7: Ipv6Information.subnet:type_name -> Subnet6
 	15, // 8: Ipv6Information.lease:type_name -> Lease6
-	62, // 9: GetSubnet6AndLease6WithIpResponse.ipv6_informations:type_name -> GetSubnet6AndLease6WithIpResponse.Ipv6InformationsEntry
-	63, // 10: GetSubnets4AndLeases4WithIpsResponse.ipv4_informations:type_name -> GetSubnets4AndLeases4WithIpsResponse.Ipv4InformationsEntry
-	64, // 11: GetSubnets6AndLeases6WithIpsResponse.ipv6_informations:type_name -> GetSubnets6AndLeases6WithIpsResponse.Ipv6InformationsEntry
+	65, // 9: GetSubnet6AndLease6WithIpResponse.ipv6_informations:type_name -> GetSubnet6AndLease6WithIpResponse.Ipv6InformationsEntry
+	66, // 10: GetSubnets4AndLeases4WithIpsResponse.ipv4_informations:type_name -> GetSubnets4AndLeases4WithIpsResponse.Ipv4InformationsEntry
+	67, // 11: GetSubnets6AndLeases6WithIpsResponse.ipv6_informations:type_name -> GetSubnets6AndLeases6WithIpsResponse.Ipv6InformationsEntry
 	1,  // 12: GetSubnet4sResponse.subnet4s:type_name -> Subnet4
 	28, // 13: GetPool4sResponse.pools:type_name -> Pool4
 	29, // 14: GetReservedPool4sResponse.pools:type_name -> ReservedPool4
@@ -4222,77 +4566,80 @@ var file_dhcp_proto_depIdxs = []int32{
 	43, // 28: CreateReservedPool6sRequest.reservedPool6s:type_name -> ReservedPool6
 	11, // 29: GetLease4sResponse.lease4s:type_name -> Lease4
 	15, // 30: GetLease6sResponse.lease6s:type_name -> Lease6
-	1,  // 31: GetSubnet4WithIpResponse.SubnetsEntry.value:type_name -> Subnet4
-	4,  // 32: GetSubnet6WithIpResponse.SubnetsEntry.value:type_name -> Subnet6
-	1,  // 33: GetSubnets4WithIpsResponse.SubnetsEntry.value:type_name -> Subnet4
-	4,  // 34: GetSubnets6WithIpsResponse.SubnetsEntry.value:type_name -> Subnet6
-	12, // 35: GetSubnet4AndLease4WithIpResponse.Ipv4InformationsEntry.value:type_name -> Ipv4Information
-	16, // 36: GetSubnet6AndLease6WithIpResponse.Ipv6InformationsEntry.value:type_name -> Ipv6Information
-	12, // 37: GetSubnets4AndLeases4WithIpsResponse.Ipv4InformationsEntry.value:type_name -> Ipv4Information
-	16, // 38: GetSubnets6AndLeases6WithIpsResponse.Ipv6InformationsEntry.value:type_name -> Ipv6Information
-	0,  // 39: DhcpService.GetSubnet4WithIp:input_type -> GetSubnet4WithIpRequest
-	3,  // 40: DhcpService.GetSubnet6WithIp:input_type -> GetSubnet6WithIpRequest
-	6,  // 41: DhcpService.GetSubnets4WithIps:input_type -> GetSubnets4WithIpsRequest
-	8,  // 42: DhcpService.GetSubnets6WithIps:input_type -> GetSubnets6WithIpsRequest
-	10, // 43: DhcpService.GetSubnet4AndLease4WithIp:input_type -> GetSubnet4AndLease4WithIpRequest
-	14, // 44: DhcpService.GetSubnet6AndLease6WithIp:input_type -> GetSubnet6AndLease6WithIpRequest
-	18, // 45: DhcpService.GetSubnets4AndLeases4WithIps:input_type -> GetSubnets4AndLeases4WithIpsRequest
-	20, // 46: DhcpService.GetSubnets6AndLeases6WithIps:input_type -> GetSubnets6AndLeases6WithIpsRequest
-	22, // 47: DhcpService.GetAllSubnet4s:input_type -> GetSubnetsRequest
-	22, // 48: DhcpService.GetAllSubnet6s:input_type -> GetSubnetsRequest
-	22, // 49: DhcpService.GetSubnet4sByPrefixes:input_type -> GetSubnetsRequest
-	22, // 50: DhcpService.GetSubnet6sByPrefixes:input_type -> GetSubnetsRequest
-	24, // 51: DhcpService.GetPool4sBySubnet:input_type -> GetSubnetPoolsRequest
-	24, // 52: DhcpService.GetPool6sBySubnet:input_type -> GetSubnetPoolsRequest
-	24, // 53: DhcpService.GetReservedPool4sBySubnet:input_type -> GetSubnetPoolsRequest
-	24, // 54: DhcpService.GetReservedPool6sBySubnet:input_type -> GetSubnetPoolsRequest
-	24, // 55: DhcpService.GetReservation4sBySubnet:input_type -> GetSubnetPoolsRequest
-	24, // 56: DhcpService.GetReservation6sBySubnet:input_type -> GetSubnetPoolsRequest
-	31, // 57: DhcpService.GetLease4sBySubnet:input_type -> GetLeasesBySubnetRequest
-	31, // 58: DhcpService.GetLease6sBySubnet:input_type -> GetLeasesBySubnetRequest
-	24, // 59: DhcpService.GetPdPools6sBySubnet:input_type -> GetSubnetPoolsRequest
-	32, // 60: DhcpService.GetLease4ByIp:input_type -> GetLeaseByIpRequest
-	32, // 61: DhcpService.GetLease6ByIp:input_type -> GetLeaseByIpRequest
-	46, // 62: DhcpService.CreateReservation4s:input_type -> CreateReservation4sRequest
-	48, // 63: DhcpService.CreateReservedPool4s:input_type -> CreateReservedPool4sRequest
-	50, // 64: DhcpService.CreateReservation6s:input_type -> CreateReservation6sRequest
-	52, // 65: DhcpService.CreateReservedPool6s:input_type -> CreateReservedPool6sRequest
-	54, // 66: DhcpService.GetLease4SWithMacs:input_type -> GetLeaseWithMacsRequest
-	54, // 67: DhcpService.GetLease6SWithMacs:input_type -> GetLeaseWithMacsRequest
-	2,  // 68: DhcpService.GetSubnet4WithIp:output_type -> GetSubnet4WithIpResponse
-	5,  // 69: DhcpService.GetSubnet6WithIp:output_type -> GetSubnet6WithIpResponse
-	7,  // 70: DhcpService.GetSubnets4WithIps:output_type -> GetSubnets4WithIpsResponse
-	9,  // 71: DhcpService.GetSubnets6WithIps:output_type -> GetSubnets6WithIpsResponse
-	13, // 72: DhcpService.GetSubnet4AndLease4WithIp:output_type -> GetSubnet4AndLease4WithIpResponse
-	17, // 73: DhcpService.GetSubnet6AndLease6WithIp:output_type -> GetSubnet6AndLease6WithIpResponse
-	19, // 74: DhcpService.GetSubnets4AndLeases4WithIps:output_type -> GetSubnets4AndLeases4WithIpsResponse
-	21, // 75: DhcpService.GetSubnets6AndLeases6WithIps:output_type -> GetSubnets6AndLeases6WithIpsResponse
-	23, // 76: DhcpService.GetAllSubnet4s:output_type -> GetSubnet4sResponse
-	35, // 77: DhcpService.GetAllSubnet6s:output_type -> GetSubnet6sResponse
-	23, // 78: DhcpService.GetSubnet4sByPrefixes:output_type -> GetSubnet4sResponse
-	35, // 79: DhcpService.GetSubnet6sByPrefixes:output_type -> GetSubnet6sResponse
-	25, // 80: DhcpService.GetPool4sBySubnet:output_type -> GetPool4sResponse
-	36, // 81: DhcpService.GetPool6sBySubnet:output_type -> GetPool6sResponse
-	26, // 82: DhcpService.GetReservedPool4sBySubnet:output_type -> GetReservedPool4sResponse
-	37, // 83: DhcpService.GetReservedPool6sBySubnet:output_type -> GetReservedPool6sResponse
-	27, // 84: DhcpService.GetReservation4sBySubnet:output_type -> GetReservationPool4sResponse
-	38, // 85: DhcpService.GetReservation6sBySubnet:output_type -> GetReservationPool6sResponse
-	34, // 86: DhcpService.GetLease4sBySubnet:output_type -> GetLease4sBySubnetResponse
-	40, // 87: DhcpService.GetLease6sBySubnet:output_type -> GetLease6sBySubnetResponse
-	41, // 88: DhcpService.GetPdPools6sBySubnet:output_type -> GetPdPoolsBySubnetResponse
-	33, // 89: DhcpService.GetLease4ByIp:output_type -> GetLease4ByIpResponse
-	39, // 90: DhcpService.GetLease6ByIp:output_type -> GetLease6ByIpResponse
-	47, // 91: DhcpService.CreateReservation4s:output_type -> CreateReservation4sResponse
-	49, // 92: DhcpService.CreateReservedPool4s:output_type -> CreateReservedPool4sResponse
-	51, // 93: DhcpService.CreateReservation6s:output_type -> CreateReservation6sResponse
-	53, // 94: DhcpService.CreateReservedPool6s:output_type -> CreateReservedPool6sResponse
-	55, // 95: DhcpService.GetLease4SWithMacs:output_type -> GetLease4sResponse
-	56, // 96: DhcpService.GetLease6SWithMacs:output_type -> GetLease6sResponse
-	68, // [68:97] is the sub-list for method output_type
-	39, // [39:68] is the sub-list for method input_type
-	39, // [39:39] is the sub-list for extension type_name
-	39, // [39:39] is the sub-list for extension extendee
-	0,  // [0:39] is the sub-list for field type_name
+	57, // 31: GetLeaseHistoriesResponse.lease_histories:type_name -> LeaseHistory
+	1,  // 32: GetSubnet4WithIpResponse.SubnetsEntry.value:type_name -> Subnet4
+	4,  // 33: GetSubnet6WithIpResponse.SubnetsEntry.value:type_name -> Subnet6
+	1,  // 34: GetSubnets4WithIpsResponse.SubnetsEntry.value:type_name -> Subnet4
+	4,  // 35: GetSubnets6WithIpsResponse.SubnetsEntry.value:type_name -> Subnet6
+	12, // 36: GetSubnet4AndLease4WithIpResponse.Ipv4InformationsEntry.value:type_name -> Ipv4Information
+	16, // 37: GetSubnet6AndLease6WithIpResponse.Ipv6InformationsEntry.value:type_name -> Ipv6Information
+	12, // 38: GetSubnets4AndLeases4WithIpsResponse.Ipv4InformationsEntry.value:type_name -> Ipv4Information
+	16, // 39: GetSubnets6AndLeases6WithIpsResponse.Ipv6InformationsEntry.value:type_name -> Ipv6Information
+	0,  // 40: DhcpService.GetSubnet4WithIp:input_type -> GetSubnet4WithIpRequest
+	3,  // 41: DhcpService.GetSubnet6WithIp:input_type -> GetSubnet6WithIpRequest
+	6,  // 42: DhcpService.GetSubnets4WithIps:input_type -> GetSubnets4WithIpsRequest
+	8,  // 43: DhcpService.GetSubnets6WithIps:input_type -> GetSubnets6WithIpsRequest
+	10, // 44: DhcpService.GetSubnet4AndLease4WithIp:input_type -> GetSubnet4AndLease4WithIpRequest
+	14, // 45: DhcpService.GetSubnet6AndLease6WithIp:input_type -> GetSubnet6AndLease6WithIpRequest
+	18, // 46: DhcpService.GetSubnets4AndLeases4WithIps:input_type -> GetSubnets4AndLeases4WithIpsRequest
+	20, // 47: DhcpService.GetSubnets6AndLeases6WithIps:input_type -> GetSubnets6AndLeases6WithIpsRequest
+	22, // 48: DhcpService.GetAllSubnet4s:input_type -> GetSubnetsRequest
+	22, // 49: DhcpService.GetAllSubnet6s:input_type -> GetSubnetsRequest
+	22, // 50: DhcpService.GetSubnet4sByPrefixes:input_type -> GetSubnetsRequest
+	22, // 51: DhcpService.GetSubnet6sByPrefixes:input_type -> GetSubnetsRequest
+	24, // 52: DhcpService.GetPool4sBySubnet:input_type -> GetSubnetPoolsRequest
+	24, // 53: DhcpService.GetPool6sBySubnet:input_type -> GetSubnetPoolsRequest
+	24, // 54: DhcpService.GetReservedPool4sBySubnet:input_type -> GetSubnetPoolsRequest
+	24, // 55: DhcpService.GetReservedPool6sBySubnet:input_type -> GetSubnetPoolsRequest
+	24, // 56: DhcpService.GetReservation4sBySubnet:input_type -> GetSubnetPoolsRequest
+	24, // 57: DhcpService.GetReservation6sBySubnet:input_type -> GetSubnetPoolsRequest
+	31, // 58: DhcpService.GetLease4sBySubnet:input_type -> GetLeasesBySubnetRequest
+	31, // 59: DhcpService.GetLease6sBySubnet:input_type -> GetLeasesBySubnetRequest
+	24, // 60: DhcpService.GetPdPools6sBySubnet:input_type -> GetSubnetPoolsRequest
+	32, // 61: DhcpService.GetLease4ByIp:input_type -> GetLeaseByIpRequest
+	32, // 62: DhcpService.GetLease6ByIp:input_type -> GetLeaseByIpRequest
+	46, // 63: DhcpService.CreateReservation4s:input_type -> CreateReservation4sRequest
+	48, // 64: DhcpService.CreateReservedPool4s:input_type -> CreateReservedPool4sRequest
+	50, // 65: DhcpService.CreateReservation6s:input_type -> CreateReservation6sRequest
+	52, // 66: DhcpService.CreateReservedPool6s:input_type -> CreateReservedPool6sRequest
+	54, // 67: DhcpService.GetLease4SWithMacs:input_type -> GetLeaseWithMacsRequest
+	54, // 68: DhcpService.GetLease6SWithMacs:input_type -> GetLeaseWithMacsRequest
+	58, // 69: DhcpService.GetLeaseHistories:input_type -> GetLeaseHistoriesRequest
+	2,  // 70: DhcpService.GetSubnet4WithIp:output_type -> GetSubnet4WithIpResponse
+	5,  // 71: DhcpService.GetSubnet6WithIp:output_type -> GetSubnet6WithIpResponse
+	7,  // 72: DhcpService.GetSubnets4WithIps:output_type -> GetSubnets4WithIpsResponse
+	9,  // 73: DhcpService.GetSubnets6WithIps:output_type -> GetSubnets6WithIpsResponse
+	13, // 74: DhcpService.GetSubnet4AndLease4WithIp:output_type -> GetSubnet4AndLease4WithIpResponse
+	17, // 75: DhcpService.GetSubnet6AndLease6WithIp:output_type -> GetSubnet6AndLease6WithIpResponse
+	19, // 76: DhcpService.GetSubnets4AndLeases4WithIps:output_type -> GetSubnets4AndLeases4WithIpsResponse
+	21, // 77: DhcpService.GetSubnets6AndLeases6WithIps:output_type -> GetSubnets6AndLeases6WithIpsResponse
+	23, // 78: DhcpService.GetAllSubnet4s:output_type -> GetSubnet4sResponse
+	35, // 79: DhcpService.GetAllSubnet6s:output_type -> GetSubnet6sResponse
+	23, // 80: DhcpService.GetSubnet4sByPrefixes:output_type -> GetSubnet4sResponse
+	35, // 81: DhcpService.GetSubnet6sByPrefixes:output_type -> GetSubnet6sResponse
+	25, // 82: DhcpService.GetPool4sBySubnet:output_type -> GetPool4sResponse
+	36, // 83: DhcpService.GetPool6sBySubnet:output_type -> GetPool6sResponse
+	26, // 84: DhcpService.GetReservedPool4sBySubnet:output_type -> GetReservedPool4sResponse
+	37, // 85: DhcpService.GetReservedPool6sBySubnet:output_type -> GetReservedPool6sResponse
+	27, // 86: DhcpService.GetReservation4sBySubnet:output_type -> GetReservationPool4sResponse
+	38, // 87: DhcpService.GetReservation6sBySubnet:output_type -> GetReservationPool6sResponse
+	34, // 88: DhcpService.GetLease4sBySubnet:output_type -> GetLease4sBySubnetResponse
+	40, // 89: DhcpService.GetLease6sBySubnet:output_type -> GetLease6sBySubnetResponse
+	41, // 90: DhcpService.GetPdPools6sBySubnet:output_type -> GetPdPoolsBySubnetResponse
+	33, // 91: DhcpService.GetLease4ByIp:output_type -> GetLease4ByIpResponse
+	39, // 92: DhcpService.GetLease6ByIp:output_type -> GetLease6ByIpResponse
+	47, // 93: DhcpService.CreateReservation4s:output_type -> CreateReservation4sResponse
+	49, // 94: DhcpService.CreateReservedPool4s:output_type -> CreateReservedPool4sResponse
+	51, // 95: DhcpService.CreateReservation6s:output_type -> CreateReservation6sResponse
+	53, // 96: DhcpService.CreateReservedPool6s:output_type -> CreateReservedPool6sResponse
+	55, // 97: DhcpService.GetLease4SWithMacs:output_type -> GetLease4sResponse
+	56, // 98: DhcpService.GetLease6SWithMacs:output_type -> GetLease6sResponse
+	59, // 99: DhcpService.GetLeaseHistories:output_type -> GetLeaseHistoriesResponse
+	70, // [70:100] is the sub-list for method output_type
+	40, // [40:70] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_dhcp_proto_init() }
@@ -4985,6 +5332,42 @@ func file_dhcp_proto_init() {
 				return nil
 			}
 		}
+		file_dhcp_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaseHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dhcp_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaseHistoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dhcp_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaseHistoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4992,7 +5375,7 @@ func file_dhcp_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dhcp_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   65,
+			NumMessages:   68,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5047,6 +5430,7 @@ type DhcpServiceClient interface {
 	CreateReservedPool6S(ctx context.Context, in *CreateReservedPool6SRequest, opts ...grpc.CallOption) (*CreateReservedPool6SResponse, error)
 	GetLease4SWithMacs(ctx context.Context, in *GetLeaseWithMacsRequest, opts ...grpc.CallOption) (*GetLease4SResponse, error)
 	GetLease6SWithMacs(ctx context.Context, in *GetLeaseWithMacsRequest, opts ...grpc.CallOption) (*GetLease6SResponse, error)
+	GetLeaseHistories(ctx context.Context, in *GetLeaseHistoriesRequest, opts ...grpc.CallOption) (*GetLeaseHistoriesResponse, error)
 }
 
 type dhcpServiceClient struct {
@@ -5318,6 +5702,15 @@ func (c *dhcpServiceClient) GetLease6SWithMacs(ctx context.Context, in *GetLease
 	return out, nil
 }
 
+func (c *dhcpServiceClient) GetLeaseHistories(ctx context.Context, in *GetLeaseHistoriesRequest, opts ...grpc.CallOption) (*GetLeaseHistoriesResponse, error) {
+	out := new(GetLeaseHistoriesResponse)
+	err := c.cc.Invoke(ctx, "/DhcpService/GetLeaseHistories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DhcpServiceServer is the server API for DhcpService service.
 type DhcpServiceServer interface {
 	GetSubnet4WithIp(context.Context, *GetSubnet4WithIpRequest) (*GetSubnet4WithIpResponse, error)
@@ -5349,6 +5742,7 @@ type DhcpServiceServer interface {
 	CreateReservedPool6S(context.Context, *CreateReservedPool6SRequest) (*CreateReservedPool6SResponse, error)
 	GetLease4SWithMacs(context.Context, *GetLeaseWithMacsRequest) (*GetLease4SResponse, error)
 	GetLease6SWithMacs(context.Context, *GetLeaseWithMacsRequest) (*GetLease6SResponse, error)
+	GetLeaseHistories(context.Context, *GetLeaseHistoriesRequest) (*GetLeaseHistoriesResponse, error)
 }
 
 // UnimplementedDhcpServiceServer can be embedded to have forward compatible implementations.
@@ -5442,6 +5836,9 @@ func (*UnimplementedDhcpServiceServer) GetLease4SWithMacs(context.Context, *GetL
 func (*UnimplementedDhcpServiceServer) GetLease6SWithMacs(context.Context, *GetLeaseWithMacsRequest) (*GetLease6SResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLease6SWithMacs not implemented")
 }
+func (*UnimplementedDhcpServiceServer) GetLeaseHistories(context.Context, *GetLeaseHistoriesRequest) (*GetLeaseHistoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaseHistories not implemented")
+}
 
 func RegisterDhcpServiceServer(s *grpc.Server, srv DhcpServiceServer) {
 	s.RegisterService(&_DhcpService_serviceDesc, srv)
@@ -5969,6 +6366,24 @@ func _DhcpService_GetLease6SWithMacs_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DhcpService_GetLeaseHistories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaseHistoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DhcpServiceServer).GetLeaseHistories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DhcpService/GetLeaseHistories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DhcpServiceServer).GetLeaseHistories(ctx, req.(*GetLeaseHistoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DhcpService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "DhcpService",
 	HandlerType: (*DhcpServiceServer)(nil),
@@ -6089,6 +6504,10 @@ var _DhcpService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetLease6SWithMacs",
 			Handler:    _DhcpService_GetLease6SWithMacs_Handler,
 		},
+		{
+			MethodName: "GetLeaseHistories",
+			Handler:    _DhcpService_GetLeaseHistories_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dhcp.proto",